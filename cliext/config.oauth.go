@@ -114,8 +114,9 @@ func loadOAuthConfigFromFile(path string) (map[string]*OAuthConfig, error) {
 				RedirectURL:  cfg.RedirectURL,
 				Scopes:       cfg.Scopes,
 				Endpoint: oauth2.Endpoint{
-					AuthURL:  cfg.AuthURL,
-					TokenURL: cfg.TokenURL,
+					AuthURL:       cfg.AuthURL,
+					TokenURL:      cfg.TokenURL,
+					DeviceAuthURL: cfg.DeviceAuthURL,
 				},
 			},
 			Token: &oauth2.Token{
@@ -285,8 +286,9 @@ func parseOAuthFromRaw(raw map[string]any) (map[string]*OAuthConfig, error) {
 				RedirectURL:  cfg.RedirectURL,
 				Scopes:       cfg.Scopes,
 				Endpoint: oauth2.Endpoint{
-					AuthURL:  cfg.AuthURL,
-					TokenURL: cfg.TokenURL,
+					AuthURL:       cfg.AuthURL,
+					TokenURL:      cfg.TokenURL,
+					DeviceAuthURL: cfg.DeviceAuthURL,
 				},
 			},
 			Token: &oauth2.Token{
@@ -330,16 +332,17 @@ func mergeOAuthIntoRaw(raw map[string]any, oauthByProfile map[string]*OAuthConfi
 
 // oauthConfigTOML is the TOML representation of OAuthConfig.
 type oauthConfigTOML struct {
-	ClientID     string   `toml:"client_id,omitempty"`
-	ClientSecret string   `toml:"client_secret,omitempty"`
-	TokenURL     string   `toml:"token_url,omitempty"`
-	AuthURL      string   `toml:"auth_url,omitempty"`
-	RedirectURL  string   `toml:"redirect_url,omitempty"`
-	AccessToken  string   `toml:"access_token,omitempty"`
-	RefreshToken string   `toml:"refresh_token,omitempty"`
-	TokenType    string   `toml:"token_type,omitempty"`
-	ExpiresAt    string   `toml:"expires_at,omitempty"`
-	Scopes       []string `toml:"scopes,omitempty"`
+	ClientID      string   `toml:"client_id,omitempty"`
+	ClientSecret  string   `toml:"client_secret,omitempty"`
+	TokenURL      string   `toml:"token_url,omitempty"`
+	AuthURL       string   `toml:"auth_url,omitempty"`
+	DeviceAuthURL string   `toml:"device_auth_url,omitempty"`
+	RedirectURL   string   `toml:"redirect_url,omitempty"`
+	AccessToken   string   `toml:"access_token,omitempty"`
+	RefreshToken  string   `toml:"refresh_token,omitempty"`
+	TokenType     string   `toml:"token_type,omitempty"`
+	ExpiresAt     string   `toml:"expires_at,omitempty"`
+	Scopes        []string `toml:"scopes,omitempty"`
 }
 
 type rawProfileWithOAuth struct {
@@ -356,15 +359,16 @@ func oauthConfigToTOML(oauth *OAuthConfig) *oauthConfigTOML {
 		return nil
 	}
 	result := &oauthConfigTOML{
-		ClientID:     oauth.ClientConfig.ClientID,
-		ClientSecret: oauth.ClientConfig.ClientSecret,
-		TokenURL:     oauth.ClientConfig.Endpoint.TokenURL,
-		AuthURL:      oauth.ClientConfig.Endpoint.AuthURL,
-		RedirectURL:  oauth.ClientConfig.RedirectURL,
-		AccessToken:  oauth.Token.AccessToken,
-		RefreshToken: oauth.Token.RefreshToken,
-		TokenType:    oauth.Token.TokenType,
-		Scopes:       oauth.ClientConfig.Scopes,
+		ClientID:      oauth.ClientConfig.ClientID,
+		ClientSecret:  oauth.ClientConfig.ClientSecret,
+		TokenURL:      oauth.ClientConfig.Endpoint.TokenURL,
+		AuthURL:       oauth.ClientConfig.Endpoint.AuthURL,
+		DeviceAuthURL: oauth.ClientConfig.Endpoint.DeviceAuthURL,
+		RedirectURL:   oauth.ClientConfig.RedirectURL,
+		AccessToken:   oauth.Token.AccessToken,
+		RefreshToken:  oauth.Token.RefreshToken,
+		TokenType:     oauth.Token.TokenType,
+		Scopes:        oauth.ClientConfig.Scopes,
 	}
 	if !oauth.Token.Expiry.IsZero() {
 		result.ExpiresAt = oauth.Token.Expiry.Format(time.RFC3339)
@@ -22,6 +22,8 @@ type CommonOptions struct {
 	LogLevel                FlagStringEnum
 	LogFormat               FlagStringEnum
 	Output                  FlagStringEnum
+	OutputTemplate          string
+	OutputJsonpath          string
 	TimeFormat              FlagStringEnum
 	Color                   FlagStringEnum
 	NoJsonShorthandPayloads bool
@@ -46,8 +48,10 @@ func (v *CommonOptions) BuildFlags(f *pflag.FlagSet) {
 	f.Var(&v.LogLevel, "log-level", "Log level. Accepted values: debug, info, warn, error, never.")
 	v.LogFormat = NewFlagStringEnum([]string{"text", "json", "pretty"}, "text")
 	f.Var(&v.LogFormat, "log-format", "Log format. Accepted values: text, json.")
-	v.Output = NewFlagStringEnum([]string{"text", "json", "jsonl", "none"}, "text")
-	f.VarP(&v.Output, "output", "o", "Non-logging data output format. Accepted values: text, json, jsonl, none.")
+	v.Output = NewFlagStringEnum([]string{"text", "json", "jsonl", "yaml", "csv", "none"}, "text")
+	f.VarP(&v.Output, "output", "o", "Non-logging data output format. Accepted values: text, json, jsonl, yaml, csv, none.")
+	f.StringVar(&v.OutputTemplate, "output-template", "", "Go `TEMPLATE` applied to the JSON of each output item, e.g. \"{{.workflowExecutionInfo.status}}\". Overrides the output format.")
+	f.StringVar(&v.OutputJsonpath, "output-jsonpath", "", "JSONPath `TEMPLATE` applied to the JSON of each output item, e.g. \"{.workflowExecutionInfo.status}\". Overrides the output format.")
 	v.TimeFormat = NewFlagStringEnum([]string{"relative", "iso", "raw"}, "relative")
 	f.Var(&v.TimeFormat, "time-format", "Time format. Accepted values: relative, iso, raw.")
 	v.Color = NewFlagStringEnum([]string{"always", "never", "auto"}, "auto")
@@ -0,0 +1,116 @@
+package devserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+// AuthOptions enable the default JWT claim mapper and authorizer on the
+// frontend.
+type AuthOptions struct {
+	// URIs of JWKS to validate tokens with
+	KeySourceURIs []string
+	// Also validates tokens signed with this key
+	SigningKey *SigningKey
+}
+
+// jwksRefreshInterval is how often keys of JWKS URIs are refreshed.
+const jwksRefreshInterval = time.Minute
+
+// SigningKey is a locally generated key for signing tokens accepted by a dev
+// server as API keys.
+type SigningKey struct {
+	ID  string
+	key *ecdsa.PrivateKey
+}
+
+// GenerateSigningKey generates a new signing key.
+func GenerateSigningKey() (*SigningKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed generating signing key: %w", err)
+	}
+	return &SigningKey{ID: "temporal-dev-server", key: key}, nil
+}
+
+// APIKey returns a token for the subject with the permissions, which are in
+// the "<namespace>:<role>" format of the default claim mapper.
+func (k *SigningKey) APIKey(subject string, permissions ...string) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"sub":         subject,
+		"permissions": permissions,
+		"iat":         time.Now().Unix(),
+	})
+	token.Header["kid"] = k.ID
+	return token.SignedString(k.key)
+}
+
+// tokenKeyProvider provides the local signing key, and keys of JWKS URIs if
+// any.
+type tokenKeyProvider struct {
+	signingKey *SigningKey
+	// Nil if there are no URIs
+	jwks authorization.TokenKeyProvider
+}
+
+var _ authorization.TokenKeyProvider = (*tokenKeyProvider)(nil)
+
+func newClaimMapper(options *AuthOptions, conf *config.Authorization, logger log.Logger) authorization.ClaimMapper {
+	provider := &tokenKeyProvider{signingKey: options.SigningKey}
+	if len(conf.JWTKeyProvider.KeySourceURIs) > 0 {
+		provider.jwks = authorization.NewDefaultTokenKeyProvider(conf, logger)
+	}
+	return authorization.NewDefaultJWTClaimMapper(provider, conf, logger)
+}
+
+func (p *tokenKeyProvider) EcdsaKey(alg string, kid string) (*ecdsa.PublicKey, error) {
+	if p.signingKey != nil && kid == p.signingKey.ID {
+		if !strings.EqualFold(alg, jwt.SigningMethodES256.Name) {
+			return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
+		}
+		return &p.signingKey.key.PublicKey, nil
+	} else if p.jwks == nil {
+		return nil, fmt.Errorf("ECDSA key not found for key ID: %s", kid)
+	}
+	return p.jwks.EcdsaKey(alg, kid)
+}
+
+func (p *tokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
+	return nil, fmt.Errorf("unsupported signing algorithm: %s", alg)
+}
+
+func (p *tokenKeyProvider) RsaKey(alg string, kid string) (*rsa.PublicKey, error) {
+	if p.jwks == nil {
+		return nil, fmt.Errorf("RSA key not found for key ID: %s", kid)
+	}
+	return p.jwks.RsaKey(alg, kid)
+}
+
+func (p *tokenKeyProvider) SupportedMethods() []string {
+	methods := []string{jwt.SigningMethodES256.Name}
+	if p.jwks != nil {
+		for _, method := range p.jwks.SupportedMethods() {
+			if !slices.Contains(methods, method) {
+				methods = append(methods, method)
+			}
+		}
+	}
+	return methods
+}
+
+func (p *tokenKeyProvider) Close() {
+	if p.jwks != nil {
+		p.jwks.Close()
+	}
+}
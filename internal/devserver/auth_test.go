@@ -0,0 +1,98 @@
+package devserver_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/temporalio/cli/internal/devserver"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+)
+
+func TestStartWithTLSAndAuth(t *testing.T) {
+	ctx := context.Background()
+	files, err := devserver.GenerateTLSFiles(t.TempDir(), []string{"127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingKey, err := devserver.GenerateSigningKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := devserver.MustGetFreePort("127.0.0.1")
+	server, err := devserver.Start(devserver.StartOptions{
+		FrontendIP:             "127.0.0.1",
+		FrontendPort:           port,
+		Namespaces:             []string{"default"},
+		ClusterID:              uuid.NewString(),
+		MasterClusterName:      "active",
+		CurrentClusterName:     "active",
+		InitialFailoverVersion: 1,
+		Logger:                 slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})),
+		LogLevel:               slog.LevelError,
+		TLS: &devserver.TLSOptions{
+			CertFile:     files.ServerCertFile,
+			KeyFile:      files.ServerKeyFile,
+			ClientCAFile: files.CAFile,
+		},
+		Auth: &devserver.AuthOptions{SigningKey: signingKey},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+
+	caPEM, err := os.ReadFile(files.CAFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(caPEM)
+	clientCert, err := tls.LoadX509KeyPair(files.ClientCertFile, files.ClientKeyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	describe := func(hostPort string, tlsConfig *tls.Config, apiKey string) error {
+		opts := client.Options{HostPort: hostPort, ConnectionOptions: client.ConnectionOptions{TLS: tlsConfig}}
+		if apiKey != "" {
+			opts.Credentials = client.NewAPIKeyStaticCredentials(apiKey)
+		}
+		cl, err := client.DialContext(ctx, opts)
+		if err != nil {
+			return err
+		}
+		defer cl.Close()
+		_, err = cl.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Namespace: "default"})
+		return err
+	}
+	tlsConfig := &tls.Config{RootCAs: roots, Certificates: []tls.Certificate{clientCert}}
+	address := fmt.Sprintf("127.0.0.1:%v", port)
+
+	adminKey, err := signingKey.APIKey("admin", "temporal-system:admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := describe(address, tlsConfig, adminKey); err != nil {
+		t.Fatalf("expected admin to describe namespace, got %v", err)
+	}
+	readerKey, err := signingKey.APIKey("reader", "other:read")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var denied *serviceerror.PermissionDenied
+	if err := describe(address, tlsConfig, readerKey); !errors.As(err, &denied) {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+	// The local address has neither TLS nor auth
+	if err := describe(server.LocalFrontendAddress(), nil, ""); err != nil {
+		t.Fatalf("expected local address to describe namespace, got %v", err)
+	}
+}
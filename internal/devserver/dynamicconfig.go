@@ -0,0 +1,455 @@
+package devserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"gopkg.in/yaml.v3"
+)
+
+// DynamicConfigPollInterval is how often a dynamic config file is checked for
+// changes.
+const DynamicConfigPollInterval = time.Second
+
+// dynamicConfigPath is the path of the dynamic config endpoint.
+const dynamicConfigPath = "/dynamic-config"
+
+// Sources of dynamic config entries.
+const (
+	// DynamicConfigSourceStart is a value given when the server started,
+	// including the dev server defaults
+	DynamicConfigSourceStart = "start"
+	// DynamicConfigSourceFile is a value from the dynamic config file
+	DynamicConfigSourceFile = "file"
+	// DynamicConfigSourceSet is a value set on the endpoint of a server with no
+	// dynamic config file
+	DynamicConfigSourceSet = "set"
+)
+
+// DynamicConfigValue is a value in a dynamic config file, which uses the same
+// format as server dynamic config files.
+type DynamicConfigValue struct {
+	Constraints map[string]any `yaml:"constraints,omitempty" json:"constraints,omitempty"`
+	Value       any            `yaml:"value" json:"value"`
+}
+
+// DynamicConfigEntry is a value a running server uses for a key. Keys with no
+// entries use the server default.
+type DynamicConfigEntry struct {
+	Key         string         `json:"key"`
+	Value       any            `json:"value"`
+	Constraints map[string]any `json:"constraints,omitempty"`
+	Source      string         `json:"source"`
+}
+
+// DynamicConfigUpdate sets the value of a key for the constraints, replacing
+// any value with the same constraints.
+type DynamicConfigUpdate struct {
+	Key         string         `json:"key"`
+	Value       any            `json:"value"`
+	Constraints map[string]any `json:"constraints,omitempty"`
+}
+
+// GetDynamicConfig returns the dynamic config entries of the key, or of all
+// overridden keys if the key is empty, from the dynamic config endpoint of a
+// running server.
+func GetDynamicConfig(ctx context.Context, address, key string) ([]DynamicConfigEntry, error) {
+	query := ""
+	if key != "" {
+		query = "?" + url.Values{"key": {key}}.Encode()
+	}
+	return doDynamicConfigRequest(ctx, http.MethodGet, "http://"+address+dynamicConfigPath+query, nil)
+}
+
+// SetDynamicConfig applies the update on the dynamic config endpoint of a
+// running server and returns the entries of the key the server now uses.
+func SetDynamicConfig(ctx context.Context, address string, update DynamicConfigUpdate) ([]DynamicConfigEntry, error) {
+	b, err := json.Marshal(update)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshaling dynamic config update: %w", err)
+	}
+	return doDynamicConfigRequest(ctx, http.MethodPost, "http://"+address+dynamicConfigPath, b)
+}
+
+func doDynamicConfigRequest(ctx context.Context, method, url string, body []byte) ([]DynamicConfigEntry, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed calling dynamic config endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading dynamic config response: %w", err)
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("dynamic config endpoint failed: %v", strings.TrimSpace(string(b)))
+	}
+	var entries []DynamicConfigEntry
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return nil, fmt.Errorf("invalid dynamic config response: %w", err)
+	}
+	return entries, nil
+}
+
+// writeDynamicConfigFile validates and writes the values to a dynamic config
+// file. The file is replaced at once so the server never reads a partially
+// written file.
+func writeDynamicConfigFile(file string, values map[string][]DynamicConfigValue) error {
+	b, err := marshalDynamicConfig(values)
+	if err != nil {
+		return err
+	}
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, b, 0644); err != nil {
+		return fmt.Errorf("failed writing dynamic config file: %w", err)
+	} else if err := os.Rename(tmp, file); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed writing dynamic config file: %w", err)
+	}
+	return nil
+}
+
+// marshalDynamicConfig marshals the values in the dynamic config file format.
+func marshalDynamicConfig(values map[string][]DynamicConfigValue) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(values); err != nil {
+		return nil, fmt.Errorf("failed marshaling dynamic config: %w", err)
+	} else if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed marshaling dynamic config: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// liveDynamicConfigClient is a dynamic config client with values that override
+// static values and can change while the server runs. The values are from a
+// file, which is reloaded when its content changes, or only in memory if there
+// is no file.
+type liveDynamicConfigClient struct {
+	file   string
+	static dynamicconfig.StaticClient
+	logger log.Logger
+	values atomic.Pointer[dynamicconfig.ConfigValueMap]
+
+	// Serializes loads, and guards the fields below
+	lock sync.Mutex
+	// Values as written, for showing them
+	raw map[string][]DynamicConfigValue
+	// Content of the file when last read, empty if missing
+	content []byte
+
+	httpServer *http.Server
+	stopCh     chan struct{}
+	stopOnce   sync.Once
+
+	dynamicconfig.NotifyingClientImpl
+}
+
+var _ dynamicconfig.NotifyingClient = (*liveDynamicConfigClient)(nil)
+
+func newLiveDynamicConfigClient(
+	file string,
+	static dynamicconfig.StaticClient,
+	logger log.Logger,
+) (*liveDynamicConfigClient, error) {
+	c := &liveDynamicConfigClient{
+		file:                file,
+		static:              static,
+		logger:              logger,
+		raw:                 map[string][]DynamicConfigValue{},
+		stopCh:              make(chan struct{}),
+		NotifyingClientImpl: dynamicconfig.NewNotifyingClientImpl(),
+	}
+	c.values.Store(&dynamicconfig.ConfigValueMap{})
+	// The file must be valid at start, later errors are only logged
+	if file != "" {
+		if _, err := c.update(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *liveDynamicConfigClient) GetValue(key dynamicconfig.Key) []dynamicconfig.ConstrainedValue {
+	if values, ok := (*c.values.Load())[key]; ok {
+		return values
+	}
+	return c.static.GetValue(key)
+}
+
+// start polls the file if any and serves the dynamic config endpoint on the
+// localhost port if non-zero.
+func (c *liveDynamicConfigClient) start(port int) error {
+	if port > 0 {
+		l, err := net.Listen("tcp", fmt.Sprintf("%v:%v", localhost, port))
+		if err != nil {
+			return fmt.Errorf("failed listening on dynamic config port %v: %w", port, err)
+		}
+		mux := http.NewServeMux()
+		mux.Handle(dynamicConfigPath, c)
+		c.httpServer = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			if err := c.httpServer.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				c.logger.Error("Failed serving dynamic config endpoint", tag.Error(err))
+			}
+		}()
+	}
+	if c.file == "" {
+		return nil
+	}
+	go func() {
+		ticker := time.NewTicker(DynamicConfigPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.stopCh:
+				return
+			case <-ticker.C:
+				if changed, err := c.update(); err != nil {
+					c.logger.Error("Failed updating dynamic config", tag.Error(err))
+				} else if changed {
+					c.logger.Info("Updated dynamic config", tag.NewStringTag("file", c.file))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (c *liveDynamicConfigClient) stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+		if c.httpServer != nil {
+			_ = c.httpServer.Close()
+		}
+	})
+}
+
+// update reloads the file if its content changed since last read and notifies
+// subscribers of changed values.
+func (c *liveDynamicConfigClient) update() (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.updateLocked()
+}
+
+func (c *liveDynamicConfigClient) updateLocked() (bool, error) {
+	// The content is compared instead of the modification time, which may not
+	// change for quick writes
+	b, err := os.ReadFile(c.file)
+	if err != nil && !os.IsNotExist(err) {
+		return false, fmt.Errorf("failed reading dynamic config file: %w", err)
+	} else if bytes.Equal(b, c.content) {
+		return false, nil
+	}
+	// Do not retry an invalid file until it changes again
+	c.content = b
+	if err := c.load(b); err != nil {
+		return false, fmt.Errorf("invalid dynamic config file %v: %w", c.file, err)
+	}
+	return true, nil
+}
+
+// load replaces the values with the ones of the dynamic config YAML and
+// notifies subscribers of changed values.
+func (c *liveDynamicConfigClient) load(b []byte) error {
+	raw := map[string][]DynamicConfigValue{}
+	if err := yaml.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	loader := dynamicconfig.LoadYamlFile(b)
+	if err := loader.Err(); err != nil {
+		return err
+	}
+	for _, warning := range loader.Warnings {
+		c.logger.Warn("Dynamic config warning", tag.Error(warning))
+	}
+	newValues := loader.Map
+	if newValues == nil {
+		newValues = dynamicconfig.ConfigValueMap{}
+	}
+	c.raw = raw
+
+	oldValues := *c.values.Swap(&newValues)
+	changed := dynamicconfig.DiffAndLogConfigs(c.logger, oldValues, newValues)
+	// Removed values go back to the static value
+	for key, values := range changed {
+		if values == nil {
+			changed[key] = c.static.GetValue(key)
+		}
+	}
+	c.PublishUpdates(changed)
+	return nil
+}
+
+// set applies the update, to the file if any, and returns the entries of the
+// key once loaded.
+func (c *liveDynamicConfigClient) set(update DynamicConfigUpdate) ([]DynamicConfigEntry, error) {
+	if update.Key == "" {
+		return nil, fmt.Errorf("missing key")
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// Apply on top of the latest file content
+	if c.file != "" {
+		if _, err := c.updateLocked(); err != nil {
+			return nil, err
+		}
+	}
+	values := make(map[string][]DynamicConfigValue, len(c.raw)+1)
+	for k, v := range c.raw {
+		values[k] = slices.Clone(v)
+	}
+	// Keys are case-insensitive, so keep the existing spelling
+	key := update.Key
+	for k := range values {
+		if strings.EqualFold(k, key) {
+			key = k
+		}
+	}
+	newValue := DynamicConfigValue{Value: update.Value}
+	if len(update.Constraints) > 0 {
+		newValue.Constraints = update.Constraints
+	}
+	index := slices.IndexFunc(values[key], func(v DynamicConfigValue) bool {
+		return len(v.Constraints) == 0 && len(newValue.Constraints) == 0 ||
+			reflect.DeepEqual(v.Constraints, newValue.Constraints)
+	})
+	if index >= 0 {
+		values[key][index] = newValue
+	} else {
+		values[key] = append(values[key], newValue)
+	}
+
+	b, err := marshalDynamicConfig(values)
+	if err != nil {
+		return nil, err
+	} else if err := dynamicconfig.LoadYamlFile(b).Err(); err != nil {
+		return nil, fmt.Errorf("invalid dynamic config: %w", err)
+	}
+	if c.file != "" {
+		if err := writeDynamicConfigFile(c.file, values); err != nil {
+			return nil, err
+		} else if _, err := c.updateLocked(); err != nil {
+			return nil, err
+		}
+	} else if err := c.load(b); err != nil {
+		return nil, fmt.Errorf("invalid dynamic config: %w", err)
+	}
+	return c.entriesLocked(update.Key), nil
+}
+
+// entries returns the entries of the key, or of all keys with values if the
+// key is empty, sorted by key.
+func (c *liveDynamicConfigClient) entries(key string) []DynamicConfigEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.entriesLocked(key)
+}
+
+func (c *liveDynamicConfigClient) entriesLocked(key string) []DynamicConfigEntry {
+	source := DynamicConfigSourceSet
+	if c.file != "" {
+		source = DynamicConfigSourceFile
+	}
+	entries := []DynamicConfigEntry{}
+	// Keys with overrides do not use their static value
+	overridden := map[string]bool{}
+	for k, values := range c.raw {
+		if key != "" && !strings.EqualFold(k, key) {
+			continue
+		}
+		overridden[strings.ToLower(k)] = true
+		for _, v := range values {
+			entries = append(entries, DynamicConfigEntry{Key: k, Value: v.Value, Constraints: v.Constraints, Source: source})
+		}
+	}
+	for k, v := range c.static {
+		if (key != "" && !strings.EqualFold(k.String(), key)) || overridden[strings.ToLower(k.String())] {
+			continue
+		}
+		if d, ok := v.(time.Duration); ok {
+			v = d.String()
+		}
+		entries = append(entries, DynamicConfigEntry{Key: k.String(), Value: v, Source: DynamicConfigSourceStart})
+	}
+	slices.SortStableFunc(entries, func(a, b DynamicConfigEntry) int {
+		return strings.Compare(strings.ToLower(a.Key), strings.ToLower(b.Key))
+	})
+	return entries
+}
+
+func (c *liveDynamicConfigClient) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var entries []DynamicConfigEntry
+	switch r.Method {
+	case http.MethodGet:
+		entries = c.entries(r.URL.Query().Get("key"))
+	case http.MethodPost:
+		var update DynamicConfigUpdate
+		dec := json.NewDecoder(r.Body)
+		dec.UseNumber()
+		if err := dec.Decode(&update); err != nil {
+			http.Error(w, fmt.Sprintf("invalid update: %v", err), http.StatusBadRequest)
+			return
+		}
+		update.Value = dynamicConfigJSONNumbers(update.Value)
+		for k, v := range update.Constraints {
+			update.Constraints[k] = dynamicConfigJSONNumbers(v)
+		}
+		var err error
+		if entries, err = c.set(update); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(entries)
+}
+
+// dynamicConfigJSONNumbers converts JSON numbers to ints where possible, since
+// dynamic config expecting ints does not accept floats, and to floats
+// otherwise.
+func dynamicConfigJSONNumbers(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i)
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for k, item := range v {
+			v[k] = dynamicConfigJSONNumbers(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = dynamicConfigJSONNumbers(item)
+		}
+	}
+	return v
+}
@@ -0,0 +1,97 @@
+package devserver_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/temporalio/cli/internal/devserver"
+)
+
+func TestDynamicConfig_FileReloadedOnSameModTime(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "dynamicconfig.yaml")
+	writeFile := func(value int, modTime time.Time) {
+		if err := os.WriteFile(file, []byte(fmt.Sprintf("limit.maxIDLength:\n  - value: %v\n", value)), 0644); err != nil {
+			t.Fatal(err)
+		} else if err := os.Chtimes(file, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	writeFile(100, modTime)
+
+	dynamicConfigPort := devserver.MustGetFreePort("127.0.0.1")
+	server, err := devserver.Start(devserver.StartOptions{
+		FrontendIP:             "127.0.0.1",
+		FrontendPort:           devserver.MustGetFreePort("127.0.0.1"),
+		Namespaces:             []string{"default"},
+		ClusterID:              uuid.NewString(),
+		MasterClusterName:      "active",
+		CurrentClusterName:     "active",
+		InitialFailoverVersion: 1,
+		Logger:                 slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})),
+		LogLevel:               slog.LevelError,
+		DynamicConfigFile:      file,
+		DynamicConfigPort:      dynamicConfigPort,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+	address := fmt.Sprintf("127.0.0.1:%v", dynamicConfigPort)
+
+	maxIDLength := func() any {
+		entries, err := devserver.GetDynamicConfig(ctx, address, "limit.maxIDLength")
+		if err != nil {
+			t.Fatal(err)
+		} else if len(entries) != 1 {
+			t.Fatalf("expected one entry, got %v", entries)
+		}
+		return entries[0].Value
+	}
+	if v := maxIDLength(); v != float64(100) {
+		t.Fatalf("expected 100, got %v", v)
+	}
+
+	// A change of the same size with the same modification time is still seen
+	writeFile(200, modTime)
+	deadline := time.Now().Add(5 * devserver.DynamicConfigPollInterval)
+	for maxIDLength() != float64(200) {
+		if time.Now().After(deadline) {
+			t.Fatal("file change not reloaded")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// Values set on the endpoint are written to the file and used at once
+	entries, err := devserver.SetDynamicConfig(ctx, address, devserver.DynamicConfigUpdate{
+		Key:   "limit.maxIDLength",
+		Value: 300,
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 1 || entries[0].Value != float64(300) || entries[0].Source != devserver.DynamicConfigSourceFile {
+		t.Fatalf("unexpected entries %v", entries)
+	}
+	if b, err := os.ReadFile(file); err != nil {
+		t.Fatal(err)
+	} else if string(b) != "limit.maxIDLength:\n  - value: 300\n" {
+		t.Fatalf("unexpected file %q", b)
+	}
+
+	// Invalid constraints are rejected
+	if _, err := devserver.SetDynamicConfig(ctx, address, devserver.DynamicConfigUpdate{
+		Key:         "limit.maxIDLength",
+		Value:       400,
+		Constraints: map[string]any{"unknown": "x"},
+	}); err == nil || !strings.Contains(err.Error(), "unknown constraint type") {
+		t.Fatalf("expected unknown constraint error, got %v", err)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"time"
 
@@ -52,6 +53,7 @@ import (
 	sqliteschema "go.temporal.io/server/schema/sqlite"
 	"go.temporal.io/server/temporal"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"gopkg.in/yaml.v3"
 )
 
@@ -84,16 +86,26 @@ type StartOptions struct {
 	SqlitePragmas         map[string]string
 	FrontendHTTPPort      int
 	EnableGlobalNamespace bool
+	NamespaceRetention    map[string]time.Duration // Namespaces not present use 24h
 	DynamicConfigValues   map[string]any
+	DynamicConfigFile     string // Overrides DynamicConfigValues, reloaded on change
+	DynamicConfigPort     int    // Serves the dynamic config endpoint on localhost if set
 	SearchAttributes      map[string]enums.IndexedValueType
 	LogConfig             func([]byte)
 	GRPCInterceptors      []grpc.UnaryServerInterceptor
+	TLS                   *TLSOptions  // Enables TLS on the frontend
+	Auth                  *AuthOptions // Enables authorization on the frontend
+
+	// Port of the internal frontend, which is only used with TLS or auth
+	internalFrontendPort int
 }
 
 type Server struct {
-	server   temporal.Server
-	ui       *uiserver.Server
-	logLevel *slog.LevelVar
+	server        temporal.Server
+	ui            *uiserver.Server
+	logLevel      *slog.LevelVar
+	dynamicConfig *liveDynamicConfigClient
+	localAddress  string
 }
 
 func Start(options StartOptions) (*Server, error) {
@@ -121,16 +133,22 @@ func Start(options StartOptions) (*Server, error) {
 	if options.FrontendHTTPPort == 0 {
 		options.FrontendHTTPPort = MustGetFreePort(options.FrontendIP)
 	}
+	// With TLS or auth, the UI and system workers use an internal frontend that
+	// has neither and only listens on localhost
+	if options.TLS != nil || options.Auth != nil {
+		options.internalFrontendPort = MustGetFreePort(localhost)
+	}
 
 	// Build servers
 	var ui *uiserver.Server
 	if options.UIIP != "" {
 		ui = options.buildUIServer()
 	}
-	server, logLevel, err := options.buildServer()
+	server, err := options.buildServer()
 	if err != nil {
 		return nil, err
 	}
+	server.ui = ui
 
 	// Start. We have to start UI server in background because it's start call is
 	// blocking. Therefore we have no way to relay error out to users, so we just
@@ -143,23 +161,45 @@ func Start(options StartOptions) (*Server, error) {
 			}
 		}()
 	}
-	if err := server.Start(); err != nil {
-		// Stop UI before returning to avoid leaks
+	// Dynamic config is served before the server starts so it is available as
+	// soon as the frontend is
+	if server.dynamicConfig != nil {
+		if err := server.dynamicConfig.start(options.DynamicConfigPort); err != nil {
+			if ui != nil {
+				ui.Stop()
+			}
+			return nil, err
+		}
+	}
+	if err := server.server.Start(); err != nil {
+		// Stop UI and dynamic config before returning to avoid leaks
 		if ui != nil {
 			ui.Stop()
 		}
+		if server.dynamicConfig != nil {
+			server.dynamicConfig.stop()
+		}
 		return nil, err
 	}
-	return &Server{server: server, ui: ui, logLevel: logLevel}, nil
+	return server, nil
 }
 
 func (s *Server) Stop() {
 	if s.ui != nil {
 		s.ui.Stop()
 	}
+	if s.dynamicConfig != nil {
+		s.dynamicConfig.stop()
+	}
 	s.server.Stop()
 }
 
+// LocalFrontendAddress returns the address for trusted local clients, which is
+// the internal frontend without TLS or auth if the frontend has any.
+func (s *Server) LocalFrontendAddress() string {
+	return s.localAddress
+}
+
 func (s *Server) SuppressWarnings() {
 	if s.logLevel != nil {
 		s.logLevel.Set(slog.LevelError)
@@ -170,7 +210,7 @@ func (s *StartOptions) buildUIServer() *uiserver.Server {
 	return uiserver.NewServer(uiserveroptions.WithConfigProvider(&uiconfig.Config{
 		Host:                MaybeEscapeIPv6(s.UIIP),
 		Port:                s.UIPort,
-		TemporalGRPCAddress: fmt.Sprintf("%v:%v", MaybeEscapeIPv6(s.FrontendIP), s.FrontendPort),
+		TemporalGRPCAddress: s.localFrontendAddress(),
 		EnableUI:            true,
 		PublicPath:          s.PublicPath,
 		UIAssetPath:         s.UIAssetPath,
@@ -181,16 +221,25 @@ func (s *StartOptions) buildUIServer() *uiserver.Server {
 	}))
 }
 
-func (s *StartOptions) buildServer() (temporal.Server, *slog.LevelVar, error) {
-	opts, logLevel, err := s.buildServerOptions()
+func (s *StartOptions) localFrontendAddress() string {
+	if s.internalFrontendPort > 0 {
+		return fmt.Sprintf("%v:%v", localhost, s.internalFrontendPort)
+	}
+	return fmt.Sprintf("%v:%v", MaybeEscapeIPv6(s.FrontendIP), s.FrontendPort)
+}
+
+func (s *StartOptions) buildServer() (*Server, error) {
+	opts, server, err := s.buildServerOptions()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	server, err := temporal.NewServer(opts...)
-	return server, logLevel, err
+	server.server, err = temporal.NewServer(opts...)
+	return server, err
 }
 
-func (s *StartOptions) buildServerOptions() ([]temporal.ServerOption, *slog.LevelVar, error) {
+// buildServerOptions returns the options of the server along with the server
+// state that is not part of the options.
+func (s *StartOptions) buildServerOptions() ([]temporal.ServerOption, *Server, error) {
 	// Build config and log it
 	conf, err := s.buildServerConfig()
 	if err != nil {
@@ -215,23 +264,32 @@ func (s *StartOptions) buildServerOptions() ([]temporal.ServerOption, *slog.Leve
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed creating authorizer: %w", err)
 	}
-	claimMapper, err := authorization.GetClaimMapperFromConfig(&conf.Global.Authorization, logger)
-	if err != nil {
+	var claimMapper authorization.ClaimMapper
+	if s.Auth != nil {
+		claimMapper = newClaimMapper(s.Auth, &conf.Global.Authorization, logger)
+	} else if claimMapper, err = authorization.GetClaimMapperFromConfig(&conf.Global.Authorization, logger); err != nil {
 		return nil, nil, fmt.Errorf("failed creating claim mapper: %w", err)
 	}
+	services := temporal.DefaultServices
+	staticHosts := map[primitives.ServiceName]static.Hosts{}
+	for _, service := range []primitives.ServiceName{
+		primitives.FrontendService,
+		primitives.MatchingService,
+		primitives.HistoryService,
+		primitives.WorkerService,
+		primitives.InternalFrontendService,
+	} {
+		if serviceConf, ok := conf.Services[string(service)]; ok {
+			staticHosts[service] = static.SingleLocalHost(fmt.Sprintf("%v:%v", localhost, serviceConf.RPC.GRPCPort))
+		}
+	}
+	if _, ok := conf.Services[string(primitives.InternalFrontendService)]; ok {
+		services = append(slices.Clone(services), string(primitives.InternalFrontendService))
+	}
 	opts := []temporal.ServerOption{
 		temporal.WithConfig(conf),
-		temporal.ForServices(temporal.DefaultServices),
-		temporal.WithStaticHosts(map[primitives.ServiceName]static.Hosts{
-			primitives.FrontendService: static.SingleLocalHost(
-				fmt.Sprintf("%v:%v", localhost, conf.Services[string(primitives.FrontendService)].RPC.GRPCPort)),
-			primitives.MatchingService: static.SingleLocalHost(
-				fmt.Sprintf("%v:%v", localhost, conf.Services[string(primitives.MatchingService)].RPC.GRPCPort)),
-			primitives.HistoryService: static.SingleLocalHost(
-				fmt.Sprintf("%v:%v", localhost, conf.Services[string(primitives.HistoryService)].RPC.GRPCPort)),
-			primitives.WorkerService: static.SingleLocalHost(
-				fmt.Sprintf("%v:%v", localhost, conf.Services[string(primitives.WorkerService)].RPC.GRPCPort)),
-		}),
+		temporal.ForServices(services),
+		temporal.WithStaticHosts(staticHosts),
 		temporal.WithLogger(logger),
 		temporal.WithAuthorizer(authorizer),
 		temporal.WithClaimMapper(func(*config.Config) authorization.ClaimMapper { return claimMapper }),
@@ -254,14 +312,22 @@ func (s *StartOptions) buildServerOptions() ([]temporal.ServerOption, *slog.Leve
 	for k, v := range s.DynamicConfigValues {
 		dynConf[dynamicconfig.MakeKey(k)] = v
 	}
-	opts = append(opts, temporal.WithDynamicConfigClient(dynConf))
+	server := &Server{logLevel: logLevel, localAddress: s.localFrontendAddress()}
+	if s.DynamicConfigFile != "" || s.DynamicConfigPort > 0 {
+		if server.dynamicConfig, err = newLiveDynamicConfigClient(s.DynamicConfigFile, dynConf, logger); err != nil {
+			return nil, nil, err
+		}
+		opts = append(opts, temporal.WithDynamicConfigClient(server.dynamicConfig))
+	} else {
+		opts = append(opts, temporal.WithDynamicConfigClient(dynConf))
+	}
 
 	// gRPC interceptors if set
 	if len(s.GRPCInterceptors) > 0 {
 		opts = append(opts, temporal.WithChainedFrontendGrpcInterceptors(s.GRPCInterceptors...))
 	}
 
-	return opts, logLevel, nil
+	return opts, server, nil
 }
 
 func (s *StartOptions) buildServerConfig() (*config.Config, error) {
@@ -319,6 +385,35 @@ func (s *StartOptions) buildServerConfig() (*config.Config, error) {
 	conf.NamespaceDefaults.Archival.History.State = "disabled"
 	conf.NamespaceDefaults.Archival.Visibility.State = "disabled"
 	conf.PublicClient.HostPort = fmt.Sprintf("%v:%v", MaybeEscapeIPv6(s.FrontendIP), s.FrontendPort)
+
+	// TLS and auth only apply to the frontend, everything else uses the
+	// internal frontend
+	if s.TLS != nil {
+		conf.Global.TLS.Frontend.Server = config.ServerTLS{
+			CertFile: s.TLS.CertFile,
+			KeyFile:  s.TLS.KeyFile,
+		}
+		if s.TLS.ClientCAFile != "" {
+			conf.Global.TLS.Frontend.Server.ClientCAFiles = []string{s.TLS.ClientCAFile}
+			conf.Global.TLS.Frontend.Server.RequireClientAuth = true
+		}
+	}
+	if s.Auth != nil {
+		conf.Global.Authorization.Authorizer = "default"
+		conf.Global.Authorization.ClaimMapper = "default"
+		conf.Global.Authorization.JWTKeyProvider.KeySourceURIs = s.Auth.KeySourceURIs
+		if len(s.Auth.KeySourceURIs) > 0 {
+			conf.Global.Authorization.JWTKeyProvider.RefreshInterval = jwksRefreshInterval
+		}
+	}
+	if s.internalFrontendPort > 0 {
+		var internal config.Service
+		internal.RPC.GRPCPort = s.internalFrontendPort
+		internal.RPC.BindOnIP = localhost
+		conf.Services[string(primitives.InternalFrontendService)] = internal
+		// Resolved to the internal frontend
+		conf.PublicClient.HostPort = ""
+	}
 	return &conf, nil
 }
 
@@ -355,6 +450,9 @@ func (s *StartOptions) buildSQLConfig() (*config.SQL, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed creating namespace config: %w", err)
 		}
+		if retention, ok := s.NamespaceRetention[ns]; ok {
+			nsConfig.Detail.Config.Retention = durationpb.New(retention)
+		}
 		namespaces[i] = nsConfig
 	}
 	if err := sqliteschema.CreateNamespaces(&conf, namespaces...); err != nil {
@@ -0,0 +1,106 @@
+package devserver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// BackupDatabase copies the SQLite database file at src to dst using SQLite's
+// online backup, so it is safe to call while a server is using src. The copy
+// is written beside dst and renamed so dst is never left partially written.
+func BackupDatabase(ctx context.Context, src, dst string) error {
+	if _, err := os.Stat(src); err != nil {
+		return fmt.Errorf("failed checking database file: %w", err)
+	}
+	tmp := dst + ".tmp"
+	_ = os.Remove(tmp)
+	if err := copyDatabase(ctx, src, tmp, false); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed writing backup: %w", err)
+	}
+	return nil
+}
+
+// RestoreDatabase replaces the contents of the SQLite database file at dst,
+// creating it if needed, with the database file at src. A server using dst
+// must be stopped first since it caches state read from the database.
+func RestoreDatabase(ctx context.Context, src, dst string) error {
+	if _, err := os.Stat(src); err != nil {
+		return fmt.Errorf("failed checking backup file: %w", err)
+	}
+	return copyDatabase(ctx, dst, src, true)
+}
+
+// copyDatabase opens file and backs it up to, or restores it from, other.
+func copyDatabase(ctx context.Context, file, other string, restore bool) error {
+	db, err := sql.Open("sqlite", file)
+	if err != nil {
+		return fmt.Errorf("failed opening database: %w", err)
+	}
+	defer db.Close()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed opening database: %w", err)
+	}
+	defer conn.Close()
+	return conn.Raw(func(driverConn any) error {
+		backuper, ok := driverConn.(interface {
+			NewBackup(string) (*sqlite.Backup, error)
+			NewRestore(string) (*sqlite.Backup, error)
+		})
+		if !ok {
+			return fmt.Errorf("unexpected SQLite driver connection %T", driverConn)
+		}
+		var backup *sqlite.Backup
+		var err error
+		if restore {
+			backup, err = backuper.NewRestore(other)
+		} else {
+			backup, err = backuper.NewBackup(other)
+		}
+		if err != nil {
+			return fmt.Errorf("failed starting backup: %w", err)
+		}
+		// Copy all pages in one step so the copy is consistent, retrying while
+		// the server holds a lock
+		for {
+			more, err := backup.Step(-1)
+			if err == nil && !more {
+				break
+			} else if err != nil && !isSQLiteBusy(err) {
+				_ = backup.Finish()
+				return fmt.Errorf("failed copying database: %w", err)
+			}
+			select {
+			case <-ctx.Done():
+				_ = backup.Finish()
+				return ctx.Err()
+			case <-time.After(50 * time.Millisecond):
+			}
+		}
+		if err := backup.Finish(); err != nil {
+			return fmt.Errorf("failed finishing backup: %w", err)
+		}
+		return nil
+	})
+}
+
+func isSQLiteBusy(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	code := sqliteErr.Code() & 0xff
+	return code == sqlite3.SQLITE_BUSY || code == sqlite3.SQLITE_LOCKED
+}
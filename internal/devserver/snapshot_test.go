@@ -0,0 +1,60 @@
+package devserver_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/temporalio/cli/internal/devserver"
+)
+
+func TestBackupRestoreDatabase(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	dbFile, backupFile := filepath.Join(dir, "temporal.db"), filepath.Join(dir, "backup.db")
+
+	db, err := sql.Open("sqlite", dbFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	exec := func(query string) {
+		if _, err := db.ExecContext(ctx, query); err != nil {
+			t.Fatal(err)
+		}
+	}
+	count := func() (n int) {
+		if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM items").Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	exec("CREATE TABLE items (id INTEGER)")
+	exec("INSERT INTO items VALUES (1)")
+
+	// Backup while the database is open
+	if err := devserver.BackupDatabase(ctx, dbFile, backupFile); err != nil {
+		t.Fatal(err)
+	}
+	exec("INSERT INTO items VALUES (2)")
+	if n := count(); n != 2 {
+		t.Fatalf("expected 2 items, got %v", n)
+	}
+
+	if err := devserver.RestoreDatabase(ctx, backupFile, dbFile); err != nil {
+		t.Fatal(err)
+	}
+	if n := count(); n != 1 {
+		t.Fatalf("expected 1 item after restore, got %v", n)
+	}
+
+	// Restore creates missing files
+	newFile := filepath.Join(dir, "new.db")
+	if err := devserver.RestoreDatabase(ctx, backupFile, newFile); err != nil {
+		t.Fatal(err)
+	}
+	if err := devserver.BackupDatabase(ctx, filepath.Join(dir, "missing.db"), backupFile); err == nil {
+		t.Fatal("expected error backing up missing file")
+	}
+}
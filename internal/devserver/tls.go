@@ -0,0 +1,125 @@
+package devserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TLSOptions enable TLS on the frontend.
+type TLSOptions struct {
+	CertFile string
+	KeyFile  string
+	// Requires client certificates signed by this CA when set
+	ClientCAFile string
+}
+
+// GeneratedTLSFiles are the files written by GenerateTLSFiles.
+type GeneratedTLSFiles struct {
+	CAFile         string
+	ServerCertFile string
+	ServerKeyFile  string
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+// generatedCertValidity is long enough for any dev server run.
+const generatedCertValidity = 365 * 24 * time.Hour
+
+// GenerateTLSFiles writes a self-signed CA, and server and client certificates
+// signed by it, to the directory. The server certificate is valid for the
+// hosts, which are IPs or DNS names.
+func GenerateTLSFiles(dir string, hosts []string) (*GeneratedTLSFiles, error) {
+	files := &GeneratedTLSFiles{
+		CAFile:         filepath.Join(dir, "ca.pem"),
+		ServerCertFile: filepath.Join(dir, "server.pem"),
+		ServerKeyFile:  filepath.Join(dir, "server-key.pem"),
+		ClientCertFile: filepath.Join(dir, "client.pem"),
+		ClientKeyFile:  filepath.Join(dir, "client-key.pem"),
+	}
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed generating CA key: %w", err)
+	}
+	ca := newCertTemplate("Temporal Dev Server CA")
+	ca.IsCA = true
+	ca.BasicConstraintsValid = true
+	ca.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, ca, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CA certificate: %w", err)
+	}
+	if err := writePEM(files.CAFile, "CERTIFICATE", caDER); err != nil {
+		return nil, err
+	}
+
+	server := newCertTemplate("Temporal Dev Server")
+	server.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			server.IPAddresses = append(server.IPAddresses, ip)
+		} else {
+			server.DNSNames = append(server.DNSNames, host)
+		}
+	}
+	if err := writeSignedCert(files.ServerCertFile, files.ServerKeyFile, server, ca, caKey); err != nil {
+		return nil, err
+	}
+	client := newCertTemplate("Temporal Dev Client")
+	client.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	if err := writeSignedCert(files.ClientCertFile, files.ClientKeyFile, client, ca, caKey); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func newCertTemplate(commonName string) *x509.Certificate {
+	serial, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(generatedCertValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+}
+
+func writeSignedCert(certFile, keyFile string, cert, ca *x509.Certificate, caKey *ecdsa.PrivateKey) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("failed generating key: %w", err)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, cert, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return fmt.Errorf("failed creating certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return fmt.Errorf("failed marshaling key: %w", err)
+	}
+	if err := writePEM(certFile, "CERTIFICATE", der); err != nil {
+		return err
+	}
+	return writePEM(keyFile, "PRIVATE KEY", keyDER)
+}
+
+func writePEM(file, blockType string, b []byte) error {
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed creating %v: %w", file, err)
+	}
+	defer f.Close()
+	if err := pem.Encode(f, &pem.Block{Type: blockType, Bytes: b}); err != nil {
+		return fmt.Errorf("failed writing %v: %w", file, err)
+	}
+	return f.Close()
+}
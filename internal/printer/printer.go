@@ -1,7 +1,9 @@
 package printer
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,6 +21,7 @@ import (
 	"go.temporal.io/api/common/v1"
 	"go.temporal.io/api/temporalproto"
 	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
 )
 
 const NonJSONIndent = "  "
@@ -32,6 +35,17 @@ type Printer struct {
 	// This is unset/empty in JSONL mode
 	JSONIndent           string
 	JSONPayloadShorthand bool
+	// Only used for JSON. If true, the JSON representation is converted to YAML
+	// before printing.
+	YAML bool
+	// If set, every structured value (or item of a structured iterator) is
+	// rendered through this instead, using the decoded JSON representation as
+	// data. A newline is added after each value if not already present. Set
+	// JSON along with this so plain text printing is ignored.
+	Template OutputTemplate
+	// Only used for non-JSON. If true, structured values are printed as CSV rows
+	// instead of tables or cards and plain text printing is ignored.
+	CSV bool
 	// Only used for non-JSON, defaults to RFC3339
 	FormatTime func(time.Time) string
 	// Only used for non-JSON, defaults to color.Magenta
@@ -39,23 +53,24 @@ type Printer struct {
 
 	listMode          bool
 	listModeFirstJSON bool // True until first JSON printed
+	yamlDocPrinted    bool // True after first non-list YAML document printed
 }
 
-// Ignored during JSON output
+// Ignored during JSON and CSV output
 func (p *Printer) Print(s ...string) {
-	if !p.JSON {
+	if !p.JSON && !p.CSV {
 		for _, v := range s {
 			p.writeStr(v)
 		}
 	}
 }
 
-// Ignored during JSON output
+// Ignored during JSON and CSV output
 func (p *Printer) Println(s ...string) {
 	p.Print(append(append([]string{}, s...), "\n")...)
 }
 
-// Ignored during JSON output
+// Ignored during JSON and CSV output
 func (p *Printer) Printlnf(s string, v ...any) {
 	p.Println(fmt.Sprintf(s, v...))
 }
@@ -65,7 +80,8 @@ func (p *Printer) Printlnf(s string, v ...any) {
 // as a list (but the indention and multiline posture of the JSON remains). When
 // called for JSON without indent, this will make sure all
 // [Printer.PrintStructured] is on its own line (i.e. JSONL mode). When called
-// for non-JSON, this is a no-op.
+// for YAML, this will make each [Printer.PrintStructured] an item of a single
+// YAML sequence. When called for non-JSON, this is a no-op.
 //
 // [Printer.EndList] must be called at the end. If this is called twice it will
 // panic. This and the end call are not safe for concurrent use.
@@ -75,7 +91,7 @@ func (p *Printer) StartList() {
 	}
 	p.listMode, p.listModeFirstJSON = true, true
 	// Write initial bracket when non-jsonl
-	if p.JSON && p.JSONIndent != "" {
+	if p.JSON && !p.YAML && p.Template == nil && p.JSONIndent != "" {
 		// Don't need newline, we count on initial object to do that
 		p.Output.Write([]byte("["))
 	}
@@ -87,9 +103,17 @@ func (p *Printer) EndList() {
 	if !p.listMode {
 		panic("not in list mode")
 	}
+	listModeEmpty := p.listModeFirstJSON
 	p.listMode, p.listModeFirstJSON = false, false
+	// YAML has no brackets, but an empty list must still be a valid document
+	if p.JSON && p.YAML {
+		if listModeEmpty {
+			p.Output.Write([]byte("[]\n"))
+		}
+		return
+	}
 	// Write ending bracket when non-jsonl
-	if p.JSON && p.JSONIndent != "" {
+	if p.JSON && p.Template == nil && p.JSONIndent != "" {
 		// We prepend a newline because non-jsonl list mode doesn't do so after each
 		// line to help with commas
 		p.Output.Write([]byte("\n]\n"))
@@ -97,7 +121,9 @@ func (p *Printer) EndList() {
 }
 
 type StructuredOptions struct {
-	// Derived if not present. Ignored for JSON printing.
+	// Derived if not present. Ignored for JSON printing. For CSV printing, nested
+	// struct and map values of these fields are flattened into additional
+	// columns.
 	Fields []string
 	// Ignored for JSON printing.
 	ExcludeFields []string
@@ -142,6 +168,11 @@ func (p *Printer) PrintStructured(v any, options StructuredOptions) error {
 	}
 	cols = adjustColsToOptions(cols, options)
 
+	// CSV
+	if p.CSV {
+		return p.printCSV(cols, rows, options.Table == nil || !options.Table.NoHeader)
+	}
+
 	// Text table
 	if options.Table != nil {
 		p.calculateUnsetColWidths(cols, rows)
@@ -168,6 +199,17 @@ func (p *Printer) PrintStructuredTableIter(
 	if options.Table == nil {
 		return fmt.Errorf("must be table")
 	}
+	if p.Template != nil {
+		for {
+			v, err := iter.Next()
+			if v == nil || err != nil {
+				return err
+			}
+			if err := p.printTemplate(v, options); err != nil {
+				return err
+			}
+		}
+	}
 	cols := options.toPredefinedCols()
 	if len(cols) == 0 {
 		var err error
@@ -176,6 +218,9 @@ func (p *Printer) PrintStructuredTableIter(
 		}
 	}
 	cols = adjustColsToOptions(cols, options)
+	if p.CSV {
+		return p.printCSVIter(cols, iter, !options.Table.NoHeader)
+	}
 	// We're intentionally not calculating field lengths and only accepting them
 	// since this is streaming
 	p.printHeader(cols)
@@ -230,6 +275,11 @@ func (p *Printer) writef(s string, v ...any) {
 }
 
 func (p *Printer) printJSON(v any, options StructuredOptions) error {
+	if p.Template != nil {
+		return p.printTemplate(v, options)
+	} else if p.YAML {
+		return p.printYAML(v, options)
+	}
 	// Before printing, if we're in non-jsonl list mode, we must append a comma
 	// and a newline if we're not the first JSON seen.
 	nonJSONLListMode := p.listMode && p.JSON && p.JSONIndent != ""
@@ -633,3 +683,204 @@ func deriveColFromField(f reflect.StructField) *col {
 	}
 	return col
 }
+
+func (p *Printer) printTemplate(v any, options StructuredOptions) error {
+	shorthandPayloads := p.JSONPayloadShorthand
+	if options.OverrideJSONPayloadShorthand != nil {
+		shorthandPayloads = *options.OverrideJSONPayloadShorthand
+	}
+	b, err := p.jsonVal(v, "", shorthandPayloads)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var data any
+	if err := dec.Decode(&data); err != nil {
+		return fmt.Errorf("failed decoding JSON for output template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := p.Template.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed executing output template: %w", err)
+	}
+	if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	_, err = p.Output.Write(buf.Bytes())
+	return err
+}
+
+func (p *Printer) printYAML(v any, options StructuredOptions) error {
+	shorthandPayloads := p.JSONPayloadShorthand
+	if options.OverrideJSONPayloadShorthand != nil {
+		shorthandPayloads = *options.OverrideJSONPayloadShorthand
+	}
+	b, err := p.yamlVal(v, shorthandPayloads)
+	if err != nil {
+		return err
+	}
+	// In list mode, every value is an item of one sequence. Otherwise, every
+	// value is its own document.
+	if p.listMode {
+		p.listModeFirstJSON = false
+		lines := strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+		for i, line := range lines {
+			if i == 0 {
+				lines[i] = "- " + line
+			} else if line != "" {
+				lines[i] = "  " + line
+			}
+		}
+		b = []byte(strings.Join(lines, "\n") + "\n")
+	} else if p.yamlDocPrinted {
+		b = append([]byte("---\n"), b...)
+	}
+	p.yamlDocPrinted = true
+	_, err = p.Output.Write(b)
+	return err
+}
+
+// Converts the JSON representation (so protos are still protojson encoded) to
+// block-style YAML retaining field order.
+func (p *Printer) yamlVal(v any, shorthandPayloads bool) ([]byte, error) {
+	b, err := p.jsonVal(v, "", shorthandPayloads)
+	if err != nil {
+		return nil, err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return nil, fmt.Errorf("failed converting JSON to YAML: %w", err)
+	}
+	clearYAMLStyle(&node)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, fmt.Errorf("failed encoding YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed encoding YAML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// JSON parses as flow-style, quoted YAML, so this resets the style for the
+// encoder to choose plain block style wherever the value allows it.
+func clearYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearYAMLStyle(child)
+	}
+}
+
+func (p *Printer) printCSV(cols []*col, rows []map[string]colVal, header bool) error {
+	flatRows := make([]map[string]string, len(rows))
+	var flatCols []string
+	for i, row := range rows {
+		flatRows[i] = map[string]string{}
+		for _, col := range cols {
+			p.csvFlatten(col.name, row[col.name].val, flatRows[i], &flatCols)
+		}
+	}
+	w := csv.NewWriter(p.Output)
+	if header {
+		_ = w.Write(flatCols)
+	}
+	for _, flatRow := range flatRows {
+		_ = w.Write(csvRecord(flatCols, flatRow))
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// Columns are flattened based on the first row since this is streaming
+func (p *Printer) printCSVIter(cols []*col, iter PrintStructuredIter, header bool) error {
+	w := csv.NewWriter(p.Output)
+	var flatCols []string
+	for first := true; ; first = false {
+		v, err := iter.Next()
+		if v == nil || err != nil {
+			w.Flush()
+			if err == nil {
+				err = w.Error()
+			}
+			return err
+		}
+		row, err := p.tableRowData(cols, v)
+		if err != nil {
+			return err
+		}
+		flatRow := map[string]string{}
+		for _, col := range cols {
+			var rowCols []string
+			p.csvFlatten(col.name, row[col.name].val, flatRow, &rowCols)
+			if first {
+				flatCols = append(flatCols, rowCols...)
+			}
+		}
+		if first && header {
+			_ = w.Write(flatCols)
+		}
+		_ = w.Write(csvRecord(flatCols, flatRow))
+		// Flush every row so output streams
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return err
+		}
+	}
+}
+
+func csvRecord(cols []string, row map[string]string) []string {
+	record := make([]string, len(cols))
+	for i, col := range cols {
+		record[i] = row[col]
+	}
+	return record
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Sets the CSV cell text for the value under the given name, expanding plain
+// structs and string-keyed maps into "name.Field" cells. Every name not yet in
+// cols is appended to it.
+func (p *Printer) csvFlatten(name string, v any, row map[string]string, cols *[]string) {
+	ref := reflect.Indirect(reflect.ValueOf(v))
+	if ref.IsValid() && ref.CanInterface() {
+		_, isProto := v.(proto.Message)
+		switch {
+		case isProto || ref.Type() == timeType || ref.Type().Implements(jsonMarshalerType):
+		case ref.Kind() == reflect.Struct:
+			for i := 0; i < ref.NumField(); i++ {
+				if f := ref.Type().Field(i); f.IsExported() && !slices.Contains(strings.Split(f.Tag.Get("cli"), ","), "omit") {
+					p.csvFlatten(name+"."+f.Name, ref.Field(i).Interface(), row, cols)
+				}
+			}
+			return
+		case ref.Kind() == reflect.Map && ref.Type().Key().Kind() == reflect.String:
+			keys := ref.MapKeys()
+			slices.SortFunc(keys, func(a, b reflect.Value) int { return strings.Compare(a.String(), b.String()) })
+			for _, key := range keys {
+				p.csvFlatten(name+"."+key.String(), ref.MapIndex(key).Interface(), row, cols)
+			}
+			return
+		}
+	}
+	if !slices.Contains(*cols, name) {
+		*cols = append(*cols, name)
+	}
+	row[name] = p.csvVal(v)
+}
+
+// Like textVal but with times always in RFC3339 since CSV is for machines
+func (p *Printer) csvVal(v any) string {
+	ref := reflect.Indirect(reflect.ValueOf(v))
+	if !ref.IsValid() {
+		return ""
+	} else if ref.Type() == timeType {
+		if ref.IsZero() {
+			return ""
+		}
+		return ref.Interface().(time.Time).Format(time.RFC3339)
+	}
+	return p.textVal(v)
+}
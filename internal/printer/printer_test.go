@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 	"unicode"
 
 	"github.com/stretchr/testify/require"
@@ -144,6 +145,135 @@ func TestPrinter_JSONList(t *testing.T) {
 	require.Equal(t, "", buf.String())
 }
 
+func TestPrinter_CSV(t *testing.T) {
+	type Nested struct {
+		Key   string
+		Value int
+	}
+	type MyStruct struct {
+		Foo     string
+		Nested  Nested
+		Labels  map[string]string
+		When    time.Time
+		Omitted string `cli:",omit"`
+	}
+	var buf bytes.Buffer
+	p := printer.Printer{Output: &buf, CSV: true}
+	p.Println("should not print")
+	require.NoError(t, p.PrintStructured([]*MyStruct{
+		{
+			Foo:     "a,b",
+			Nested:  Nested{Key: "k", Value: 1},
+			Labels:  map[string]string{"y": "2", "x": "1"},
+			When:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Omitted: "value",
+		},
+		{Foo: "c"},
+	}, printer.StructuredOptions{Table: &printer.TableOptions{}}))
+	require.Equal(t, `Foo,Nested.Key,Nested.Value,Labels.x,Labels.y,When
+"a,b",k,1,1,2,2024-01-02T03:04:05Z
+c,,0,,,
+`, buf.String())
+
+	// No header for subsequent pages
+	buf.Reset()
+	require.NoError(t, p.PrintStructured([]map[string]any{{"Foo": "d"}}, printer.StructuredOptions{
+		Fields: []string{"Foo"},
+		Table:  &printer.TableOptions{NoHeader: true},
+	}))
+	require.Equal(t, "d\n", buf.String())
+}
+
+func TestPrinter_YAML(t *testing.T) {
+	var buf bytes.Buffer
+	p := printer.Printer{Output: &buf, JSON: true, YAML: true}
+	p.Println("should not print")
+	require.NoError(t, p.PrintStructured(struct {
+		Foo string         `json:"foo"`
+		Bar map[string]int `json:"bar"`
+		Baz []string       `json:"baz"`
+	}{Foo: "123", Bar: map[string]int{"qux": 1}, Baz: []string{"a", "b"}}, printer.StructuredOptions{}))
+	require.NoError(t, p.PrintStructured(map[string]string{"foo": "bar"}, printer.StructuredOptions{}))
+	require.Equal(t, `foo: "123"
+bar:
+  qux: 1
+baz:
+  - a
+  - b
+---
+foo: bar
+`, buf.String())
+}
+
+func TestPrinter_YAMLList(t *testing.T) {
+	var buf bytes.Buffer
+	p := printer.Printer{Output: &buf, JSON: true, YAML: true}
+	p.StartList()
+	require.NoError(t, p.PrintStructured(map[string]any{"foo": map[string]string{"bar": "baz"}}, printer.StructuredOptions{}))
+	require.NoError(t, p.PrintStructured(map[string]string{"qux": "quux"}, printer.StructuredOptions{}))
+	p.EndList()
+	require.Equal(t, `- foo:
+    bar: baz
+- qux: quux
+`, buf.String())
+
+	// Empty
+	buf.Reset()
+	p.StartList()
+	p.EndList()
+	require.Equal(t, "[]\n", buf.String())
+}
+
+func TestPrinter_GoTemplate(t *testing.T) {
+	var buf bytes.Buffer
+	tmpl, err := printer.NewGoTemplate(`{{.foo}} {{index .bar 1}} {{json .baz}}`)
+	require.NoError(t, err)
+	p := printer.Printer{Output: &buf, JSON: true, JSONIndent: "  ", Template: tmpl}
+	p.StartList()
+	p.Println("should not print")
+	require.NoError(t, p.PrintStructured(map[string]any{
+		"foo": "a",
+		"bar": []int{1, 2},
+		"baz": map[string]string{"qux": "quux"},
+	}, printer.StructuredOptions{}))
+	require.NoError(t, p.PrintStructured(map[string]any{"foo": "b", "bar": []int{3, 4}}, printer.StructuredOptions{}))
+	p.EndList()
+	require.Equal(t, "a 2 {\"qux\":\"quux\"}\nb 4 null\n", buf.String())
+}
+
+func TestPrinter_JSONPath(t *testing.T) {
+	data := map[string]any{
+		"name": "a",
+		"items": []any{
+			map[string]any{"id": 1, "tags": map[string]any{"x": "y"}},
+			map[string]any{"id": 2, "nested": map[string]any{"id": 3}},
+		},
+		"odd key": true,
+	}
+	for tmpl, expected := range map[string]string{
+		`{.name}`:                    "a\n",
+		`{$.name}{"\t"}{name}{"\n"}`: "a\ta\n",
+		`{.items[*].id}`:             "1 2\n",
+		`{.items[-1].id}`:            "2\n",
+		`{..id}`:                     "1 2 3\n",
+		`{.items[0].tags}`:           "{\"x\":\"y\"}\n",
+		`{['odd key']}`:              "true\n",
+		`{.missing}`:                 "\n",
+		`id={.items[1].id}`:          "id=2\n",
+	} {
+		var buf bytes.Buffer
+		jsonPath, err := printer.NewJSONPath(tmpl)
+		require.NoError(t, err, tmpl)
+		p := printer.Printer{Output: &buf, JSON: true, Template: jsonPath}
+		require.NoError(t, p.PrintStructured(data, printer.StructuredOptions{}), tmpl)
+		require.Equal(t, expected, buf.String(), tmpl)
+	}
+	for _, tmpl := range []string{`{.name`, `{.items[0}`, `{.items[x]}`, `{..}`, `{"unterminated}`} {
+		_, err := printer.NewJSONPath(tmpl)
+		require.Error(t, err, tmpl)
+	}
+}
+
 // Asserts the printer package don't panic if the CLI is run without a STDOUT.
 // This is a tricky thing to validate, as it must be done in a subprocess and as
 // `go test` has its own internal fix for improper STDOUT. This was fixed in
@@ -0,0 +1,286 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// OutputTemplate renders a single structured value. The data given is the
+// decoded JSON representation of the value, so proto messages appear with
+// their protojson field names and payload shorthand is respected.
+type OutputTemplate interface {
+	Execute(w io.Writer, data any) error
+}
+
+// NewGoTemplate parses a Go text/template for use as [Printer.Template]. In
+// addition to the builtin functions, "json" renders its argument as compact
+// JSON.
+func NewGoTemplate(text string) (OutputTemplate, error) {
+	return template.New("output").Option("missingkey=zero").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(text)
+}
+
+// NewJSONPath parses a kubectl-style JSONPath template for use as
+// [Printer.Template]. The template is literal text with expressions in braces,
+// e.g. "{.workflowExecutionInfo.status}". Expressions support "$", ".field",
+// "..field" (recursive descent), "[n]" (negative counts from the end), "[*]",
+// ".*", and ['field']. A brace-wrapped quoted string such as {"\n"} is
+// printed unquoted. When an expression matches multiple values, they are
+// space-separated.
+func NewJSONPath(text string) (OutputTemplate, error) {
+	var t jsonPathTemplate
+	for len(text) > 0 {
+		start := strings.IndexByte(text, '{')
+		if start < 0 {
+			t.parts = append(t.parts, jsonPathPart{literal: text})
+			break
+		}
+		if start > 0 {
+			t.parts = append(t.parts, jsonPathPart{literal: text[:start]})
+		}
+		end := jsonPathExprEnd(text, start+1)
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed JSONPath expression at offset %v", start)
+		}
+		expr := strings.TrimSpace(text[start+1 : end])
+		if strings.HasPrefix(expr, `"`) {
+			literal, err := strconv.Unquote(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSONPath string literal %v: %w", expr, err)
+			}
+			t.parts = append(t.parts, jsonPathPart{literal: literal})
+		} else {
+			steps, err := parseJSONPathSteps(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSONPath expression %q: %w", expr, err)
+			}
+			t.parts = append(t.parts, jsonPathPart{steps: steps})
+		}
+		text = text[end+1:]
+	}
+	return &t, nil
+}
+
+type jsonPathTemplate struct {
+	parts []jsonPathPart
+}
+
+type jsonPathPart struct {
+	// Only set if steps is not
+	literal string
+	steps   []jsonPathStep
+}
+
+type jsonPathStepKind int
+
+const (
+	jsonPathStepField jsonPathStepKind = iota
+	jsonPathStepIndex
+	jsonPathStepWildcard
+	jsonPathStepRecursive
+)
+
+type jsonPathStep struct {
+	kind  jsonPathStepKind
+	field string
+	index int
+}
+
+// Index of the closing brace, skipping over braces in quoted strings
+func jsonPathExprEnd(text string, from int) int {
+	var quote byte
+	for i := from; i < len(text); i++ {
+		switch c := text[i]; {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == 0 && c == '}':
+			return i
+		}
+	}
+	return -1
+}
+
+func parseJSONPathSteps(expr string) ([]jsonPathStep, error) {
+	expr = strings.TrimPrefix(expr, "$")
+	var steps []jsonPathStep
+	for len(expr) > 0 {
+		switch {
+		case strings.HasPrefix(expr, ".."):
+			expr = expr[2:]
+			steps = append(steps, jsonPathStep{kind: jsonPathStepRecursive})
+			// Recursive descent must be followed by a name or wildcard
+			if expr == "" || expr[0] == '.' {
+				return nil, fmt.Errorf("expected name after ..")
+			} else if expr[0] != '[' {
+				expr = "." + expr
+			}
+		case expr[0] == '.':
+			end := strings.IndexAny(expr[1:], ".[")
+			if end < 0 {
+				end = len(expr) - 1
+			}
+			name := expr[1 : end+1]
+			if name == "" {
+				return nil, fmt.Errorf("empty field name")
+			} else if name == "*" {
+				steps = append(steps, jsonPathStep{kind: jsonPathStepWildcard})
+			} else {
+				steps = append(steps, jsonPathStep{kind: jsonPathStepField, field: name})
+			}
+			expr = expr[end+1:]
+		case expr[0] == '[':
+			end := jsonPathBracketEnd(expr)
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed bracket")
+			}
+			inner := strings.TrimSpace(expr[1:end])
+			switch {
+			case inner == "*":
+				steps = append(steps, jsonPathStep{kind: jsonPathStepWildcard})
+			case strings.HasPrefix(inner, "'") || strings.HasPrefix(inner, `"`):
+				if len(inner) < 2 || inner[len(inner)-1] != inner[0] {
+					return nil, fmt.Errorf("invalid quoted field %v", inner)
+				}
+				steps = append(steps, jsonPathStep{kind: jsonPathStepField, field: inner[1 : len(inner)-1]})
+			default:
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid index %v", inner)
+				}
+				steps = append(steps, jsonPathStep{kind: jsonPathStepIndex, index: index})
+			}
+			expr = expr[end+1:]
+		default:
+			// Allow a leading field name without a dot
+			if len(steps) > 0 {
+				return nil, fmt.Errorf("unexpected %q", expr)
+			}
+			expr = "." + expr
+		}
+	}
+	return steps, nil
+}
+
+func jsonPathBracketEnd(expr string) int {
+	var quote byte
+	for i := 1; i < len(expr); i++ {
+		switch c := expr[i]; {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == 0 && c == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *jsonPathTemplate) Execute(w io.Writer, data any) error {
+	var buf bytes.Buffer
+	for _, part := range t.parts {
+		if part.steps == nil {
+			buf.WriteString(part.literal)
+			continue
+		}
+		for i, v := range evalJSONPath(part.steps, data) {
+			if i > 0 {
+				buf.WriteString(" ")
+			}
+			switch v := v.(type) {
+			case string:
+				buf.WriteString(v)
+			case json.Number:
+				buf.WriteString(v.String())
+			case map[string]any, []any:
+				b, err := json.Marshal(v)
+				if err != nil {
+					return err
+				}
+				buf.Write(b)
+			default:
+				fmt.Fprintf(&buf, "%v", v)
+			}
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Missing values are skipped instead of failing so a template can be applied
+// to every item of a list where some items lack a field
+func evalJSONPath(steps []jsonPathStep, data any) []any {
+	vals := []any{data}
+	for _, step := range steps {
+		var next []any
+		for _, val := range vals {
+			switch step.kind {
+			case jsonPathStepField:
+				if m, ok := val.(map[string]any); ok {
+					if v, ok := m[step.field]; ok {
+						next = append(next, v)
+					}
+				}
+			case jsonPathStepIndex:
+				if s, ok := val.([]any); ok {
+					index := step.index
+					if index < 0 {
+						index += len(s)
+					}
+					if index >= 0 && index < len(s) {
+						next = append(next, s[index])
+					}
+				}
+			case jsonPathStepWildcard:
+				next = append(next, jsonPathChildren(val)...)
+			case jsonPathStepRecursive:
+				next = append(next, jsonPathDescendants(val)...)
+			}
+		}
+		vals = next
+	}
+	return vals
+}
+
+// Map children are in key order since JSON object order is not retained
+func jsonPathChildren(val any) []any {
+	switch val := val.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		children := make([]any, len(keys))
+		for i, k := range keys {
+			children[i] = val[k]
+		}
+		return children
+	case []any:
+		return val
+	}
+	return nil
+}
+
+// Includes the value itself
+func jsonPathDescendants(val any) []any {
+	ret := []any{val}
+	for _, child := range jsonPathChildren(val) {
+		ret = append(ret, jsonPathDescendants(child)...)
+	}
+	return ret
+}
@@ -97,6 +97,25 @@ func dialClientWithCodec(cctx *CommandContext, c *cliext.ClientOptions) (client.
 	return cl, builder.PayloadCodec, nil
 }
 
+// loadPayloadCodec returns the configured remote payload codec without dialing
+// a client, or nil if no codec is configured. This is for commands that decode
+// payloads read from somewhere other than the server, such as history files.
+func loadPayloadCodec(cctx *CommandContext, c *cliext.ClientOptions) (converter.PayloadCodec, error) {
+	if cctx.RootCommand == nil {
+		return nil, fmt.Errorf("root command unexpectedly missing when loading codec")
+	}
+	builder := &cliext.ClientOptionsBuilder{
+		CommonOptions: cctx.RootCommand.CommonOptions,
+		ClientOptions: *c,
+		EnvLookup:     cctx.Options.EnvLookup,
+		Logger:        cctx.Logger,
+	}
+	if _, err := builder.Build(cctx); err != nil {
+		return nil, err
+	}
+	return builder.PayloadCodec, nil
+}
+
 func fixedHeaderOverrideInterceptor(
 	ctx context.Context,
 	method string, req, reply any,
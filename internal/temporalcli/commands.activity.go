@@ -574,6 +574,10 @@ func (s *ActivityReferenceOrBatchOptions) activityExecOrBatch(
 			return nil, nil, fmt.Errorf("cannot set 'yes' when activity ID is set")
 		} else if s.Rps != 0 {
 			return nil, nil, fmt.Errorf("cannot set rps when activity ID is set")
+		} else if overrides.DryRun {
+			return nil, nil, fmt.Errorf("cannot set dry run when activity ID is set")
+		} else if overrides.Wait {
+			return nil, nil, fmt.Errorf("cannot set wait when activity ID is set")
 		}
 		return &client.GetActivityHandleOptions{
 			ActivityID: s.ActivityId,
@@ -590,24 +594,26 @@ func (s *ActivityReferenceOrBatchOptions) activityExecOrBatch(
 		return nil, nil, fmt.Errorf("cannot set run ID when query is set")
 	}
 
-	// The count is only used in the confirmation prompt; skip the request when --yes
-	// bypasses it, so batch jobs can still proceed if the visibility API is timing out.
-	var promptMessage string
-	if yesFlag {
-		promptMessage = fmt.Sprintf("Start batch against standalone activities matching query %q? y/N", s.Query)
-	} else {
-		count, err := cl.CountActivities(cctx, client.CountActivitiesOptions{Query: s.Query})
+	if !overrides.DryRun {
+		// The count is only used in the confirmation prompt; skip the request when --yes
+		// bypasses it, so batch jobs can still proceed if the visibility API is timing out.
+		var promptMessage string
+		if yesFlag {
+			promptMessage = fmt.Sprintf("Start batch against standalone activities matching query %q? y/N", s.Query)
+		} else {
+			count, err := cl.CountActivities(cctx, client.CountActivitiesOptions{Query: s.Query})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed counting standalone activities from query: %w", err)
+			}
+			promptMessage = fmt.Sprintf("Start batch against approximately %v standalone activities(s)? y/N", count.Count)
+		}
+		isYes, err := cctx.promptYes(promptMessage, yesFlag)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed counting standalone activities from query: %w", err)
+			return nil, nil, err
+		} else if !isYes {
+			// We consider this a command failure
+			return nil, nil, fmt.Errorf("user denied confirmation")
 		}
-		promptMessage = fmt.Sprintf("Start batch against approximately %v standalone activities(s)? y/N", count.Count)
-	}
-	isYes, err := cctx.promptYes(promptMessage, yesFlag)
-	if err != nil {
-		return nil, nil, err
-	} else if !isYes {
-		// We consider this a command failure
-		return nil, nil, fmt.Errorf("user denied confirmation")
 	}
 
 	return nil, &workflowservice.StartBatchOperationRequest{
@@ -632,7 +638,7 @@ func (c *TemporalActivityCancelCommand) run(cctx *CommandContext, args []string)
 		Rps:        c.Rps,
 	}
 
-	activityOptions, batchReq, err := opts.activityExecOrBatch(cctx, c.Parent.Namespace, cl, c.Yes, singleOrBatchOverrides{})
+	activityOptions, batchReq, err := opts.activityExecOrBatch(cctx, c.Parent.Namespace, cl, c.Yes, singleOrBatchOverrides{DryRun: c.DryRun, Wait: c.Wait})
 	if err != nil {
 		return err
 	}
@@ -656,7 +662,7 @@ func (c *TemporalActivityCancelCommand) run(cctx *CommandContext, args []string)
 			CancelActivitiesOperation: cancelActivitiesOperation,
 		}
 
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -677,7 +683,7 @@ func (c *TemporalActivityTerminateCommand) run(cctx *CommandContext, args []stri
 		Rps:        c.Rps,
 	}
 
-	activityOptions, batchReq, err := opts.activityExecOrBatch(cctx, c.Parent.Namespace, cl, c.Yes, singleOrBatchOverrides{})
+	activityOptions, batchReq, err := opts.activityExecOrBatch(cctx, c.Parent.Namespace, cl, c.Yes, singleOrBatchOverrides{DryRun: c.DryRun, Wait: c.Wait})
 	if err != nil {
 		return err
 	}
@@ -705,7 +711,7 @@ func (c *TemporalActivityTerminateCommand) run(cctx *CommandContext, args []stri
 			TerminateActivitiesOperation: terminateActivitiesOperation,
 		}
 
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -737,6 +743,8 @@ func (c *TemporalActivityDeleteCommand) run(cctx *CommandContext, args []string)
 
 	activityOptions, batchReq, err := opts.activityExecOrBatch(cctx, c.Parent.Namespace, cl, c.Yes, singleOrBatchOverrides{
 		AllowYesWithActivityID: true,
+		DryRun:                 c.DryRun,
+		Wait:                   c.Wait,
 	})
 	if err != nil {
 		return err
@@ -765,7 +773,7 @@ func (c *TemporalActivityDeleteCommand) run(cctx *CommandContext, args []string)
 			DeleteActivitiesOperation: deleteActivitiesOperation,
 		}
 
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -936,10 +944,14 @@ func (c *TemporalActivityUpdateOptionsCommand) run(cctx *CommandContext, args []
 	if c.WorkflowId == "" && c.Query == "" {
 		if c.ActivityId == "" {
 			return errActivityTarget
+		} else if c.DryRun {
+			return fmt.Errorf("cannot set dry run without query")
+		} else if c.Wait {
+			return fmt.Errorf("cannot set wait without query")
 		}
 		exec = &common.WorkflowExecution{RunId: c.RunId}
 	} else {
-		exec, batchReq, err = opts.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{})
+		exec, batchReq, err = opts.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{DryRun: c.DryRun, Wait: c.Wait})
 		if err != nil {
 			return err
 		}
@@ -1003,7 +1015,7 @@ func (c *TemporalActivityUpdateOptionsCommand) run(cctx *CommandContext, args []
 			UpdateActivityOptionsOperation: updateActivitiesOperation,
 		}
 
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -1079,10 +1091,14 @@ func (c *TemporalActivityUnpauseCommand) run(cctx *CommandContext, args []string
 	if c.WorkflowId == "" && c.Query == "" {
 		if c.ActivityId == "" {
 			return errActivityTarget
+		} else if c.DryRun {
+			return fmt.Errorf("cannot set dry run without query")
+		} else if c.Wait {
+			return fmt.Errorf("cannot set wait without query")
 		}
 		exec = &common.WorkflowExecution{RunId: c.RunId}
 	} else {
-		exec, batchReq, err = opts.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{})
+		exec, batchReq, err = opts.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{DryRun: c.DryRun, Wait: c.Wait})
 		if err != nil {
 			return err
 		}
@@ -1123,7 +1139,7 @@ func (c *TemporalActivityUnpauseCommand) run(cctx *CommandContext, args []string
 			UnpauseActivitiesOperation: unpauseActivitiesOperation,
 		}
 
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -1159,10 +1175,14 @@ func (c *TemporalActivityResetCommand) run(cctx *CommandContext, args []string)
 	if c.WorkflowId == "" && c.Query == "" {
 		if c.ActivityId == "" {
 			return errActivityTarget
+		} else if c.DryRun {
+			return fmt.Errorf("cannot set dry run without query")
+		} else if c.Wait {
+			return fmt.Errorf("cannot set wait without query")
 		}
 		exec = &common.WorkflowExecution{RunId: c.RunId}
 	} else {
-		exec, batchReq, err = opts.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{})
+		exec, batchReq, err = opts.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{DryRun: c.DryRun, Wait: c.Wait})
 		if err != nil {
 			return err
 		}
@@ -1217,7 +1237,7 @@ func (c *TemporalActivityResetCommand) run(cctx *CommandContext, args []string)
 			ResetActivitiesOperation: resetActivitiesOperation,
 		}
 
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -1,14 +1,21 @@
 package temporalcli
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/fatih/color"
 	"github.com/temporalio/cli/internal/printer"
+	"github.com/temporalio/cli/internal/tracer"
+	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -36,15 +43,13 @@ func (c TemporalBatchDescribeCommand) run(cctx *CommandContext, args []string) e
 	}
 	defer cl.Close()
 
-	resp, err := cl.WorkflowService().DescribeBatchOperation(cctx, &workflowservice.DescribeBatchOperationRequest{
-		Namespace: c.Parent.Namespace,
-		JobId:     c.JobId,
-	})
-	var notFound *serviceerror.NotFound
-	if errors.As(err, &notFound) {
-		return fmt.Errorf("could not find Batch Job '%v'", c.JobId)
-	} else if err != nil {
-		return fmt.Errorf("failed to describe batch job: %w", err)
+	if c.Follow {
+		return followBatchJob(cctx, cl, c.Parent.Namespace, c.JobId)
+	}
+
+	resp, err := describeBatchJob(cctx, cl, c.Parent.Namespace, c.JobId)
+	if err != nil {
+		return err
 	}
 
 	if cctx.JSONOutput {
@@ -63,6 +68,306 @@ func (c TemporalBatchDescribeCommand) run(cctx *CommandContext, args []string) e
 	return nil
 }
 
+func describeBatchJob(
+	cctx *CommandContext,
+	cl client.Client,
+	namespace string,
+	jobId string,
+) (*workflowservice.DescribeBatchOperationResponse, error) {
+	resp, err := cl.WorkflowService().DescribeBatchOperation(cctx, &workflowservice.DescribeBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobId,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return nil, fmt.Errorf("could not find Batch Job '%v'", jobId)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to describe batch job: %w", err)
+	}
+	return resp, nil
+}
+
+// batchFollowInterval is how often a followed batch job's progress is refreshed.
+var batchFollowInterval = time.Second
+
+type batchProgressEvent struct {
+	JobId          string    `json:"jobId"`
+	State          string    `json:"state"`
+	CompletedCount int64     `json:"completedCount"`
+	FailureCount   int64     `json:"failureCount"`
+	TotalCount     int64     `json:"totalCount"`
+	Time           time.Time `json:"time"`
+}
+
+// followBatchJob refreshes the progress of a batch job until it is no longer
+// running. Progress is a progress bar in text mode and a JSON line per change
+// in JSON mode. An error is returned if the job or any of its operations fail.
+func followBatchJob(cctx *CommandContext, cl client.Client, namespace, jobId string) error {
+	var writer *tracer.TermWriter
+	if cctx.JSONOutput {
+		// Each event is a list item, so a line each in JSONL mode
+		cctx.Printer.StartList()
+		defer cctx.Printer.EndList()
+	} else {
+		writer = tracer.NewTermWriter(cctx.Printer.Output)
+	}
+	var last batchProgressEvent
+	for {
+		resp, err := describeBatchJob(cctx, cl, namespace, jobId)
+		if err != nil {
+			return err
+		}
+		event := batchProgressEvent{
+			JobId:          jobId,
+			State:          resp.State.String(),
+			CompletedCount: resp.CompleteOperationCount,
+			FailureCount:   resp.FailureOperationCount,
+			TotalCount:     resp.TotalOperationCount,
+		}
+		// Only the time differs on refreshes without progress
+		if event != last {
+			last = event
+			event.Time = time.Now()
+			if cctx.JSONOutput {
+				if err := cctx.Printer.PrintStructured(event, printer.StructuredOptions{}); err != nil {
+					return err
+				}
+			} else {
+				_, _ = writer.WriteLine(batchProgressLine(&event))
+				if err := writer.Flush(false); err != nil {
+					return err
+				}
+			}
+		}
+
+		if resp.State != enums.BATCH_OPERATION_STATE_RUNNING {
+			if resp.State == enums.BATCH_OPERATION_STATE_FAILED {
+				return fmt.Errorf("batch job '%v' failed", jobId)
+			} else if resp.FailureOperationCount > 0 {
+				return fmt.Errorf("batch job '%v' finished with %v of %v operation(s) failed",
+					jobId, resp.FailureOperationCount, resp.TotalOperationCount)
+			}
+			return nil
+		}
+		select {
+		case <-cctx.Done():
+			return cctx.Err()
+		case <-time.After(batchFollowInterval):
+		}
+	}
+}
+
+func batchProgressLine(event *batchProgressEvent) string {
+	const barWidth = 30
+	filled := 0
+	if event.TotalCount > 0 {
+		filled = int(min(event.CompletedCount+event.FailureCount, event.TotalCount) * barWidth / event.TotalCount)
+	}
+	return fmt.Sprintf("[%v%v] %v/%v completed, %v failed (%v)",
+		strings.Repeat("#", filled), strings.Repeat("-", barWidth-filled),
+		event.CompletedCount, event.TotalCount, event.FailureCount, event.State)
+}
+
+type (
+	batchPreview struct {
+		Query      string                  `json:"query"`
+		Count      int64                   `json:"count"`
+		Executions []batchPreviewExecution `json:"executions"`
+		// Counted across all matches by the server, which can only group by
+		// status
+		ByStatus []batchPreviewCount `json:"byStatus"`
+		// Counted across the listed executions only
+		SampleByType []batchPreviewCount `json:"sampleByType"`
+	}
+	batchPreviewExecution struct {
+		Id        string    `json:"id"`
+		RunId     string    `json:"runId"`
+		Type      string    `json:"type"`
+		Status    string    `json:"status"`
+		StartTime time.Time `json:"startTime"`
+		TaskQueue string    `json:"taskQueue"`
+	}
+	batchPreviewCount struct {
+		Value string `json:"value"`
+		Count int    `json:"count"`
+	}
+)
+
+// previewBatchJob lists up to limit (or all if 0) executions the batch job
+// would operate on instead of starting it.
+func previewBatchJob(
+	cctx *CommandContext,
+	cl client.Client,
+	req *workflowservice.StartBatchOperationRequest,
+	limit int,
+) error {
+	if limit < 0 {
+		return fmt.Errorf("dry run limit cannot be negative")
+	}
+	preview := batchPreview{Query: req.VisibilityQuery}
+	var err error
+	switch req.Operation.(type) {
+	// These operations are on standalone activities, the others on workflows
+	case *workflowservice.StartBatchOperationRequest_CancelActivitiesOperation,
+		*workflowservice.StartBatchOperationRequest_TerminateActivitiesOperation,
+		*workflowservice.StartBatchOperationRequest_DeleteActivitiesOperation:
+		err = preview.loadActivities(cctx, cl, req.Namespace, limit)
+	default:
+		err = preview.loadWorkflows(cctx, cl, req.Namespace, limit)
+	}
+	if err != nil {
+		return err
+	}
+	preview.SampleByType = countBatchPreview(preview.Executions, func(e batchPreviewExecution) string { return e.Type })
+
+	if cctx.JSONOutput {
+		return cctx.Printer.PrintStructured(preview, printer.StructuredOptions{})
+	}
+	cctx.Printer.Printlnf("Dry run, batch job not started. Listing %v of approximately %v match(es) for query %q.",
+		len(preview.Executions), preview.Count, preview.Query)
+	if len(preview.Executions) == 0 {
+		return nil
+	}
+	cctx.Printer.Println()
+	_ = cctx.Printer.PrintStructured(preview.Executions, printer.StructuredOptions{Table: &printer.TableOptions{}})
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("By Status (of %v matched):", preview.Count))
+	_ = cctx.Printer.PrintStructured(preview.ByStatus, printer.StructuredOptions{
+		Fields: []string{"Value", "Count"},
+		Table:  &printer.TableOptions{NoHeader: true},
+	})
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("By Type (of %v listed):", len(preview.Executions)))
+	return cctx.Printer.PrintStructured(preview.SampleByType, printer.StructuredOptions{
+		Fields: []string{"Value", "Count"},
+		Table:  &printer.TableOptions{NoHeader: true},
+	})
+}
+
+func (p *batchPreview) loadWorkflows(cctx *CommandContext, cl client.Client, namespace string, limit int) error {
+	count, err := cl.CountWorkflow(cctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: namespace,
+		Query:     batchPreviewGroupByStatusQuery(p.Query),
+	})
+	if err != nil {
+		return fmt.Errorf("failed counting workflows from query: %w", err)
+	}
+	p.Count = count.Count
+	for _, group := range count.Groups {
+		p.ByStatus = append(p.ByStatus, batchPreviewGroupCount(group))
+	}
+	sortBatchPreviewCounts(p.ByStatus)
+	var nextPageToken []byte
+	for {
+		resp, err := cl.ListWorkflow(cctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     namespace,
+			Query:         p.Query,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("failed listing workflows: %w", err)
+		}
+		for _, exec := range resp.Executions {
+			if limit > 0 && len(p.Executions) >= limit {
+				return nil
+			}
+			p.Executions = append(p.Executions, batchPreviewExecution{
+				Id:        exec.Execution.GetWorkflowId(),
+				RunId:     exec.Execution.GetRunId(),
+				Type:      exec.Type.GetName(),
+				Status:    exec.Status.String(),
+				StartTime: toTime(exec.StartTime),
+				TaskQueue: exec.TaskQueue,
+			})
+		}
+		if nextPageToken = resp.NextPageToken; len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (p *batchPreview) loadActivities(cctx *CommandContext, cl client.Client, namespace string, limit int) error {
+	count, err := cl.WorkflowService().CountActivityExecutions(cctx, &workflowservice.CountActivityExecutionsRequest{
+		Namespace: namespace,
+		Query:     batchPreviewGroupByStatusQuery(p.Query),
+	})
+	if err != nil {
+		return fmt.Errorf("failed counting standalone activities from query: %w", err)
+	}
+	p.Count = count.Count
+	for _, group := range count.Groups {
+		p.ByStatus = append(p.ByStatus, batchPreviewGroupCount(group))
+	}
+	sortBatchPreviewCounts(p.ByStatus)
+	var nextPageToken []byte
+	for {
+		resp, err := cl.WorkflowService().ListActivityExecutions(cctx, &workflowservice.ListActivityExecutionsRequest{
+			Namespace:     namespace,
+			Query:         p.Query,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("failed listing standalone activities: %w", err)
+		}
+		for _, exec := range resp.Executions {
+			if limit > 0 && len(p.Executions) >= limit {
+				return nil
+			}
+			p.Executions = append(p.Executions, batchPreviewExecution{
+				Id:        exec.ActivityId,
+				RunId:     exec.RunId,
+				Type:      exec.ActivityType.GetName(),
+				Status:    exec.Status.String(),
+				StartTime: toTime(exec.ScheduleTime),
+				TaskQueue: exec.TaskQueue,
+			})
+		}
+		if nextPageToken = resp.NextPageToken; len(nextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func batchPreviewGroupByStatusQuery(query string) string {
+	if query == "" {
+		return "GROUP BY ExecutionStatus"
+	}
+	return query + " GROUP BY ExecutionStatus"
+}
+
+func batchPreviewGroupCount(group countGroup) batchPreviewCount {
+	var values []string
+	for _, payload := range group.GetGroupValues() {
+		var value any
+		if err := converter.GetDefaultDataConverter().FromPayload(payload, &value); err != nil {
+			value = fmt.Sprintf("<failed converting: %v>", err)
+		}
+		values = append(values, fmt.Sprint(value))
+	}
+	return batchPreviewCount{Value: strings.Join(values, ", "), Count: int(group.GetCount())}
+}
+
+// countBatchPreview groups executions by the key, most common first.
+func countBatchPreview(execs []batchPreviewExecution, key func(batchPreviewExecution) string) []batchPreviewCount {
+	counts := map[string]int{}
+	for _, exec := range execs {
+		counts[key(exec)]++
+	}
+	ret := make([]batchPreviewCount, 0, len(counts))
+	for value, count := range counts {
+		ret = append(ret, batchPreviewCount{Value: value, Count: count})
+	}
+	sortBatchPreviewCounts(ret)
+	return ret
+}
+
+// sortBatchPreviewCounts sorts most common first.
+func sortBatchPreviewCounts(counts []batchPreviewCount) {
+	slices.SortFunc(counts, func(a, b batchPreviewCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Value, b.Value))
+	})
+}
+
 func (c TemporalBatchListCommand) run(cctx *CommandContext, args []string) error {
 	cl, err := dialClient(cctx, &c.Parent.ClientOptions)
 	if err != nil {
@@ -9,8 +9,11 @@ import (
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/google/uuid"
 	"go.temporal.io/api/batch/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
 )
 
 func (s *SharedServerSuite) TestBatchJob_Describe() {
@@ -73,6 +76,166 @@ func (s *SharedServerSuite) TestBatchJob_Describe() {
 	})
 }
 
+func (s *SharedServerSuite) TestBatchJob_Describe_Follow() {
+	jobId := "TestBatchJob_Describe_Follow"
+	s.startBatchJob(jobId, s.Namespace())
+
+	s.t.Run("as text", func(t *testing.T) {
+		res := s.Execute(
+			"batch", "describe",
+			"--address", s.Address(),
+			"--job-id", jobId,
+			"--follow")
+		s.NoError(res.Err)
+		s.Contains(res.Stdout.String(), "0/0 completed, 0 failed (Completed)")
+	})
+
+	s.t.Run("as jsonl", func(t *testing.T) {
+		res := s.Execute(
+			"batch", "describe",
+			"--address", s.Address(),
+			"--job-id", jobId,
+			"--follow",
+			"-o", "jsonl")
+		s.NoError(res.Err)
+		// Every line is an event, the last one is the finished job
+		lines := strings.Split(strings.TrimSpace(res.Stdout.String()), "\n")
+		var event map[string]any
+		for _, line := range lines {
+			s.NoError(json.Unmarshal([]byte(line), &event))
+			s.Equal(jobId, event["jobId"])
+		}
+		s.Equal("Completed", event["state"])
+	})
+
+	s.t.Run("as yaml", func(t *testing.T) {
+		res := s.Execute(
+			"batch", "describe",
+			"--address", s.Address(),
+			"--job-id", jobId,
+			"--follow",
+			"-o", "yaml")
+		s.NoError(res.Err)
+		s.Contains(res.Stdout.String(), "- jobId: "+jobId)
+		s.Contains(res.Stdout.String(), "state: Completed")
+	})
+}
+
+func (s *SharedServerSuite) TestBatchJob_Wait() {
+	query := s.startBlockingBatchWorkflows(3)
+
+	res := s.Execute(
+		"workflow", "terminate",
+		"--address", s.Address(),
+		"--query", query,
+		"--yes",
+		"--wait",
+		"-o", "jsonl")
+	s.NoError(res.Err)
+	lines := strings.Split(strings.TrimSpace(res.Stdout.String()), "\n")
+	var event map[string]any
+	s.NoError(json.Unmarshal([]byte(lines[len(lines)-1]), &event))
+	s.Equal("Completed", event["state"])
+	s.Equal(3.0, event["completedCount"])
+	s.Equal(0.0, event["failureCount"])
+
+	// Nothing to wait for without a batch job
+	res = s.Execute(
+		"workflow", "terminate",
+		"--address", s.Address(),
+		"--workflow-id", "does-not-matter",
+		"--wait")
+	s.EqualError(res.Err, "cannot set wait when workflow ID is set")
+
+	// Nothing is left to terminate
+	s.Eventually(func() bool {
+		resp, err := s.Client.CountWorkflow(s.Context, &workflowservice.CountWorkflowExecutionsRequest{
+			Query: query + " AND ExecutionStatus = 'Running'",
+		})
+		s.NoError(err)
+		return resp.Count == 0
+	}, 3*time.Second, 100*time.Millisecond)
+}
+
+func (s *SharedServerSuite) TestBatchJob_DryRun() {
+	query := s.startBlockingBatchWorkflows(3)
+
+	res := s.Execute(
+		"workflow", "terminate",
+		"--address", s.Address(),
+		"--query", query,
+		"--dry-run",
+		"--dry-run-limit", "2")
+	s.NoError(res.Err)
+	out := res.Stdout.String()
+	s.Contains(out, "Listing 2 of approximately 3 match(es)")
+	s.ContainsOnSameLine(out, "DevWorkflow", "Running", s.Worker().Options.TaskQueue)
+	// Statuses are counted across all matches, types only across those listed
+	s.Contains(out, "By Status (of 3 matched):")
+	s.ContainsOnSameLine(out, "Running", "3")
+	s.Contains(out, "By Type (of 2 listed):")
+	s.ContainsOnSameLine(out, "DevWorkflow", "2")
+
+	res = s.Execute(
+		"workflow", "terminate",
+		"--address", s.Address(),
+		"--query", query,
+		"--dry-run",
+		"--dry-run-limit", "0",
+		"-o", "json")
+	s.NoError(res.Err)
+	var preview map[string]any
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &preview))
+	s.Equal(3.0, preview["count"])
+	s.Len(preview["executions"], 3)
+	s.Equal([]any{map[string]any{"value": "DevWorkflow", "count": 3.0}}, preview["sampleByType"])
+	s.Equal([]any{map[string]any{"value": "Running", "count": 3.0}}, preview["byStatus"])
+
+	// Nothing was terminated
+	resp, err := s.Client.CountWorkflow(s.Context, &workflowservice.CountWorkflowExecutionsRequest{
+		Query: query + " AND ExecutionStatus = 'Running'",
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.Count)
+
+	// Only for batches
+	res = s.Execute(
+		"workflow", "cancel",
+		"--address", s.Address(),
+		"--workflow-id", "some-id",
+		"--dry-run")
+	s.ErrorContains(res.Err, "cannot set dry run when workflow ID is set")
+}
+
+// startBlockingBatchWorkflows starts workflows that run until canceled and
+// returns a query matching only them once they are all visible.
+func (s *SharedServerSuite) startBlockingBatchWorkflows(count int) string {
+	s.Worker().OnDevWorkflow(func(ctx workflow.Context, a any) (any, error) {
+		ctx.Done().Receive(ctx, nil)
+		return nil, ctx.Err()
+	})
+	searchAttr := "keyword-" + uuid.NewString()
+	for range count {
+		_, err := s.Client.ExecuteWorkflow(
+			s.Context,
+			client.StartWorkflowOptions{
+				TaskQueue:        s.Worker().Options.TaskQueue,
+				SearchAttributes: map[string]any{"CustomKeywordField": searchAttr},
+			},
+			DevWorkflow,
+			"ignored",
+		)
+		s.NoError(err)
+	}
+	query := "CustomKeywordField = '" + searchAttr + "'"
+	s.Eventually(func() bool {
+		resp, err := s.Client.CountWorkflow(s.Context, &workflowservice.CountWorkflowExecutionsRequest{Query: query})
+		s.NoError(err)
+		return resp.Count == int64(count)
+	}, 3*time.Second, 100*time.Millisecond)
+	return query
+}
+
 func (s *SharedServerSuite) TestBatchJob_List() {
 	// NOTE: this test is the only test to use the "batch-empty" namespace;
 	// ie it is guaranteed to be empty at the start
@@ -0,0 +1,80 @@
+package temporalcli
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/converter"
+)
+
+// Origins of the dev server's Web UI with default ports
+var defaultCodecServerCORSOrigins = []string{"http://localhost:8233", "http://127.0.0.1:8233"}
+
+func (c *TemporalCodecServerCommand) run(cctx *CommandContext, args []string) error {
+	codec, err := c.buildCodec(cctx.Options.EnvLookup)
+	if err != nil {
+		return err
+	}
+	origins := c.CorsOrigin
+	if len(origins) == 0 {
+		origins = defaultCodecServerCORSOrigins
+	}
+	ln, err := net.Listen("tcp", net.JoinHostPort(c.Ip, strconv.Itoa(c.Port)))
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{
+		Handler:           corsHandler(origins, converter.NewPayloadCodecHTTPHandler(codec)),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	serveErrCh := make(chan error, 1)
+	go func() { serveErrCh <- srv.Serve(ln) }()
+
+	cctx.Printer.Printlnf("%-14s http://%v", "Codec Server:", ln.Addr())
+	cctx.Printer.Printlnf("%-14s %v", "Codecs:", strings.Join(c.Codec.Values, ", "))
+	cctx.Printer.Printlnf("%-14s %v", "CORS Origins:", strings.Join(origins, ", "))
+	select {
+	case <-cctx.Done():
+	case err := <-serveErrCh:
+		return err
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// corsHandler allows cross-origin requests from the given origins, answering
+// preflight requests itself. Listed origins are reflected and may send
+// credentials, while "*" allows any other origin without credentials.
+func corsHandler(origins []string, next http.Handler) http.Handler {
+	allowAll := slices.Contains(origins, "*")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		listed := origin != "" && origin != "*" && slices.Contains(origins, origin)
+		if listed || (origin != "" && allowAll) {
+			if listed {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
+			} else {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			}
+			w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, X-Namespace, Authorization")
+			w.Header().Add("Vary", "Origin")
+		}
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,149 @@
+package temporalcli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/temporalio/cli/internal/devserver"
+)
+
+func TestCodecServer(t *testing.T) {
+	h := NewCommandHarness(t)
+	keyFile := filepath.Join(t.TempDir(), "key")
+	h.NoError(os.WriteFile(keyFile, []byte(testPayloadKey+"\n"), 0600))
+	port := strconv.Itoa(devserver.MustGetFreePort("127.0.0.1"))
+	resCh := make(chan *CommandResult, 1)
+	go func() {
+		resCh <- h.Execute(
+			"codec-server",
+			"-p", port,
+			"--codec", "zlib",
+			"--codec", "aes-gcm",
+			"--key-file", keyFile,
+			"--cors-origin", "http://ui.example.com",
+		)
+	}()
+	endpoint := "http://127.0.0.1:" + port
+
+	// Encode once the server is up
+	plain := `{"metadata":{"encoding":"anNvbi9wbGFpbg=="},"data":"ImZvbyI="}`
+	var encoded []byte
+	h.Eventually(func() bool {
+		resp, err := http.Post(endpoint+"/default/encode", "application/json",
+			bytes.NewBufferString(`{"payloads":[`+plain+`]}`))
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		h.Equal(http.StatusOK, resp.StatusCode)
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(resp.Body)
+		h.NoError(err)
+		encoded = buf.Bytes()
+		return true
+	}, 5*time.Second, 100*time.Millisecond)
+	h.NotContains(string(encoded), "ImZvbyI=")
+
+	// Decode offline with the same codecs
+	h.Stdin.Write(encoded)
+	res := h.Execute("payload", "decode", "--codec", "zlib", "--codec", "aes-gcm", "--key-file", keyFile)
+	h.NoError(res.Err)
+	h.JSONEq(`{"payloads":[`+plain+`]}`, res.Stdout.String())
+
+	// And through the server
+	resp, err := http.Post(endpoint+"/decode", "application/json", bytes.NewReader(encoded))
+	h.NoError(err)
+	var decoded map[string]any
+	h.NoError(json.NewDecoder(resp.Body).Decode(&decoded))
+	resp.Body.Close()
+	h.Equal([]any{map[string]any{
+		"metadata": map[string]any{"encoding": "anNvbi9wbGFpbg=="},
+		"data":     "ImZvbyI=",
+	}}, decoded["payloads"])
+
+	// CORS preflight only allowed for the configured origin
+	preflight := func(origin string) *http.Response {
+		req, err := http.NewRequest(http.MethodOptions, endpoint+"/decode", nil)
+		h.NoError(err)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", "POST")
+		resp, err := http.DefaultClient.Do(req)
+		h.NoError(err)
+		resp.Body.Close()
+		return resp
+	}
+	resp = preflight("http://ui.example.com")
+	h.Equal(http.StatusOK, resp.StatusCode)
+	h.Equal("http://ui.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	h.Contains(resp.Header.Get("Access-Control-Allow-Headers"), "X-Namespace")
+	resp = preflight("http://other.example.com")
+	h.Empty(resp.Header.Get("Access-Control-Allow-Origin"))
+
+	// Stops on interrupt
+	h.CancelContext()
+	select {
+	case res := <-resCh:
+		h.NoError(res.Err)
+		h.Contains(res.Stdout.String(), "http://127.0.0.1:"+port)
+	case <-time.After(5 * time.Second):
+		t.Fatal("codec server did not stop")
+	}
+}
+
+func TestCodecServer_CORSAnyOrigin(t *testing.T) {
+	h := NewCommandHarness(t)
+	port := strconv.Itoa(devserver.MustGetFreePort("127.0.0.1"))
+	resCh := make(chan *CommandResult, 1)
+	go func() {
+		resCh <- h.Execute(
+			"codec-server",
+			"-p", port,
+			"--codec", "zlib",
+			"--cors-origin", "*",
+			"--cors-origin", "http://ui.example.com",
+		)
+	}()
+	preflight := func(origin string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodOptions, "http://127.0.0.1:"+port+"/decode", nil)
+		h.NoError(err)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", "POST")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body.Close()
+		return resp, nil
+	}
+	h.Eventually(func() bool {
+		_, err := preflight("http://other.example.com")
+		return err == nil
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// Any origin gets the wildcard without credentials
+	resp, err := preflight("http://other.example.com")
+	h.NoError(err)
+	h.Equal("*", resp.Header.Get("Access-Control-Allow-Origin"))
+	h.Empty(resp.Header.Get("Access-Control-Allow-Credentials"))
+	h.Contains(resp.Header.Get("Access-Control-Allow-Headers"), "X-Namespace")
+
+	// Listed origins are reflected with credentials
+	resp, err = preflight("http://ui.example.com")
+	h.NoError(err)
+	h.Equal("http://ui.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	h.Equal("true", resp.Header.Get("Access-Control-Allow-Credentials"))
+
+	h.CancelContext()
+	select {
+	case res := <-resCh:
+		h.NoError(res.Err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("codec server did not stop")
+	}
+}
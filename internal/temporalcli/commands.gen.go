@@ -211,6 +211,20 @@ func (v *SingleWorkflowOrBatchOptions) BuildFlags(f *pflag.FlagSet) {
 	f.StringArrayVar(&v.Headers, "headers", nil, "Temporal workflow headers in 'KEY=VALUE' format. Keys must be identifiers, and values must be JSON values. May be passed multiple times to set multiple Temporal headers. Note: These are workflow headers, not gRPC headers.")
 }
 
+type BatchJobOptions struct {
+	Wait        bool
+	DryRun      bool
+	DryRunLimit int
+	FlagSet     *pflag.FlagSet
+}
+
+func (v *BatchJobOptions) BuildFlags(f *pflag.FlagSet) {
+	v.FlagSet = f
+	f.BoolVar(&v.Wait, "wait", false, "Wait for the batch job to finish, showing its progress. Only used with --query. Fails if any operation in the batch fails.")
+	f.BoolVar(&v.DryRun, "dry-run", false, "List the executions matching the query instead of starting the batch job, with counts by status of every match and counts by type of the listed executions. Only used with --query.")
+	f.IntVar(&v.DryRunLimit, "dry-run-limit", 20, "Maximum number of matching executions to list with --dry-run. Counts by status cover every match, while counts by type only cover the listed executions. Set to 0 to list all.")
+}
+
 type SharedWorkflowStartOptions struct {
 	WorkflowId       string
 	Type             string
@@ -274,6 +288,66 @@ func (v *WorkflowStartOptions) BuildFlags(f *pflag.FlagSet) {
 	f.Var(&v.IdConflictPolicy, "id-conflict-policy", "Determines how to resolve a conflict when spawning a new Workflow Execution with a particular Workflow Id used by an existing Open Workflow Execution. Accepted values: Fail, UseExisting, TerminateExisting.")
 }
 
+type BulkExecutionOptions struct {
+	Query          string
+	IdFile         string
+	Concurrency    int
+	Rps            float32
+	CheckpointFile string
+	ReportFile     string
+	Yes            bool
+	FlagSet        *pflag.FlagSet
+}
+
+func (v *BulkExecutionOptions) BuildFlags(f *pflag.FlagSet) {
+	v.FlagSet = f
+	f.StringVarP(&v.Query, "query", "q", "", "Content for an SQL-like `QUERY` List Filter. You must set either --query or --id-file.")
+	f.StringVar(&v.IdFile, "id-file", "", "Path to a file of Workflow IDs. You must set either --query or --id-file.")
+	f.IntVar(&v.Concurrency, "concurrency", 10, "Number of Workflows to operate on at a time.")
+	f.Float32Var(&v.Rps, "rps", 0, "Limit requests per second. Unlimited when 0.")
+	f.StringVar(&v.CheckpointFile, "checkpoint-file", "", "Path to a file recording completed Workflows. Workflows already in the file are skipped.")
+	f.StringVar(&v.ReportFile, "report-file", "", "Path to a JSONL file to append per-Workflow results to.")
+	f.BoolVarP(&v.Yes, "yes", "y", false, "Don't prompt to confirm.")
+}
+
+type ServerDynamicConfigOptions struct {
+	Address string
+	FlagSet *pflag.FlagSet
+}
+
+func (v *ServerDynamicConfigOptions) BuildFlags(f *pflag.FlagSet) {
+	v.FlagSet = f
+	f.StringVar(&v.Address, "address", "127.0.0.1:9233", "Address of the dynamic config endpoint of the development server, at its `--dynamic-config-port`.")
+}
+
+type ServerSnapshotOptions struct {
+	SnapshotDir string
+	FlagSet     *pflag.FlagSet
+}
+
+func (v *ServerSnapshotOptions) BuildFlags(f *pflag.FlagSet) {
+	v.FlagSet = f
+	f.StringVar(&v.SnapshotDir, "snapshot-dir", "", "Directory snapshots are stored in. Defaults to `~/.config/temporalio/snapshots`.")
+}
+
+type PayloadCodecOptions struct {
+	Codec   cliext.FlagStringEnumArray
+	KeyFile string
+	KeyEnv  string
+	KeyId   string
+	FlagSet *pflag.FlagSet
+}
+
+func (v *PayloadCodecOptions) BuildFlags(f *pflag.FlagSet) {
+	v.FlagSet = f
+	v.Codec = cliext.NewFlagStringEnumArray([]string{"aes-gcm", "zlib", "zstd"}, []string{})
+	f.Var(&v.Codec, "codec", "Codec to apply, in encoding order. Can be passed multiple times. Accepted values: aes-gcm, zlib, zstd. Required.")
+	_ = cobra.MarkFlagRequired(f, "codec")
+	f.StringVar(&v.KeyFile, "key-file", "", "Path to a file containing a base64-encoded AES key. Required for the \"aes-gcm\" codec unless --key-env is set.")
+	f.StringVar(&v.KeyEnv, "key-env", "", "Name of an environment variable containing a base64-encoded AES key. Required for the \"aes-gcm\" codec unless --key-file is set.")
+	f.StringVar(&v.KeyId, "key-id", "default", "Key ID stored in encrypted Payload metadata. Decoding fails for Payloads encrypted with a different key ID.")
+}
+
 type PayloadInputOptions struct {
 	Input       []string
 	InputFile   []string
@@ -530,10 +604,14 @@ func NewTemporalCommand(cctx *CommandContext) *TemporalCommand {
 	s.Command.Args = cobra.NoArgs
 	s.Command.AddCommand(&NewTemporalActivityCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalBatchCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalCodecServerCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalConfigCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalEnvCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalLoginCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalLogoutCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalNexusCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalOperatorCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalPayloadCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalScheduleCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalServerCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalTaskQueueCommand(cctx, &s).Command)
@@ -585,6 +663,7 @@ type TemporalActivityCancelCommand struct {
 	Parent  *TemporalActivityCommand
 	Command cobra.Command
 	ActivityReferenceOrBatchOptions
+	BatchJobOptions
 	Reason string
 	Yes    bool
 }
@@ -604,6 +683,7 @@ func NewTemporalActivityCancelCommand(cctx *CommandContext, parent *TemporalActi
 	s.Command.Flags().StringVar(&s.Reason, "reason", "", "Reason for cancellation. Also used as reason for batch operation with --query, which defaults to a message with the current user's name.")
 	s.Command.Flags().BoolVarP(&s.Yes, "yes", "y", false, "Don't prompt to confirm. Only allowed when --query is present.")
 	s.ActivityReferenceOrBatchOptions.BuildFlags(s.Command.Flags())
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -678,6 +758,7 @@ type TemporalActivityDeleteCommand struct {
 	Parent  *TemporalActivityCommand
 	Command cobra.Command
 	ActivityReferenceOrBatchOptions
+	BatchJobOptions
 	Reason string
 	Yes    bool
 }
@@ -697,6 +778,7 @@ func NewTemporalActivityDeleteCommand(cctx *CommandContext, parent *TemporalActi
 	s.Command.Flags().StringVar(&s.Reason, "reason", "", "Reason for batch operation. Only use with --query. Defaults to a message with the current user's name.")
 	s.Command.Flags().BoolVarP(&s.Yes, "yes", "y", false, "Don't prompt to confirm.")
 	s.ActivityReferenceOrBatchOptions.BuildFlags(s.Command.Flags())
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -869,6 +951,7 @@ type TemporalActivityResetCommand struct {
 	Parent  *TemporalActivityCommand
 	Command cobra.Command
 	SingleActivityOrBatchOptions
+	BatchJobOptions
 	ActivityId             string
 	KeepPaused             bool
 	Jitter                 cliext.FlagDuration
@@ -893,6 +976,7 @@ func NewTemporalActivityResetCommand(cctx *CommandContext, parent *TemporalActiv
 	s.Command.Flags().Var(&s.Jitter, "jitter", "The activity will reset at random a time within the specified duration. Can only be used with --query.")
 	s.Command.Flags().BoolVar(&s.RestoreOriginalOptions, "restore-original-options", false, "Restore the original options of the activity.")
 	s.SingleActivityOrBatchOptions.BuildFlags(s.Command.Flags())
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -961,6 +1045,7 @@ type TemporalActivityTerminateCommand struct {
 	Parent  *TemporalActivityCommand
 	Command cobra.Command
 	ActivityReferenceOrBatchOptions
+	BatchJobOptions
 	Reason string
 	Yes    bool
 }
@@ -980,6 +1065,7 @@ func NewTemporalActivityTerminateCommand(cctx *CommandContext, parent *TemporalA
 	s.Command.Flags().StringVar(&s.Reason, "reason", "", "Reason for termination. Defaults to a message with the current user's name. Also used as reason for batch operation with --query.")
 	s.Command.Flags().BoolVarP(&s.Yes, "yes", "y", false, "Don't prompt to confirm. Only allowed when --query is present.")
 	s.ActivityReferenceOrBatchOptions.BuildFlags(s.Command.Flags())
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -992,6 +1078,7 @@ type TemporalActivityUnpauseCommand struct {
 	Parent  *TemporalActivityCommand
 	Command cobra.Command
 	SingleActivityOrBatchOptions
+	BatchJobOptions
 	ActivityId string
 	Jitter     cliext.FlagDuration
 }
@@ -1012,6 +1099,7 @@ func NewTemporalActivityUnpauseCommand(cctx *CommandContext, parent *TemporalAct
 	s.Jitter = 0
 	s.Command.Flags().Var(&s.Jitter, "jitter", "The activity will start at random a time within the specified duration. Can only be used with --query.")
 	s.SingleActivityOrBatchOptions.BuildFlags(s.Command.Flags())
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -1024,6 +1112,7 @@ type TemporalActivityUpdateOptionsCommand struct {
 	Parent  *TemporalActivityCommand
 	Command cobra.Command
 	SingleActivityOrBatchOptions
+	BatchJobOptions
 	ActivityId              string
 	TaskQueue               string
 	ScheduleToCloseTimeout  cliext.FlagDuration
@@ -1070,6 +1159,7 @@ func NewTemporalActivityUpdateOptionsCommand(cctx *CommandContext, parent *Tempo
 	s.Command.Flags().IntVar(&s.RetryMaximumAttempts, "retry-maximum-attempts", 0, "Maximum number of attempts. When exceeded the retries stop even if not expired yet. Setting this value to 1 disables retries. Setting this value to 0 means unlimited attempts(up to the timeouts).")
 	s.Command.Flags().BoolVar(&s.RestoreOriginalOptions, "restore-original-options", false, "Restore the original options of the activity.")
 	s.SingleActivityOrBatchOptions.BuildFlags(s.Command.Flags())
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -1090,9 +1180,9 @@ func NewTemporalBatchCommand(cctx *CommandContext, parent *TemporalCommand) *Tem
 	s.Command.Use = "batch"
 	s.Command.Short = "Manage running batch jobs"
 	if hasHighlighting {
-		s.Command.Long = "List or terminate running batch jobs.\n\nA batch job executes a command on multiple Workflow Executions at once. Create\nbatch jobs by passing \x1b[1m--query\x1b[0m to commands that support it. For example, to\ncreate a batch job to cancel a set of Workflow Executions:\n\n\x1b[1mtemporal workflow cancel \\\n  --query 'ExecutionStatus = \"Running\" AND WorkflowType=\"YourWorkflow\"' \\\n  --reason \"Testing\"\x1b[0m\n\nQuery Quick Reference:\n\n\x1b[1m+----------------------------------------------------------------------------+\n| Composition:                                                               |\n| - Data types: String literals with single or double quotes,                |\n|   Numbers (integer and floating point), Booleans                           |\n| - Comparison: '=', '!=', '>', '>=', '<', '<='                              |\n| - Expressions/Operators:  'IN array', 'BETWEEN value AND value',           |\n|   'STARTS_WITH string', 'IS NULL', 'IS NOT NULL', 'expr AND expr',         |\n|   'expr OR expr', '( expr )'                                               |\n| - Array: '( comma-separated-values )'                                      |\n|                                                                            |\n| Please note:                                                               |\n| - Wrap attributes with backticks if it contains characters not in          |\n|   [a-zA-Z0-9].                                                             |\n| - STARTS_WITH is only available for Keyword search attributes.             |\n+----------------------------------------------------------------------------+\x1b[0m\n\nVisit https://docs.temporal.io/visibility to read more about Search Attributes\nand Query creation."
+		s.Command.Long = "List or terminate running batch jobs.\n\nA batch job executes a command on multiple Workflow Executions at once. Create\nbatch jobs by passing \x1b[1m--query\x1b[0m to commands that support it. For example, to\ncreate a batch job to cancel a set of Workflow Executions:\n\n\x1b[1mtemporal workflow cancel \\\n  --query 'ExecutionStatus = \"Running\" AND WorkflowType=\"YourWorkflow\"' \\\n  --reason \"Testing\"\x1b[0m\n\nAdd \x1b[1m--dry-run\x1b[0m to preview the executions a query matches without\nstarting the batch job:\n\n\x1b[1mtemporal workflow terminate \\\n  --query 'ExecutionStatus = \"Running\" AND WorkflowType=\"YourWorkflow\"' \\\n  --dry-run\x1b[0m\n\nQuery Quick Reference:\n\n\x1b[1m+----------------------------------------------------------------------------+\n| Composition:                                                               |\n| - Data types: String literals with single or double quotes,                |\n|   Numbers (integer and floating point), Booleans                           |\n| - Comparison: '=', '!=', '>', '>=', '<', '<='                              |\n| - Expressions/Operators:  'IN array', 'BETWEEN value AND value',           |\n|   'STARTS_WITH string', 'IS NULL', 'IS NOT NULL', 'expr AND expr',         |\n|   'expr OR expr', '( expr )'                                               |\n| - Array: '( comma-separated-values )'                                      |\n|                                                                            |\n| Please note:                                                               |\n| - Wrap attributes with backticks if it contains characters not in          |\n|   [a-zA-Z0-9].                                                             |\n| - STARTS_WITH is only available for Keyword search attributes.             |\n+----------------------------------------------------------------------------+\x1b[0m\n\nVisit https://docs.temporal.io/visibility to read more about Search Attributes\nand Query creation."
 	} else {
-		s.Command.Long = "List or terminate running batch jobs.\n\nA batch job executes a command on multiple Workflow Executions at once. Create\nbatch jobs by passing `--query` to commands that support it. For example, to\ncreate a batch job to cancel a set of Workflow Executions:\n\n```\ntemporal workflow cancel \\\n  --query 'ExecutionStatus = \"Running\" AND WorkflowType=\"YourWorkflow\"' \\\n  --reason \"Testing\"\n```\n\nQuery Quick Reference:\n\n```\n+----------------------------------------------------------------------------+\n| Composition:                                                               |\n| - Data types: String literals with single or double quotes,                |\n|   Numbers (integer and floating point), Booleans                           |\n| - Comparison: '=', '!=', '>', '>=', '<', '<='                              |\n| - Expressions/Operators:  'IN array', 'BETWEEN value AND value',           |\n|   'STARTS_WITH string', 'IS NULL', 'IS NOT NULL', 'expr AND expr',         |\n|   'expr OR expr', '( expr )'                                               |\n| - Array: '( comma-separated-values )'                                      |\n|                                                                            |\n| Please note:                                                               |\n| - Wrap attributes with backticks if it contains characters not in          |\n|   [a-zA-Z0-9].                                                             |\n| - STARTS_WITH is only available for Keyword search attributes.             |\n+----------------------------------------------------------------------------+\n```\n\nVisit https://docs.temporal.io/visibility to read more about Search Attributes\nand Query creation."
+		s.Command.Long = "List or terminate running batch jobs.\n\nA batch job executes a command on multiple Workflow Executions at once. Create\nbatch jobs by passing `--query` to commands that support it. For example, to\ncreate a batch job to cancel a set of Workflow Executions:\n\n```\ntemporal workflow cancel \\\n  --query 'ExecutionStatus = \"Running\" AND WorkflowType=\"YourWorkflow\"' \\\n  --reason \"Testing\"\n```\n\nAdd `--dry-run` to preview the executions a query matches without\nstarting the batch job:\n\n```\ntemporal workflow terminate \\\n  --query 'ExecutionStatus = \"Running\" AND WorkflowType=\"YourWorkflow\"' \\\n  --dry-run\n```\n\nQuery Quick Reference:\n\n```\n+----------------------------------------------------------------------------+\n| Composition:                                                               |\n| - Data types: String literals with single or double quotes,                |\n|   Numbers (integer and floating point), Booleans                           |\n| - Comparison: '=', '!=', '>', '>=', '<', '<='                              |\n| - Expressions/Operators:  'IN array', 'BETWEEN value AND value',           |\n|   'STARTS_WITH string', 'IS NULL', 'IS NOT NULL', 'expr AND expr',         |\n|   'expr OR expr', '( expr )'                                               |\n| - Array: '( comma-separated-values )'                                      |\n|                                                                            |\n| Please note:                                                               |\n| - Wrap attributes with backticks if it contains characters not in          |\n|   [a-zA-Z0-9].                                                             |\n| - STARTS_WITH is only available for Keyword search attributes.             |\n+----------------------------------------------------------------------------+\n```\n\nVisit https://docs.temporal.io/visibility to read more about Search Attributes\nand Query creation."
 	}
 	s.Command.Args = cobra.NoArgs
 	s.Command.AddCommand(&NewTemporalBatchDescribeCommand(cctx, &s).Command)
@@ -1107,6 +1197,7 @@ type TemporalBatchDescribeCommand struct {
 	Parent  *TemporalBatchCommand
 	Command cobra.Command
 	JobId   string
+	Follow  bool
 }
 
 func NewTemporalBatchDescribeCommand(cctx *CommandContext, parent *TemporalBatchCommand) *TemporalBatchDescribeCommand {
@@ -1116,13 +1207,14 @@ func NewTemporalBatchDescribeCommand(cctx *CommandContext, parent *TemporalBatch
 	s.Command.Use = "describe [flags]"
 	s.Command.Short = "Show batch job progress"
 	if hasHighlighting {
-		s.Command.Long = "Show the progress of an ongoing batch job. Pass a valid job ID to display its\ninformation:\n\n\x1b[1mtemporal batch describe \\\n    --job-id YourJobId\x1b[0m"
+		s.Command.Long = "Show the progress of an ongoing batch job. Pass a valid job ID to display its\ninformation:\n\n\x1b[1mtemporal batch describe \\\n    --job-id YourJobId\x1b[0m\n\nUse \x1b[1m--follow\x1b[0m to show a progress bar until the job finishes. With\nstructured output, each progress change is printed as a list item, one\nJSON line each with \x1b[1m-o jsonl\x1b[0m:\n\n\x1b[1mtemporal batch describe \\\n    --job-id YourJobId \\\n    --follow\x1b[0m\n\nCommands that start batch jobs accept \x1b[1m--wait\x1b[0m to do the same after\nstarting the job."
 	} else {
-		s.Command.Long = "Show the progress of an ongoing batch job. Pass a valid job ID to display its\ninformation:\n\n```\ntemporal batch describe \\\n    --job-id YourJobId\n```"
+		s.Command.Long = "Show the progress of an ongoing batch job. Pass a valid job ID to display its\ninformation:\n\n```\ntemporal batch describe \\\n    --job-id YourJobId\n```\n\nUse `--follow` to show a progress bar until the job finishes. With\nstructured output, each progress change is printed as a list item, one\nJSON line each with `-o jsonl`:\n\n```\ntemporal batch describe \\\n    --job-id YourJobId \\\n    --follow\n```\n\nCommands that start batch jobs accept `--wait` to do the same after\nstarting the job."
 	}
 	s.Command.Args = cobra.NoArgs
 	s.Command.Flags().StringVar(&s.JobId, "job-id", "", "Batch job ID. Required.")
 	_ = cobra.MarkFlagRequired(s.Command.Flags(), "job-id")
+	s.Command.Flags().BoolVarP(&s.Follow, "follow", "f", false, "Refresh the job's progress until it finishes. Fails if any operation in the batch fails.")
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -1189,6 +1281,39 @@ func NewTemporalBatchTerminateCommand(cctx *CommandContext, parent *TemporalBatc
 	return &s
 }
 
+type TemporalCodecServerCommand struct {
+	Parent  *TemporalCommand
+	Command cobra.Command
+	PayloadCodecOptions
+	Ip         string
+	Port       int
+	CorsOrigin []string
+}
+
+func NewTemporalCodecServerCommand(cctx *CommandContext, parent *TemporalCommand) *TemporalCodecServerCommand {
+	var s TemporalCodecServerCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "codec-server [flags]"
+	s.Command.Short = "Run a local Codec Server"
+	if hasHighlighting {
+		s.Command.Long = "Run a Codec Server that serves the standard \x1b[1m/encode\x1b[0m and \x1b[1m/decode\x1b[0m\nendpoints using built-in codecs. This is meant for local development\nand is not intended for production use.\n\nCodecs are applied in the order given when encoding and in reverse order\nwhen decoding. For example, to compress then encrypt Payloads with a key\nread from a file:\n\n\x1b[1mtemporal codec-server \\\n    --codec zlib \\\n    --codec aes-gcm \\\n    --key-file path/to/key\x1b[0m\n\nKeys are base64-encoded 16, 24, or 32 byte AES keys. Use the server with\nthe CLI and the development server's Web UI:\n\n\x1b[1mtemporal server start-dev \\\n    --ui-codec-endpoint http://127.0.0.1:8081\ntemporal workflow show \\\n    --workflow-id YourWorkflowId \\\n    --codec-endpoint http://127.0.0.1:8081\x1b[0m\n\nThe development server's Web UI is allowed as a CORS origin by default.\nUse \x1b[1m--cors-origin\x1b[0m to allow other origins."
+	} else {
+		s.Command.Long = "Run a Codec Server that serves the standard `/encode` and `/decode`\nendpoints using built-in codecs. This is meant for local development\nand is not intended for production use.\n\nCodecs are applied in the order given when encoding and in reverse order\nwhen decoding. For example, to compress then encrypt Payloads with a key\nread from a file:\n\n```\ntemporal codec-server \\\n    --codec zlib \\\n    --codec aes-gcm \\\n    --key-file path/to/key\n```\n\nKeys are base64-encoded 16, 24, or 32 byte AES keys. Use the server with\nthe CLI and the development server's Web UI:\n\n```\ntemporal server start-dev \\\n    --ui-codec-endpoint http://127.0.0.1:8081\ntemporal workflow show \\\n    --workflow-id YourWorkflowId \\\n    --codec-endpoint http://127.0.0.1:8081\n```\n\nThe development server's Web UI is allowed as a CORS origin by default.\nUse `--cors-origin` to allow other origins."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.Ip, "ip", "127.0.0.1", "IP address bound to the Codec Server.")
+	s.Command.Flags().IntVarP(&s.Port, "port", "p", 8081, "Port for the Codec Server.")
+	s.Command.Flags().StringArrayVar(&s.CorsOrigin, "cors-origin", nil, "Origin allowed to make cross-origin requests, such as a Web UI URL. Use \"*\" to allow any origin, without credentials like cookies. Can be passed multiple times. Defaults to \"http://localhost:8233\" and \"http://127.0.0.1:8233\".")
+	s.PayloadCodecOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalConfigCommand struct {
 	Parent  *TemporalCommand
 	Command cobra.Command
@@ -1480,6 +1605,79 @@ func NewTemporalEnvSetCommand(cctx *CommandContext, parent *TemporalEnvCommand)
 	return &s
 }
 
+type TemporalLoginCommand struct {
+	Parent        *TemporalCommand
+	Command       cobra.Command
+	ClientId      string
+	ClientSecret  string
+	AuthUrl       string
+	TokenUrl      string
+	DeviceAuthUrl string
+	Scope         []string
+	Device        bool
+	RedirectPort  int
+	NoBrowser     bool
+	Address       string
+	Timeout       cliext.FlagDuration
+}
+
+func NewTemporalLoginCommand(cctx *CommandContext, parent *TemporalCommand) *TemporalLoginCommand {
+	var s TemporalLoginCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "login [flags]"
+	s.Command.Short = "Log in with OAuth (EXPERIMENTAL)"
+	if hasHighlighting {
+		s.Command.Long = "Obtain an OAuth access token and store it in a config file profile.\nCommands using the profile send the token to the Temporal Service and\nrefresh it when it expires.\n\nBy default, this uses the authorization code flow with PKCE. The\nauthorization URL is opened in a browser and the result is received on a\nlocal loopback listener:\n\n\x1b[1mtemporal login \\\n    --auth-url https://auth.example.com/authorize \\\n    --token-url https://auth.example.com/token \\\n    --client-id YourClientId \\\n    --scope openid \\\n    --address your-namespace.tmprl.cloud:7233\x1b[0m\n\nOn hosts without a browser, use the device code flow and enter the\ndisplayed code on another device:\n\n\x1b[1mtemporal login \\\n    --device \\\n    --device-auth-url https://auth.example.com/device/code \\\n    --token-url https://auth.example.com/token \\\n    --client-id YourClientId\x1b[0m\n\nClient settings already stored in the profile are reused, so logging in\nagain only needs \x1b[1mtemporal login\x1b[0m. Use \x1b[1m--profile\x1b[0m to select the\nprofile."
+	} else {
+		s.Command.Long = "Obtain an OAuth access token and store it in a config file profile.\nCommands using the profile send the token to the Temporal Service and\nrefresh it when it expires.\n\nBy default, this uses the authorization code flow with PKCE. The\nauthorization URL is opened in a browser and the result is received on a\nlocal loopback listener:\n\n```\ntemporal login \\\n    --auth-url https://auth.example.com/authorize \\\n    --token-url https://auth.example.com/token \\\n    --client-id YourClientId \\\n    --scope openid \\\n    --address your-namespace.tmprl.cloud:7233\n```\n\nOn hosts without a browser, use the device code flow and enter the\ndisplayed code on another device:\n\n```\ntemporal login \\\n    --device \\\n    --device-auth-url https://auth.example.com/device/code \\\n    --token-url https://auth.example.com/token \\\n    --client-id YourClientId\n```\n\nClient settings already stored in the profile are reused, so logging in\nagain only needs `temporal login`. Use `--profile` to select the\nprofile."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.ClientId, "client-id", "", "OAuth client ID. Required unless stored in the profile.")
+	s.Command.Flags().StringVar(&s.ClientSecret, "client-secret", "", "OAuth client secret, for clients that require one.")
+	s.Command.Flags().StringVar(&s.AuthUrl, "auth-url", "", "Authorization endpoint URL. Required for the authorization code flow unless stored in the profile.")
+	s.Command.Flags().StringVar(&s.TokenUrl, "token-url", "", "Token endpoint URL. Required unless stored in the profile.")
+	s.Command.Flags().StringVar(&s.DeviceAuthUrl, "device-auth-url", "", "Device authorization endpoint URL. Required for the device code flow.")
+	s.Command.Flags().StringArrayVar(&s.Scope, "scope", nil, "OAuth scope to request. Can be passed multiple times.")
+	s.Command.Flags().BoolVar(&s.Device, "device", false, "Use the device code flow instead of opening a browser.")
+	s.Command.Flags().IntVar(&s.RedirectPort, "redirect-port", 0, "Port of the local loopback listener receiving the authorization code. Defaults to a random free port.")
+	s.Command.Flags().BoolVar(&s.NoBrowser, "no-browser", false, "Print the authorization URL instead of opening a browser.")
+	s.Command.Flags().StringVar(&s.Address, "address", "", "Temporal Service address to store in the profile.")
+	s.Timeout = cliext.MustParseFlagDuration("5m")
+	s.Command.Flags().Var(&s.Timeout, "timeout", "Maximum time to wait for the login to complete.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalLogoutCommand struct {
+	Parent  *TemporalCommand
+	Command cobra.Command
+}
+
+func NewTemporalLogoutCommand(cctx *CommandContext, parent *TemporalCommand) *TemporalLogoutCommand {
+	var s TemporalLogoutCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "logout [flags]"
+	s.Command.Short = "Remove stored OAuth tokens (EXPERIMENTAL)"
+	if hasHighlighting {
+		s.Command.Long = "Remove the OAuth tokens stored in a config file profile by\n\x1b[1mtemporal login\x1b[0m. The client settings are kept so that logging in again\nonly needs \x1b[1mtemporal login\x1b[0m:\n\n\x1b[1mtemporal logout --profile YourProfile\x1b[0m"
+	} else {
+		s.Command.Long = "Remove the OAuth tokens stored in a config file profile by\n`temporal login`. The client settings are kept so that logging in again\nonly needs `temporal login`:\n\n```\ntemporal logout --profile YourProfile\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalNexusCommand struct {
 	Parent  *TemporalCommand
 	Command cobra.Command
@@ -1777,7 +1975,9 @@ func NewTemporalOperatorCommand(cctx *CommandContext, parent *TemporalCommand) *
 		s.Command.Long = "Operator commands manage and fetch information about Namespaces, Search\nAttributes, Nexus Endpoints, and Temporal Services:\n\n```\ntemporal operator [command] [subcommand] [options]\n```\n\nFor example, to show information about the Temporal Service at the default\naddress (localhost):\n\n```\ntemporal operator cluster describe\n```"
 	}
 	s.Command.Args = cobra.NoArgs
+	s.Command.AddCommand(&NewTemporalOperatorApplyCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalOperatorClusterCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalOperatorExportCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalOperatorNamespaceCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalOperatorNexusCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalOperatorSearchAttributeCommand(cctx, &s).Command)
@@ -1786,6 +1986,38 @@ func NewTemporalOperatorCommand(cctx *CommandContext, parent *TemporalCommand) *
 	return &s
 }
 
+type TemporalOperatorApplyCommand struct {
+	Parent  *TemporalOperatorCommand
+	Command cobra.Command
+	File    string
+	DryRun  bool
+	Yes     bool
+}
+
+func NewTemporalOperatorApplyCommand(cctx *CommandContext, parent *TemporalOperatorCommand) *TemporalOperatorApplyCommand {
+	var s TemporalOperatorApplyCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "apply [flags]"
+	s.Command.Short = "Create or update Namespace resources from a file"
+	if hasHighlighting {
+		s.Command.Long = "Create or update a Namespace, its custom Search Attributes, the Nexus\nEndpoints targeting it, and Task Queue configuration so they match a\nYAML file:\n\n\x1b[1mtemporal operator apply \\\n    --file namespace.yaml\x1b[0m\n\nOnly the calls needed to reach the state in the file are made. A plan of\nthe changes is shown and must be confirmed before it is applied. Use\n\x1b[1m--dry-run\x1b[0m to only show the plan. The file format is the one written by\n\x1b[1mtemporal operator export\x1b[0m:\n\n\x1b[1mnamespace:\n  name: YourNamespace\n  description: Orders\n  retention: 168h\n  data:\n    team: payments\nsearchAttributes:\n  CustomerId: Keyword\nnexusEndpoints:\n  - name: your-endpoint\n    targetTaskQueue: YourTaskQueue\ntaskQueues:\n  - name: YourTaskQueue\n    type: activity\n    queueRpsLimit: 100\x1b[0m\n\nIf the file has no Namespace name, \x1b[1m--namespace\x1b[0m is used. Search\nAttributes and Namespace data keys are only added or changed, never\nremoved."
+	} else {
+		s.Command.Long = "Create or update a Namespace, its custom Search Attributes, the Nexus\nEndpoints targeting it, and Task Queue configuration so they match a\nYAML file:\n\n```\ntemporal operator apply \\\n    --file namespace.yaml\n```\n\nOnly the calls needed to reach the state in the file are made. A plan of\nthe changes is shown and must be confirmed before it is applied. Use\n`--dry-run` to only show the plan. The file format is the one written by\n`temporal operator export`:\n\n```\nnamespace:\n  name: YourNamespace\n  description: Orders\n  retention: 168h\n  data:\n    team: payments\nsearchAttributes:\n  CustomerId: Keyword\nnexusEndpoints:\n  - name: your-endpoint\n    targetTaskQueue: YourTaskQueue\ntaskQueues:\n  - name: YourTaskQueue\n    type: activity\n    queueRpsLimit: 100\n```\n\nIf the file has no Namespace name, `--namespace` is used. Search\nAttributes and Namespace data keys are only added or changed, never\nremoved."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVarP(&s.File, "file", "f", "", "Path to the YAML file to apply. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "file")
+	s.Command.Flags().BoolVar(&s.DryRun, "dry-run", false, "Show the plan without applying it.")
+	s.Command.Flags().BoolVarP(&s.Yes, "yes", "y", false, "Don't prompt to confirm the plan.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalOperatorClusterCommand struct {
 	Parent  *TemporalOperatorCommand
 	Command cobra.Command
@@ -1975,6 +2207,35 @@ func NewTemporalOperatorClusterUpsertCommand(cctx *CommandContext, parent *Tempo
 	return &s
 }
 
+type TemporalOperatorExportCommand struct {
+	Parent     *TemporalOperatorCommand
+	Command    cobra.Command
+	TaskQueue  []string
+	OutputFile string
+}
+
+func NewTemporalOperatorExportCommand(cctx *CommandContext, parent *TemporalOperatorCommand) *TemporalOperatorExportCommand {
+	var s TemporalOperatorExportCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "export [flags]"
+	s.Command.Short = "Write Namespace resources to a file"
+	if hasHighlighting {
+		s.Command.Long = "Write a Namespace, its custom Search Attributes, and the Nexus Endpoints\ntargeting it as YAML, in the format read by \x1b[1mtemporal operator apply\x1b[0m:\n\n\x1b[1mtemporal operator export \\\n    --namespace YourNamespace \\\n    --output-file namespace.yaml\x1b[0m\n\nTask Queues can't be listed, so include the configuration of specific\nTask Queues with \x1b[1m--task-queue\x1b[0m:\n\n\x1b[1mtemporal operator export \\\n    --namespace YourNamespace \\\n    --task-queue YourTaskQueue\x1b[0m\n\nUse \x1b[1m--output json\x1b[0m to write JSON instead."
+	} else {
+		s.Command.Long = "Write a Namespace, its custom Search Attributes, and the Nexus Endpoints\ntargeting it as YAML, in the format read by `temporal operator apply`:\n\n```\ntemporal operator export \\\n    --namespace YourNamespace \\\n    --output-file namespace.yaml\n```\n\nTask Queues can't be listed, so include the configuration of specific\nTask Queues with `--task-queue`:\n\n```\ntemporal operator export \\\n    --namespace YourNamespace \\\n    --task-queue YourTaskQueue\n```\n\nUse `--output json` to write JSON instead."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringArrayVarP(&s.TaskQueue, "task-queue", "t", nil, "Task Queue to include the configuration of. Can be passed multiple times.")
+	s.Command.Flags().StringVarP(&s.OutputFile, "output-file", "f", "", "Path to write the resources to. Defaults to stdout.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalOperatorNamespaceCommand struct {
 	Parent  *TemporalOperatorCommand
 	Command cobra.Command
@@ -2473,6 +2734,83 @@ func NewTemporalOperatorSearchAttributeRemoveCommand(cctx *CommandContext, paren
 	return &s
 }
 
+type TemporalPayloadCommand struct {
+	Parent  *TemporalCommand
+	Command cobra.Command
+	PayloadCodecOptions
+}
+
+func NewTemporalPayloadCommand(cctx *CommandContext, parent *TemporalCommand) *TemporalPayloadCommand {
+	var s TemporalPayloadCommand
+	s.Parent = parent
+	s.Command.Use = "payload"
+	s.Command.Short = "Transform Payloads with built-in codecs"
+	if hasHighlighting {
+		s.Command.Long = "Payload commands encode and decode Payload JSON offline, using the same\nbuilt-in codecs as \x1b[1mtemporal codec-server\x1b[0m:\n\n\x1b[1mtemporal payload [command] [options]\x1b[0m\n\nFor example, to encrypt a Payload read from stdin:\n\n\x1b[1mecho '{\"metadata\":{\"encoding\":\"anNvbi9wbGFpbg==\"},\"data\":\"ImZvbyI=\"}' | \\\n    temporal payload encode \\\n        --codec aes-gcm \\\n        --key-env YOUR_KEY_ENV_VAR\x1b[0m"
+	} else {
+		s.Command.Long = "Payload commands encode and decode Payload JSON offline, using the same\nbuilt-in codecs as `temporal codec-server`:\n\n```\ntemporal payload [command] [options]\n```\n\nFor example, to encrypt a Payload read from stdin:\n\n```\necho '{\"metadata\":{\"encoding\":\"anNvbi9wbGFpbg==\"},\"data\":\"ImZvbyI=\"}' | \\\n    temporal payload encode \\\n        --codec aes-gcm \\\n        --key-env YOUR_KEY_ENV_VAR\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.AddCommand(&NewTemporalPayloadDecodeCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalPayloadEncodeCommand(cctx, &s).Command)
+	s.PayloadCodecOptions.BuildFlags(s.Command.PersistentFlags())
+	return &s
+}
+
+type TemporalPayloadDecodeCommand struct {
+	Parent    *TemporalPayloadCommand
+	Command   cobra.Command
+	InputFile string
+}
+
+func NewTemporalPayloadDecodeCommand(cctx *CommandContext, parent *TemporalPayloadCommand) *TemporalPayloadDecodeCommand {
+	var s TemporalPayloadDecodeCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "decode [flags]"
+	s.Command.Short = "Decode Payloads"
+	if hasHighlighting {
+		s.Command.Long = "Decode Payload JSON with the given codecs. Codecs are applied in reverse\norder, so use the same \x1b[1m--codec\x1b[0m options that encoded the Payloads. Input\nis either a single Payload or a \x1b[1m{\"payloads\": [...]}\x1b[0m object, and the\noutput has the same shape:\n\n\x1b[1mtemporal payload decode \\\n    --codec zlib \\\n    --codec aes-gcm \\\n    --key-file path/to/key \\\n    --input-file encoded.json\x1b[0m"
+	} else {
+		s.Command.Long = "Decode Payload JSON with the given codecs. Codecs are applied in reverse\norder, so use the same `--codec` options that encoded the Payloads. Input\nis either a single Payload or a `{\"payloads\": [...]}` object, and the\noutput has the same shape:\n\n```\ntemporal payload decode \\\n    --codec zlib \\\n    --codec aes-gcm \\\n    --key-file path/to/key \\\n    --input-file encoded.json\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.InputFile, "input-file", "", "Path to a file of Payload JSON. Reads from stdin if unset.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalPayloadEncodeCommand struct {
+	Parent    *TemporalPayloadCommand
+	Command   cobra.Command
+	InputFile string
+}
+
+func NewTemporalPayloadEncodeCommand(cctx *CommandContext, parent *TemporalPayloadCommand) *TemporalPayloadEncodeCommand {
+	var s TemporalPayloadEncodeCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "encode [flags]"
+	s.Command.Short = "Encode Payloads"
+	if hasHighlighting {
+		s.Command.Long = "Encode Payload JSON with the given codecs. Codecs are applied in the order\ngiven. Input is either a single Payload or a \x1b[1m{\"payloads\": [...]}\x1b[0m object,\nand the output has the same shape:\n\n\x1b[1mtemporal payload encode \\\n    --codec zlib \\\n    --codec aes-gcm \\\n    --key-file path/to/key \\\n    --input-file payloads.json\x1b[0m"
+	} else {
+		s.Command.Long = "Encode Payload JSON with the given codecs. Codecs are applied in the order\ngiven. Input is either a single Payload or a `{\"payloads\": [...]}` object,\nand the output has the same shape:\n\n```\ntemporal payload encode \\\n    --codec zlib \\\n    --codec aes-gcm \\\n    --key-file path/to/key \\\n    --input-file payloads.json\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.InputFile, "input-file", "", "Path to a file of Payload JSON. Reads from stdin if unset.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalScheduleCommand struct {
 	Parent  *TemporalCommand
 	Command cobra.Command
@@ -2490,10 +2828,12 @@ func NewTemporalScheduleCommand(cctx *CommandContext, parent *TemporalCommand) *
 		s.Command.Long = "Create, use, and update Schedules that allow Workflow Executions to be created\nat specified times:\n\n```\ntemporal schedule [commands] [options]\n```\n\nFor example:\n\n```\ntemporal schedule describe \\\n    --schedule-id \"YourScheduleId\"\n```"
 	}
 	s.Command.Args = cobra.NoArgs
+	s.Command.AddCommand(&NewTemporalScheduleApplyCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalScheduleBackfillCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalScheduleCreateCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalScheduleDeleteCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalScheduleDescribeCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalScheduleExportCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalScheduleListCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalScheduleListMatchingTimesCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalScheduleToggleCommand(cctx, &s).Command)
@@ -2504,6 +2844,42 @@ func NewTemporalScheduleCommand(cctx *CommandContext, parent *TemporalCommand) *
 	return &s
 }
 
+type TemporalScheduleApplyCommand struct {
+	Parent  *TemporalScheduleCommand
+	Command cobra.Command
+	File    string
+	Prune   bool
+	Query   string
+	DryRun  bool
+	Yes     bool
+}
+
+func NewTemporalScheduleApplyCommand(cctx *CommandContext, parent *TemporalScheduleCommand) *TemporalScheduleApplyCommand {
+	var s TemporalScheduleApplyCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "apply [flags]"
+	s.Command.Short = "Create or update Schedules from a file"
+	if hasHighlighting {
+		s.Command.Long = "Create, update, and optionally delete Schedules so they match the\ndefinitions in a YAML file:\n\n\x1b[1mtemporal schedule apply \\\n    --file schedules.yaml\x1b[0m\n\nEach Schedule in the file is compared with the live Schedule of the same\nID. A plan of the Schedules to create, update, and delete is shown and\nmust be confirmed before it is applied. Use \x1b[1m--dry-run\x1b[0m to only show the\nplan. The file format is the one written by \x1b[1mtemporal schedule export\x1b[0m:\n\n\x1b[1mschedules:\n  - id: YourScheduleId\n    cron: [\"0 12 * * MON-FRI\"]\n    interval: [\"1h/5m\"]\n    timeZone: America/New_York\n    overlapPolicy: BufferOne\n    workflow:\n      id: YourWorkflowId\n      type: YourWorkflowType\n      taskQueue: YourTaskQueue\n      input: [{\"hello\": \"world\"}]\x1b[0m\n\nWith \x1b[1m--prune\x1b[0m, Schedules that are not in the file are deleted. Limit\nthe Schedules considered for deletion with \x1b[1m--query\x1b[0m:\n\n\x1b[1mtemporal schedule apply \\\n    --file schedules.yaml \\\n    --prune \\\n    --query 'TemporalSchedulePaused = false'\x1b[0m\n\nSchedule memo and search attributes are only set when a Schedule is\ncreated and are not compared with existing Schedules."
+	} else {
+		s.Command.Long = "Create, update, and optionally delete Schedules so they match the\ndefinitions in a YAML file:\n\n```\ntemporal schedule apply \\\n    --file schedules.yaml\n```\n\nEach Schedule in the file is compared with the live Schedule of the same\nID. A plan of the Schedules to create, update, and delete is shown and\nmust be confirmed before it is applied. Use `--dry-run` to only show the\nplan. The file format is the one written by `temporal schedule export`:\n\n```\nschedules:\n  - id: YourScheduleId\n    cron: [\"0 12 * * MON-FRI\"]\n    interval: [\"1h/5m\"]\n    timeZone: America/New_York\n    overlapPolicy: BufferOne\n    workflow:\n      id: YourWorkflowId\n      type: YourWorkflowType\n      taskQueue: YourTaskQueue\n      input: [{\"hello\": \"world\"}]\n```\n\nWith `--prune`, Schedules that are not in the file are deleted. Limit\nthe Schedules considered for deletion with `--query`:\n\n```\ntemporal schedule apply \\\n    --file schedules.yaml \\\n    --prune \\\n    --query 'TemporalSchedulePaused = false'\n```\n\nSchedule memo and search attributes are only set when a Schedule is\ncreated and are not compared with existing Schedules."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVarP(&s.File, "file", "f", "", "Path to the YAML file of Schedules to apply. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "file")
+	s.Command.Flags().BoolVar(&s.Prune, "prune", false, "Delete Schedules that are not in the file.")
+	s.Command.Flags().StringVarP(&s.Query, "query", "q", "", "Filter the Schedules considered for deletion using given List Filter. Only used with --prune.")
+	s.Command.Flags().BoolVar(&s.DryRun, "dry-run", false, "Show the plan without applying it.")
+	s.Command.Flags().BoolVarP(&s.Yes, "yes", "y", false, "Don't prompt to confirm the plan.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalScheduleBackfillCommand struct {
 	Parent  *TemporalScheduleCommand
 	Command cobra.Command
@@ -2633,6 +3009,37 @@ func NewTemporalScheduleDescribeCommand(cctx *CommandContext, parent *TemporalSc
 	return &s
 }
 
+type TemporalScheduleExportCommand struct {
+	Parent     *TemporalScheduleCommand
+	Command    cobra.Command
+	ScheduleId string
+	Query      string
+	OutputFile string
+}
+
+func NewTemporalScheduleExportCommand(cctx *CommandContext, parent *TemporalScheduleCommand) *TemporalScheduleExportCommand {
+	var s TemporalScheduleExportCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "export [flags]"
+	s.Command.Short = "Write Schedules to a file"
+	if hasHighlighting {
+		s.Command.Long = "Write the definitions of existing Schedules as YAML, in the format read\nby \x1b[1mtemporal schedule apply\x1b[0m:\n\n\x1b[1mtemporal schedule export \\\n    --output-file schedules.yaml\x1b[0m\n\nExport a single Schedule with \x1b[1m--schedule-id\x1b[0m, or filter the exported\nSchedules with \x1b[1m--query\x1b[0m. Use \x1b[1m--output json\x1b[0m to write the definitions\nas JSON instead."
+	} else {
+		s.Command.Long = "Write the definitions of existing Schedules as YAML, in the format read\nby `temporal schedule apply`:\n\n```\ntemporal schedule export \\\n    --output-file schedules.yaml\n```\n\nExport a single Schedule with `--schedule-id`, or filter the exported\nSchedules with `--query`. Use `--output json` to write the definitions\nas JSON instead."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVarP(&s.ScheduleId, "schedule-id", "s", "", "Schedule ID to export. Exports all Schedules if unset.")
+	s.Command.Flags().StringVarP(&s.Query, "query", "q", "", "Filter exported Schedules using given List Filter.")
+	s.Command.Flags().StringVarP(&s.OutputFile, "output-file", "f", "", "Path to write the definitions to. Defaults to stdout.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalScheduleListCommand struct {
 	Parent     *TemporalScheduleCommand
 	Command    cobra.Command
@@ -2813,61 +3220,54 @@ func NewTemporalServerCommand(cctx *CommandContext, parent *TemporalCommand) *Te
 		s.Command.Long = "Run a development Temporal Server on your local system.\n\n```\n+------------------------------------------------------------------------+\n| WARNING: The development server is not intended for production use.    |\n| It skips certain HTTP security checks to make local use simpler.       |\n|                                                                        |\n| For production use, see:                                               |\n| https://docs.temporal.io/production-deployment                         |\n+------------------------------------------------------------------------+\n```\n\nView the Web UI for the default configuration at: http://localhost:8233\n\n```\ntemporal server start-dev\n```\n\nAdd persistence for Workflow Executions across runs:\n\n```\ntemporal server start-dev \\\n    --db-filename path-to-your-local-persistent-store\n```\n\nSet the port from the front-end gRPC Service (7233 default):\n\n```\ntemporal server start-dev \\\n    --port 7234 \\\n    --ui-port 8234 \\\n    --metrics-port 57271\n```\n\nUse a custom port for the Web UI. The default is the gRPC port (7233 default)\nplus 1000 (8233):\n\n```\ntemporal server start-dev \\\n    --ui-port 3000\n```"
 	}
 	s.Command.Args = cobra.NoArgs
+	s.Command.AddCommand(&NewTemporalServerDynamicConfigCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalServerSnapshotCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalServerStartDevCommand(cctx, &s).Command)
 	return &s
 }
 
-type TemporalServerStartDevCommand struct {
-	Parent             *TemporalServerCommand
-	Command            cobra.Command
-	DbFilename         string
-	Namespace          []string
-	Port               int
-	HttpPort           int
-	MetricsPort        int
-	UiPort             int
-	Headless           bool
-	Ip                 string
-	UiIp               string
-	UiPublicPath       string
-	UiAssetPath        string
-	UiCodecEndpoint    string
-	UiDisableNewsFetch bool
-	SqlitePragma       []string
-	DynamicConfigValue []string
-	LogConfig          bool
-	SearchAttribute    []string
+type TemporalServerDynamicConfigCommand struct {
+	Parent  *TemporalServerCommand
+	Command cobra.Command
 }
 
-func NewTemporalServerStartDevCommand(cctx *CommandContext, parent *TemporalServerCommand) *TemporalServerStartDevCommand {
-	var s TemporalServerStartDevCommand
+func NewTemporalServerDynamicConfigCommand(cctx *CommandContext, parent *TemporalServerCommand) *TemporalServerDynamicConfigCommand {
+	var s TemporalServerDynamicConfigCommand
+	s.Parent = parent
+	s.Command.Use = "dynamic-config"
+	s.Command.Short = "Inspect and change dynamic config of a development server"
+	if hasHighlighting {
+		s.Command.Long = "Show and change the dynamic config values of a running development\nserver, without a restart. The commands talk to the dynamic config\nendpoint of the server, which only listens on localhost at the\n\x1b[1m--dynamic-config-port\x1b[0m of \x1b[1mtemporal server start-dev\x1b[0m, defaulting to\nthe \x1b[1m--port\x1b[0m value + 2000:\n\n\x1b[1mtemporal server start-dev\ntemporal server dynamic-config set \\\n    --key frontend.namespaceRPS \\\n    --value 50\x1b[0m\n\nWith \x1b[1m--dynamic-config-file\x1b[0m, set values are written to the file,\nand changes made to the file directly are applied within a second."
+	} else {
+		s.Command.Long = "Show and change the dynamic config values of a running development\nserver, without a restart. The commands talk to the dynamic config\nendpoint of the server, which only listens on localhost at the\n`--dynamic-config-port` of `temporal server start-dev`, defaulting to\nthe `--port` value + 2000:\n\n```\ntemporal server start-dev\ntemporal server dynamic-config set \\\n    --key frontend.namespaceRPS \\\n    --value 50\n```\n\nWith `--dynamic-config-file`, set values are written to the file,\nand changes made to the file directly are applied within a second."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.AddCommand(&NewTemporalServerDynamicConfigGetCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalServerDynamicConfigSetCommand(cctx, &s).Command)
+	return &s
+}
+
+type TemporalServerDynamicConfigGetCommand struct {
+	Parent  *TemporalServerDynamicConfigCommand
+	Command cobra.Command
+	ServerDynamicConfigOptions
+	Key string
+}
+
+func NewTemporalServerDynamicConfigGetCommand(cctx *CommandContext, parent *TemporalServerDynamicConfigCommand) *TemporalServerDynamicConfigGetCommand {
+	var s TemporalServerDynamicConfigGetCommand
 	s.Parent = parent
 	s.Command.DisableFlagsInUseLine = true
-	s.Command.Use = "start-dev [flags]"
-	s.Command.Short = "Start Temporal development server"
+	s.Command.Use = "get [flags]"
+	s.Command.Short = "Show dynamic config values of a development server"
 	if hasHighlighting {
-		s.Command.Long = "Run a development Temporal Server on your local system.\n\n\x1b[1m+------------------------------------------------------------------------+\n| WARNING: The development server is not intended for production use.    |\n| It skips certain HTTP security checks to make local use simpler.       |\n|                                                                        |\n| For production use, see:                                               |\n| https://docs.temporal.io/production-deployment                         |\n+------------------------------------------------------------------------+\x1b[0m\n\nView the Web UI for the default configuration at: http://localhost:8233\n\n\x1b[1mtemporal server start-dev\x1b[0m\n\nAdd persistence for Workflow Executions across runs:\n\n\x1b[1mtemporal server start-dev \\\n    --db-filename path-to-your-local-persistent-store\x1b[0m\n\nSet the port from the front-end gRPC Service (7233 default):\n\n\x1b[1mtemporal server start-dev \\\n    --port 7000\x1b[0m\n\nUse a custom port for the Web UI. The default is the gRPC port (7233 default)\nplus 1000 (8233):\n\n\x1b[1mtemporal server start-dev \\\n    --ui-port 3000\x1b[0m"
+		s.Command.Long = "Show the dynamic config values a running development server uses, for\nall keys with values or a single key. Each value has its source:\n\x1b[1mstart\x1b[0m for values given at start, including \x1b[1m--dynamic-config-value\x1b[0m\nand development server defaults, \x1b[1mfile\x1b[0m for values in the\n\x1b[1m--dynamic-config-file\x1b[0m, and \x1b[1mset\x1b[0m for values set without a file.\nKeys that are not shown use the Temporal Server default:\n\n\x1b[1mtemporal server dynamic-config get \\\n    --key frontend.namespaceRPS\x1b[0m"
 	} else {
-		s.Command.Long = "Run a development Temporal Server on your local system.\n\n```\n+------------------------------------------------------------------------+\n| WARNING: The development server is not intended for production use.    |\n| It skips certain HTTP security checks to make local use simpler.       |\n|                                                                        |\n| For production use, see:                                               |\n| https://docs.temporal.io/production-deployment                         |\n+------------------------------------------------------------------------+\n```\n\nView the Web UI for the default configuration at: http://localhost:8233\n\n```\ntemporal server start-dev\n```\n\nAdd persistence for Workflow Executions across runs:\n\n```\ntemporal server start-dev \\\n    --db-filename path-to-your-local-persistent-store\n```\n\nSet the port from the front-end gRPC Service (7233 default):\n\n```\ntemporal server start-dev \\\n    --port 7000\n```\n\nUse a custom port for the Web UI. The default is the gRPC port (7233 default)\nplus 1000 (8233):\n\n```\ntemporal server start-dev \\\n    --ui-port 3000\n```"
+		s.Command.Long = "Show the dynamic config values a running development server uses, for\nall keys with values or a single key. Each value has its source:\n`start` for values given at start, including `--dynamic-config-value`\nand development server defaults, `file` for values in the\n`--dynamic-config-file`, and `set` for values set without a file.\nKeys that are not shown use the Temporal Server default:\n\n```\ntemporal server dynamic-config get \\\n    --key frontend.namespaceRPS\n```"
 	}
 	s.Command.Args = cobra.NoArgs
-	s.Command.Flags().StringVarP(&s.DbFilename, "db-filename", "f", "", "Path to file for persistent Temporal state store. By default, Workflow Executions are lost when the server process dies.")
-	s.Command.Flags().StringArrayVarP(&s.Namespace, "namespace", "n", nil, "Namespaces to be created at launch. The \"default\" Namespace is always created automatically.")
-	s.Command.Flags().IntVarP(&s.Port, "port", "p", 7233, "Port for the front-end gRPC Service.")
-	s.Command.Flags().IntVar(&s.HttpPort, "http-port", 0, "Port for the HTTP API service. Defaults to a random free port.")
-	s.Command.Flags().IntVar(&s.MetricsPort, "metrics-port", 0, "Port for the '/metrics' HTTP endpoint. Defaults to a random free port.")
-	s.Command.Flags().IntVar(&s.UiPort, "ui-port", 0, "Port for the Web UI. Defaults to '--port' value + 1000.")
-	s.Command.Flags().BoolVar(&s.Headless, "headless", false, "Disable the Web UI.")
-	s.Command.Flags().StringVar(&s.Ip, "ip", "localhost", "IP address bound to the front-end Service.")
-	s.Command.Flags().StringVar(&s.UiIp, "ui-ip", "", "IP address bound to the Web UI. Defaults to same as '--ip' value.")
-	s.Command.Flags().StringVar(&s.UiPublicPath, "ui-public-path", "", "The public base path for the Web UI. Defaults to `/`.")
-	s.Command.Flags().StringVar(&s.UiAssetPath, "ui-asset-path", "", "UI custom assets path.")
-	s.Command.Flags().StringVar(&s.UiCodecEndpoint, "ui-codec-endpoint", "", "UI remote codec HTTP endpoint.")
-	s.Command.Flags().BoolVar(&s.UiDisableNewsFetch, "ui-disable-news-fetch", false, "Disable the Web UI newsfeed. When set, the UI will not request the newsfeed and the button to open the newsfeed panel is hidden.")
-	s.Command.Flags().StringArrayVar(&s.SqlitePragma, "sqlite-pragma", nil, "SQLite pragma statements in \"PRAGMA=VALUE\" format.")
-	s.Command.Flags().StringArrayVar(&s.DynamicConfigValue, "dynamic-config-value", nil, "Dynamic configuration value using `KEY=VALUE` pairs. Keys must be identifiers, and values must be JSON values. For example: `YourKey=\"YourString\"` Can be passed multiple times.")
-	s.Command.Flags().BoolVar(&s.LogConfig, "log-config", false, "Print the server config to stderr.")
-	s.Command.Flags().StringArrayVar(&s.SearchAttribute, "search-attribute", nil, "Search attributes to register using `KEY=VALUE` pairs. Keys must be identifiers, and values must be the search attribute type, which is one of the following: Text, Keyword, Int, Double, Bool, Datetime, KeywordList.")
+	s.Command.Flags().StringVar(&s.Key, "key", "", "Dynamic config key to show. Defaults to all keys with values.")
+	s.ServerDynamicConfigOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -2876,10 +3276,249 @@ func NewTemporalServerStartDevCommand(cctx *CommandContext, parent *TemporalServ
 	return &s
 }
 
-type TemporalTaskQueueCommand struct {
-	Parent  *TemporalCommand
+type TemporalServerDynamicConfigSetCommand struct {
+	Parent  *TemporalServerDynamicConfigCommand
 	Command cobra.Command
-	cliext.ClientOptions
+	ServerDynamicConfigOptions
+	Key        string
+	Value      string
+	Constraint []string
+}
+
+func NewTemporalServerDynamicConfigSetCommand(cctx *CommandContext, parent *TemporalServerDynamicConfigCommand) *TemporalServerDynamicConfigSetCommand {
+	var s TemporalServerDynamicConfigSetCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "set [flags]"
+	s.Command.Short = "Set a dynamic config value of a development server"
+	if hasHighlighting {
+		s.Command.Long = "Set a dynamic config value of a running development server, which\nuses it at once. The values the server then uses for the key are\nshown:\n\n\x1b[1mtemporal server dynamic-config set \\\n    --key frontend.namespaceRPS \\\n    --value 50\x1b[0m\n\nSet a value for some Namespaces or Task Queues only with constraints:\n\n\x1b[1mtemporal server dynamic-config set \\\n    --key matching.numTaskqueueReadPartitions \\\n    --value 8 \\\n    --constraint namespace=default \\\n    --constraint taskQueueName=YourTaskQueue\x1b[0m\n\nA value with the same constraints is replaced. Values with other\nconstraints are kept. With \x1b[1m--dynamic-config-file\x1b[0m, the value is\nwritten to the file. Otherwise, it only lasts until the server stops."
+	} else {
+		s.Command.Long = "Set a dynamic config value of a running development server, which\nuses it at once. The values the server then uses for the key are\nshown:\n\n```\ntemporal server dynamic-config set \\\n    --key frontend.namespaceRPS \\\n    --value 50\n```\n\nSet a value for some Namespaces or Task Queues only with constraints:\n\n```\ntemporal server dynamic-config set \\\n    --key matching.numTaskqueueReadPartitions \\\n    --value 8 \\\n    --constraint namespace=default \\\n    --constraint taskQueueName=YourTaskQueue\n```\n\nA value with the same constraints is replaced. Values with other\nconstraints are kept. With `--dynamic-config-file`, the value is\nwritten to the file. Otherwise, it only lasts until the server stops."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.Key, "key", "", "Dynamic config key to set. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "key")
+	s.Command.Flags().StringVar(&s.Value, "value", "", "Value to set, as JSON. For example: `50`, `true`, or `\"YourString\"`. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "value")
+	s.Command.Flags().StringArrayVar(&s.Constraint, "constraint", nil, "Constraint for the value in `KEY=VALUE` format, such as `namespace=YourNamespace`. Can be passed multiple times.")
+	s.ServerDynamicConfigOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalServerSnapshotCommand struct {
+	Parent  *TemporalServerCommand
+	Command cobra.Command
+}
+
+func NewTemporalServerSnapshotCommand(cctx *CommandContext, parent *TemporalServerCommand) *TemporalServerSnapshotCommand {
+	var s TemporalServerSnapshotCommand
+	s.Parent = parent
+	s.Command.Use = "snapshot"
+	s.Command.Short = "Manage development server snapshots"
+	if hasHighlighting {
+		s.Command.Long = "Save the state of a development server using \x1b[1m--db-filename\x1b[0m to a named\nsnapshot and restore it later, for example to reset fixtures between test\nruns:\n\n\x1b[1mtemporal server snapshot save \\\n    --db-filename temporal.db \\\n    --name fixtures\ntemporal server snapshot restore \\\n    --db-filename temporal.db \\\n    --name fixtures\x1b[0m\n\nSnapshots are stored in \x1b[1m~/.config/temporalio/snapshots\x1b[0m unless\n\x1b[1m--snapshot-dir\x1b[0m is set."
+	} else {
+		s.Command.Long = "Save the state of a development server using `--db-filename` to a named\nsnapshot and restore it later, for example to reset fixtures between test\nruns:\n\n```\ntemporal server snapshot save \\\n    --db-filename temporal.db \\\n    --name fixtures\ntemporal server snapshot restore \\\n    --db-filename temporal.db \\\n    --name fixtures\n```\n\nSnapshots are stored in `~/.config/temporalio/snapshots` unless\n`--snapshot-dir` is set."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.AddCommand(&NewTemporalServerSnapshotListCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalServerSnapshotRestoreCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalServerSnapshotSaveCommand(cctx, &s).Command)
+	return &s
+}
+
+type TemporalServerSnapshotListCommand struct {
+	Parent  *TemporalServerSnapshotCommand
+	Command cobra.Command
+	ServerSnapshotOptions
+}
+
+func NewTemporalServerSnapshotListCommand(cctx *CommandContext, parent *TemporalServerSnapshotCommand) *TemporalServerSnapshotListCommand {
+	var s TemporalServerSnapshotListCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "list [flags]"
+	s.Command.Short = "List snapshots"
+	if hasHighlighting {
+		s.Command.Long = "List the saved development server snapshots:\n\n\x1b[1mtemporal server snapshot list\x1b[0m"
+	} else {
+		s.Command.Long = "List the saved development server snapshots:\n\n```\ntemporal server snapshot list\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.ServerSnapshotOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalServerSnapshotRestoreCommand struct {
+	Parent  *TemporalServerSnapshotCommand
+	Command cobra.Command
+	ServerSnapshotOptions
+	DbFilename string
+	Name       string
+}
+
+func NewTemporalServerSnapshotRestoreCommand(cctx *CommandContext, parent *TemporalServerSnapshotCommand) *TemporalServerSnapshotRestoreCommand {
+	var s TemporalServerSnapshotRestoreCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "restore [flags]"
+	s.Command.Short = "Restore a database file from a snapshot"
+	if hasHighlighting {
+		s.Command.Long = "Replace the state in a development server database file with a saved\nsnapshot:\n\n\x1b[1mtemporal server snapshot restore \\\n    --db-filename temporal.db \\\n    --name fixtures\x1b[0m\n\nStop the server using the database file before restoring. To start a\nserver from a snapshot in one step, use\n\x1b[1mtemporal server start-dev --from-snapshot\x1b[0m."
+	} else {
+		s.Command.Long = "Replace the state in a development server database file with a saved\nsnapshot:\n\n```\ntemporal server snapshot restore \\\n    --db-filename temporal.db \\\n    --name fixtures\n```\n\nStop the server using the database file before restoring. To start a\nserver from a snapshot in one step, use\n`temporal server start-dev --from-snapshot`."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVarP(&s.DbFilename, "db-filename", "f", "", "Path to the database file to restore into. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "db-filename")
+	s.Command.Flags().StringVar(&s.Name, "name", "", "Snapshot name. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "name")
+	s.ServerSnapshotOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalServerSnapshotSaveCommand struct {
+	Parent  *TemporalServerSnapshotCommand
+	Command cobra.Command
+	ServerSnapshotOptions
+	DbFilename string
+	Name       string
+	Overwrite  bool
+}
+
+func NewTemporalServerSnapshotSaveCommand(cctx *CommandContext, parent *TemporalServerSnapshotCommand) *TemporalServerSnapshotSaveCommand {
+	var s TemporalServerSnapshotSaveCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "save [flags]"
+	s.Command.Short = "Save a database file to a snapshot"
+	if hasHighlighting {
+		s.Command.Long = "Save the state in a development server database file as a named\nsnapshot:\n\n\x1b[1mtemporal server snapshot save \\\n    --db-filename temporal.db \\\n    --name fixtures\x1b[0m\n\nThe snapshot is taken using SQLite's online backup, so it is consistent\neven while the server is running."
+	} else {
+		s.Command.Long = "Save the state in a development server database file as a named\nsnapshot:\n\n```\ntemporal server snapshot save \\\n    --db-filename temporal.db \\\n    --name fixtures\n```\n\nThe snapshot is taken using SQLite's online backup, so it is consistent\neven while the server is running."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVarP(&s.DbFilename, "db-filename", "f", "", "Path to the database file to save. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "db-filename")
+	s.Command.Flags().StringVar(&s.Name, "name", "", "Snapshot name. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "name")
+	s.Command.Flags().BoolVar(&s.Overwrite, "overwrite", false, "Replace an existing snapshot with the same name.")
+	s.ServerSnapshotOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalServerStartDevCommand struct {
+	Parent  *TemporalServerCommand
+	Command cobra.Command
+	ServerSnapshotOptions
+	DbFilename         string
+	Namespace          []string
+	Port               int
+	HttpPort           int
+	MetricsPort        int
+	UiPort             int
+	Headless           bool
+	Ip                 string
+	UiIp               string
+	UiPublicPath       string
+	UiAssetPath        string
+	UiCodecEndpoint    string
+	UiDisableNewsFetch bool
+	SqlitePragma       []string
+	DynamicConfigValue []string
+	LogConfig          bool
+	SearchAttribute    []string
+	FromSnapshot       string
+	Clusters           int
+	Config             string
+	PrintConfig        bool
+	DynamicConfigFile  string
+	DynamicConfigPort  int
+	Seed               string
+	TlsAuto            bool
+	TlsCert            string
+	TlsKey             string
+	TlsCa              string
+	AuthJwks           []string
+	ApiKey             bool
+}
+
+func NewTemporalServerStartDevCommand(cctx *CommandContext, parent *TemporalServerCommand) *TemporalServerStartDevCommand {
+	var s TemporalServerStartDevCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "start-dev [flags]"
+	s.Command.Short = "Start Temporal development server"
+	if hasHighlighting {
+		s.Command.Long = "Run a development Temporal Server on your local system.\n\n\x1b[1m+------------------------------------------------------------------------+\n| WARNING: The development server is not intended for production use.    |\n| It skips certain HTTP security checks to make local use simpler.       |\n|                                                                        |\n| For production use, see:                                               |\n| https://docs.temporal.io/production-deployment                         |\n+------------------------------------------------------------------------+\x1b[0m\n\nView the Web UI for the default configuration at: http://localhost:8233\n\n\x1b[1mtemporal server start-dev\x1b[0m\n\nAdd persistence for Workflow Executions across runs:\n\n\x1b[1mtemporal server start-dev \\\n    --db-filename path-to-your-local-persistent-store\x1b[0m\n\nSet the port from the front-end gRPC Service (7233 default):\n\n\x1b[1mtemporal server start-dev \\\n    --port 7000\x1b[0m\n\nUse a custom port for the Web UI. The default is the gRPC port (7233 default)\nplus 1000 (8233):\n\n\x1b[1mtemporal server start-dev \\\n    --ui-port 3000\x1b[0m\n\nStart from state saved with \x1b[1mtemporal server snapshot save\x1b[0m:\n\n\x1b[1mtemporal server start-dev \\\n    --from-snapshot fixtures\x1b[0m\n\nStart three clusters connected as remote clusters on ports 7233, 7234\nand 7235, with '--namespace' Namespaces registered as global Namespaces\nactive in the first cluster:\n\n\x1b[1mtemporal server start-dev \\\n    --clusters 3 \\\n    --namespace replicated\x1b[0m\n\nStart from a configuration file, which has a key for every option using\ncamel case (for example \x1b[1muiPort\x1b[0m for '--ui-port'). Options that are set\noverride file values:\n\n\x1b[1mtemporal server start-dev \\\n    --config dev-server.yaml \\\n    --port 7000\x1b[0m\n\nA configuration file can also set Namespace retention, and settings\nwithout options:\n\n\x1b[1myaml\nport: 7233\ndbFilename: temporal.db\nnamespaces:\n  - name: orders\n    retention: 7d\nsearchAttributes:\n  CustomerId: Keyword\ndynamicConfig:\n  frontend.enableUpdateWorkflowExecution: true\nsqlitePragmas:\n  journal_mode: wal\nlogLevel: error\npprofPort: 7936\nclusterName: active\x1b[0m\n\nPrint the effective configuration, which can be used as a configuration\nfile, without starting the server:\n\n\x1b[1mtemporal server start-dev \\\n    --config dev-server.yaml \\\n    --print-config\x1b[0m\n\nSeed the server once it is ready with Namespaces, Search Attributes, Nexus\nEndpoints, Schedules, and Workflow Executions from a file:\n\n\x1b[1mtemporal server start-dev \\\n    --seed seed.yaml\x1b[0m\n\nExisting Namespaces, Search Attributes, Nexus Endpoints, and Schedules\nare left as-is or updated, and Workflow Executions already running with\nthe same ID are not started again, so the same file can be used with\n'--db-filename' on every start:\n\n\x1b[1myaml\nnamespaces:\n  - name: orders\n    description: Order processing\n    retention: 7d\n    searchAttributes:\n      CustomerId: Keyword\n    schedules:\n      - id: nightly-report\n        cron: [\"0 0 * * *\"]\n        workflow:\n          type: ReportWorkflow\n          taskQueue: reports\n    workflows:\n      - id: order-1\n        type: OrderWorkflow\n        taskQueue: orders\n        input: [{\"customerId\": \"c-1\"}]\nnexusEndpoints:\n  - name: orders-endpoint\n    targetNamespace: orders\n    targetTaskQueue: orders-nexus\x1b[0m\n\nWith multiple clusters, the first cluster is seeded.\n\nRequire TLS with generated certificates, including client certificates\n(mTLS), and an API key with admin permissions. The client options to\nconnect, and a matching client config profile, are printed:\n\n\x1b[1mtemporal server start-dev \\\n    --tls-auto \\\n    --api-key\x1b[0m\n\nUse your own certificates instead, requiring client certificates signed\nby '--tls-ca' when set, and validate tokens with keys from a JWKS URL:\n\n\x1b[1mtemporal server start-dev \\\n    --tls-cert server.pem \\\n    --tls-key server-key.pem \\\n    --tls-ca ca.pem \\\n    --auth-jwks https://example.com/.well-known/jwks.json\x1b[0m\n\nWith TLS or auth, the Web UI and the Temporal Server itself connect\nthrough an internal frontend that only listens on localhost."
+	} else {
+		s.Command.Long = "Run a development Temporal Server on your local system.\n\n```\n+------------------------------------------------------------------------+\n| WARNING: The development server is not intended for production use.    |\n| It skips certain HTTP security checks to make local use simpler.       |\n|                                                                        |\n| For production use, see:                                               |\n| https://docs.temporal.io/production-deployment                         |\n+------------------------------------------------------------------------+\n```\n\nView the Web UI for the default configuration at: http://localhost:8233\n\n```\ntemporal server start-dev\n```\n\nAdd persistence for Workflow Executions across runs:\n\n```\ntemporal server start-dev \\\n    --db-filename path-to-your-local-persistent-store\n```\n\nSet the port from the front-end gRPC Service (7233 default):\n\n```\ntemporal server start-dev \\\n    --port 7000\n```\n\nUse a custom port for the Web UI. The default is the gRPC port (7233 default)\nplus 1000 (8233):\n\n```\ntemporal server start-dev \\\n    --ui-port 3000\n```\n\nStart from state saved with `temporal server snapshot save`:\n\n```\ntemporal server start-dev \\\n    --from-snapshot fixtures\n```\n\nStart three clusters connected as remote clusters on ports 7233, 7234\nand 7235, with '--namespace' Namespaces registered as global Namespaces\nactive in the first cluster:\n\n```\ntemporal server start-dev \\\n    --clusters 3 \\\n    --namespace replicated\n```\n\nStart from a configuration file, which has a key for every option using\ncamel case (for example `uiPort` for '--ui-port'). Options that are set\noverride file values:\n\n```\ntemporal server start-dev \\\n    --config dev-server.yaml \\\n    --port 7000\n```\n\nA configuration file can also set Namespace retention, and settings\nwithout options:\n\n```yaml\nport: 7233\ndbFilename: temporal.db\nnamespaces:\n  - name: orders\n    retention: 7d\nsearchAttributes:\n  CustomerId: Keyword\ndynamicConfig:\n  frontend.enableUpdateWorkflowExecution: true\nsqlitePragmas:\n  journal_mode: wal\nlogLevel: error\npprofPort: 7936\nclusterName: active\n```\n\nPrint the effective configuration, which can be used as a configuration\nfile, without starting the server:\n\n```\ntemporal server start-dev \\\n    --config dev-server.yaml \\\n    --print-config\n```\n\nSeed the server once it is ready with Namespaces, Search Attributes, Nexus\nEndpoints, Schedules, and Workflow Executions from a file:\n\n```\ntemporal server start-dev \\\n    --seed seed.yaml\n```\n\nExisting Namespaces, Search Attributes, Nexus Endpoints, and Schedules\nare left as-is or updated, and Workflow Executions already running with\nthe same ID are not started again, so the same file can be used with\n'--db-filename' on every start:\n\n```yaml\nnamespaces:\n  - name: orders\n    description: Order processing\n    retention: 7d\n    searchAttributes:\n      CustomerId: Keyword\n    schedules:\n      - id: nightly-report\n        cron: [\"0 0 * * *\"]\n        workflow:\n          type: ReportWorkflow\n          taskQueue: reports\n    workflows:\n      - id: order-1\n        type: OrderWorkflow\n        taskQueue: orders\n        input: [{\"customerId\": \"c-1\"}]\nnexusEndpoints:\n  - name: orders-endpoint\n    targetNamespace: orders\n    targetTaskQueue: orders-nexus\n```\n\nWith multiple clusters, the first cluster is seeded.\n\nRequire TLS with generated certificates, including client certificates\n(mTLS), and an API key with admin permissions. The client options to\nconnect, and a matching client config profile, are printed:\n\n```\ntemporal server start-dev \\\n    --tls-auto \\\n    --api-key\n```\n\nUse your own certificates instead, requiring client certificates signed\nby '--tls-ca' when set, and validate tokens with keys from a JWKS URL:\n\n```\ntemporal server start-dev \\\n    --tls-cert server.pem \\\n    --tls-key server-key.pem \\\n    --tls-ca ca.pem \\\n    --auth-jwks https://example.com/.well-known/jwks.json\n```\n\nWith TLS or auth, the Web UI and the Temporal Server itself connect\nthrough an internal frontend that only listens on localhost."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVarP(&s.DbFilename, "db-filename", "f", "", "Path to file for persistent Temporal state store. By default, Workflow Executions are lost when the server process dies.")
+	s.Command.Flags().StringArrayVarP(&s.Namespace, "namespace", "n", nil, "Namespaces to be created at launch. The \"default\" Namespace is always created automatically.")
+	s.Command.Flags().IntVarP(&s.Port, "port", "p", 7233, "Port for the front-end gRPC Service.")
+	s.Command.Flags().IntVar(&s.HttpPort, "http-port", 0, "Port for the HTTP API service. Defaults to a random free port.")
+	s.Command.Flags().IntVar(&s.MetricsPort, "metrics-port", 0, "Port for the '/metrics' HTTP endpoint. Defaults to a random free port.")
+	s.Command.Flags().IntVar(&s.UiPort, "ui-port", 0, "Port for the Web UI. Defaults to '--port' value + 1000.")
+	s.Command.Flags().BoolVar(&s.Headless, "headless", false, "Disable the Web UI.")
+	s.Command.Flags().StringVar(&s.Ip, "ip", "localhost", "IP address bound to the front-end Service.")
+	s.Command.Flags().StringVar(&s.UiIp, "ui-ip", "", "IP address bound to the Web UI. Defaults to same as '--ip' value.")
+	s.Command.Flags().StringVar(&s.UiPublicPath, "ui-public-path", "", "The public base path for the Web UI. Defaults to `/`.")
+	s.Command.Flags().StringVar(&s.UiAssetPath, "ui-asset-path", "", "UI custom assets path.")
+	s.Command.Flags().StringVar(&s.UiCodecEndpoint, "ui-codec-endpoint", "", "UI remote codec HTTP endpoint.")
+	s.Command.Flags().BoolVar(&s.UiDisableNewsFetch, "ui-disable-news-fetch", false, "Disable the Web UI newsfeed. When set, the UI will not request the newsfeed and the button to open the newsfeed panel is hidden.")
+	s.Command.Flags().StringArrayVar(&s.SqlitePragma, "sqlite-pragma", nil, "SQLite pragma statements in \"PRAGMA=VALUE\" format.")
+	s.Command.Flags().StringArrayVar(&s.DynamicConfigValue, "dynamic-config-value", nil, "Dynamic configuration value using `KEY=VALUE` pairs. Keys must be identifiers, and values must be JSON values. For example: `YourKey=\"YourString\"` Can be passed multiple times.")
+	s.Command.Flags().BoolVar(&s.LogConfig, "log-config", false, "Print the server config to stderr.")
+	s.Command.Flags().StringArrayVar(&s.SearchAttribute, "search-attribute", nil, "Search attributes to register using `KEY=VALUE` pairs. Keys must be identifiers, and values must be the search attribute type, which is one of the following: Text, Keyword, Int, Double, Bool, Datetime, KeywordList.")
+	s.Command.Flags().StringVar(&s.FromSnapshot, "from-snapshot", "", "Snapshot to start from. Restored into '--db-filename' when set, otherwise changes are discarded on exit.")
+	s.Command.Flags().IntVar(&s.Clusters, "clusters", 1, "Number of clusters to start, up to 9. Clusters are named \"cluster-1\" through \"cluster-N\" and use consecutive ports starting at each port option. They are connected as remote clusters with global Namespaces enabled, and '--namespace' Namespaces are registered as global Namespaces active in \"cluster-1\". '--db-filename' is used by the first cluster, with the cluster name added to it for the others.")
+	s.Command.Flags().StringVar(&s.Config, "config", "", "Path to a YAML configuration file. Options that are set override values in the file.")
+	s.Command.Flags().BoolVar(&s.PrintConfig, "print-config", false, "Print the effective configuration from the configuration file and options, then exit without starting the server.")
+	s.Command.Flags().StringVar(&s.DynamicConfigFile, "dynamic-config-file", "", "Path to a dynamic config file in the Temporal Server dynamic config format. Changes to the file are applied without a restart. Values in the file override '--dynamic-config-value'.")
+	s.Command.Flags().IntVar(&s.DynamicConfigPort, "dynamic-config-port", 0, "Port on localhost for the dynamic config endpoint used by `temporal server dynamic-config`. Defaults to '--port' value + 2000, or no endpoint if that port is in use.")
+	s.Command.Flags().StringVar(&s.Seed, "seed", "", "Path to a YAML seed file with Namespaces, Search Attributes, Nexus Endpoints, Schedules, and Workflow Executions to create once the server is ready. The server stops if any of them fail.")
+	s.Command.Flags().BoolVar(&s.TlsAuto, "tls-auto", false, "Require mTLS with a generated CA, and server and client certificates. The certificates are removed on exit.")
+	s.Command.Flags().StringVar(&s.TlsCert, "tls-cert", "", "Path to the server TLS certificate. Requires '--tls-key'.")
+	s.Command.Flags().StringVar(&s.TlsKey, "tls-key", "", "Path to the server TLS private key. Requires '--tls-cert'.")
+	s.Command.Flags().StringVar(&s.TlsCa, "tls-ca", "", "Path to a CA certificate for client certificates. Requires clients to use certificates signed by it (mTLS).")
+	s.Command.Flags().StringArrayVar(&s.AuthJwks, "auth-jwks", nil, "URL of a JWKS with keys to validate tokens with. Enables the default JWT claim mapper and authorizer. Can be passed multiple times.")
+	s.Command.Flags().BoolVar(&s.ApiKey, "api-key", false, "Require auth, and print an API key with admin permissions signed by a generated key. Enables the default JWT claim mapper and authorizer.")
+	s.ServerSnapshotOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalTaskQueueCommand struct {
+	Parent  *TemporalCommand
+	Command cobra.Command
+	cliext.ClientOptions
 }
 
 func NewTemporalTaskQueueCommand(cctx *CommandContext, parent *TemporalCommand) *TemporalTaskQueueCommand {
@@ -2893,6 +3532,7 @@ func NewTemporalTaskQueueCommand(cctx *CommandContext, parent *TemporalCommand)
 		s.Command.Long = "Inspect and update Task Queues, the queues that Workers poll for Workflow and\nActivity tasks:\n\n```\ntemporal task-queue [command] [command options] \\\n    --task-queue YourTaskQueue\n```\n\nFor example:\n\n```\ntemporal task-queue describe \\\n    --task-queue YourTaskQueue\n```"
 	}
 	s.Command.Args = cobra.NoArgs
+	s.Command.AddCommand(&NewTemporalTaskQueueCheckCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalTaskQueueConfigCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalTaskQueueDescribeCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalTaskQueueGetBuildIdReachabilityCommand(cctx, &s).Command)
@@ -2905,6 +3545,57 @@ func NewTemporalTaskQueueCommand(cctx *CommandContext, parent *TemporalCommand)
 	return &s
 }
 
+type TemporalTaskQueueCheckCommand struct {
+	Parent            *TemporalTaskQueueCommand
+	Command           cobra.Command
+	TaskQueue         string
+	TaskQueueType     cliext.FlagStringEnumArray
+	SelectBuildId     []string
+	SelectUnversioned bool
+	SelectAllActive   bool
+	MaxBacklog        int
+	WarnMaxBacklog    int
+	MaxBacklogAge     cliext.FlagDuration
+	WarnMaxBacklogAge cliext.FlagDuration
+	MinPollers        int
+	WarnMinPollers    int
+}
+
+func NewTemporalTaskQueueCheckCommand(cctx *CommandContext, parent *TemporalTaskQueueCommand) *TemporalTaskQueueCheckCommand {
+	var s TemporalTaskQueueCheckCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "check [flags]"
+	s.Command.Short = "Check Task Queue health for monitoring"
+	if hasHighlighting {
+		s.Command.Long = "Check the backlog and pollers of a Task Queue against thresholds, for\nuse from cron or monitoring systems:\n\n\x1b[1mtemporal task-queue check \\\n    --task-queue YourTaskQueue \\\n    --max-backlog-age 5m \\\n    --min-pollers 2 \\\n    --max-backlog 10000\x1b[0m\n\nThresholds are checked for each Task Queue type and Build ID described,\nwhich can be selected as with \x1b[1mtemporal task-queue describe\x1b[0m. A status\nline is printed, or with \x1b[1m--output json\x1b[0m, the status, the problems\nfound, and the statistics and pollers they were found in.\n\nThe command exits with a Nagios-style code:\n\n- \x1b[1m0\x1b[0m (OK): no threshold was crossed.\n- \x1b[1m1\x1b[0m (WARNING): a \x1b[1m--warn-\x1b[0m threshold was crossed.\n- \x1b[1m2\x1b[0m (CRITICAL): a \x1b[1m--max-\x1b[0m or \x1b[1m--min-\x1b[0m threshold was crossed.\n- \x1b[1m3\x1b[0m (UNKNOWN): the Task Queue could not be described.\n\nThresholds left at 0 are not checked."
+	} else {
+		s.Command.Long = "Check the backlog and pollers of a Task Queue against thresholds, for\nuse from cron or monitoring systems:\n\n```\ntemporal task-queue check \\\n    --task-queue YourTaskQueue \\\n    --max-backlog-age 5m \\\n    --min-pollers 2 \\\n    --max-backlog 10000\n```\n\nThresholds are checked for each Task Queue type and Build ID described,\nwhich can be selected as with `temporal task-queue describe`. A status\nline is printed, or with `--output json`, the status, the problems\nfound, and the statistics and pollers they were found in.\n\nThe command exits with a Nagios-style code:\n\n- `0` (OK): no threshold was crossed.\n- `1` (WARNING): a `--warn-` threshold was crossed.\n- `2` (CRITICAL): a `--max-` or `--min-` threshold was crossed.\n- `3` (UNKNOWN): the Task Queue could not be described.\n\nThresholds left at 0 are not checked."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVarP(&s.TaskQueue, "task-queue", "t", "", "Task Queue name. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "task-queue")
+	s.TaskQueueType = cliext.NewFlagStringEnumArray([]string{"workflow", "activity", "nexus"}, []string{})
+	s.Command.Flags().Var(&s.TaskQueueType, "task-queue-type", "Task Queue type. If not specified, all types are checked. Accepted values: workflow, activity, nexus.")
+	s.Command.Flags().StringArrayVar(&s.SelectBuildId, "select-build-id", nil, "Filter the Task Queue based on Build ID.")
+	s.Command.Flags().BoolVar(&s.SelectUnversioned, "select-unversioned", false, "Include the unversioned queue.")
+	s.Command.Flags().BoolVar(&s.SelectAllActive, "select-all-active", false, "Include all active versions. A version is active if it had new tasks or polls recently.")
+	s.Command.Flags().IntVar(&s.MaxBacklog, "max-backlog", 0, "Backlog count above which the check is critical.")
+	s.Command.Flags().IntVar(&s.WarnMaxBacklog, "warn-max-backlog", 0, "Backlog count above which the check is a warning.")
+	s.MaxBacklogAge = 0
+	s.Command.Flags().Var(&s.MaxBacklogAge, "max-backlog-age", "Backlog age above which the check is critical.")
+	s.WarnMaxBacklogAge = 0
+	s.Command.Flags().Var(&s.WarnMaxBacklogAge, "warn-max-backlog-age", "Backlog age above which the check is a warning.")
+	s.Command.Flags().IntVar(&s.MinPollers, "min-pollers", 0, "Poller count below which the check is critical.")
+	s.Command.Flags().IntVar(&s.WarnMinPollers, "warn-min-pollers", 0, "Poller count below which the check is a warning.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalTaskQueueConfigCommand struct {
 	Parent  *TemporalTaskQueueCommand
 	Command cobra.Command
@@ -3335,6 +4026,7 @@ func NewTemporalTaskQueueVersioningCommand(cctx *CommandContext, parent *Tempora
 	s.Command.AddCommand(&NewTemporalTaskQueueVersioningInsertAssignmentRuleCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalTaskQueueVersioningReplaceAssignmentRuleCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalTaskQueueVersioningReplaceRedirectRuleCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalTaskQueueVersioningSimulateCommand(cctx, &s).Command)
 	s.Command.PersistentFlags().StringVarP(&s.TaskQueue, "task-queue", "t", "", "Task queue name. Required.")
 	_ = cobra.MarkFlagRequired(s.Command.PersistentFlags(), "task-queue")
 	return &s
@@ -3612,6 +4304,45 @@ func NewTemporalTaskQueueVersioningReplaceRedirectRuleCommand(cctx *CommandConte
 	return &s
 }
 
+type TemporalTaskQueueVersioningSimulateCommand struct {
+	Parent               *TemporalTaskQueueVersioningCommand
+	Command              cobra.Command
+	RulesFile            string
+	InsertAssignmentRule []string
+	AddRedirectRule      []string
+	CommitBuildId        string
+	BuildId              []string
+	Sample               int
+}
+
+func NewTemporalTaskQueueVersioningSimulateCommand(cctx *CommandContext, parent *TemporalTaskQueueVersioningCommand) *TemporalTaskQueueVersioningSimulateCommand {
+	var s TemporalTaskQueueVersioningSimulateCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "simulate [flags]"
+	s.Command.Short = "Preview where tasks go under Build ID rules (Deprecated)"
+	if hasHighlighting {
+		s.Command.Long = "\x1b[1m+-------------------------------------------------------------+\n| CAUTION: This API has been deprecated by Worker Deployment. |\n+-------------------------------------------------------------+\x1b[0m\n\nShow where new Workflows and the tasks of existing Build IDs would be\nsent by a Task Queue's assignment and redirect rules, without changing\nthem:\n\n\x1b[1mtemporal task-queue versioning simulate \\\n    --task-queue YourTaskQueue \\\n    --insert-assignment-rule YourNewBuildID:10 \\\n    --add-redirect-rule YourOldBuildID=YourNewBuildID\x1b[0m\n\nThe current rules of the Task Queue are used, or the rules in\n\x1b[1m--rules-file\x1b[0m, in the format output by \x1b[1mget-rules --output json\x1b[0m.\nPending edits are then applied in the order of the options below:\n\n- \x1b[1m--insert-assignment-rule\x1b[0m inserts \x1b[1mBUILD_ID\x1b[0m or\n  \x1b[1mBUILD_ID:PERCENTAGE\x1b[0m rules before the others, in the order given.\n- \x1b[1m--add-redirect-rule\x1b[0m adds \x1b[1mSOURCE_BUILD_ID=TARGET_BUILD_ID\x1b[0m rules.\n- \x1b[1m--commit-build-id\x1b[0m commits a Build ID as \x1b[1mcommit-build-id\x1b[0m would,\n  without checking for recent pollers.\n\nNew Workflows are spread evenly across ramp percentages, so \x1b[1m--sample\x1b[0m\nof 100 shows one Workflow per percentage point. Every Build ID in the\nrules, and any given with \x1b[1m--build-id\x1b[0m, is shown with the Build ID its\ntasks are redirected to.\n\nAssignment rules that no Workflow can reach, redirect rules that are\nnever applied, and redirect cycles are reported as problems."
+	} else {
+		s.Command.Long = "```\n+-------------------------------------------------------------+\n| CAUTION: This API has been deprecated by Worker Deployment. |\n+-------------------------------------------------------------+\n```\n\nShow where new Workflows and the tasks of existing Build IDs would be\nsent by a Task Queue's assignment and redirect rules, without changing\nthem:\n\n```\ntemporal task-queue versioning simulate \\\n    --task-queue YourTaskQueue \\\n    --insert-assignment-rule YourNewBuildID:10 \\\n    --add-redirect-rule YourOldBuildID=YourNewBuildID\n```\n\nThe current rules of the Task Queue are used, or the rules in\n`--rules-file`, in the format output by `get-rules --output json`.\nPending edits are then applied in the order of the options below:\n\n- `--insert-assignment-rule` inserts `BUILD_ID` or\n  `BUILD_ID:PERCENTAGE` rules before the others, in the order given.\n- `--add-redirect-rule` adds `SOURCE_BUILD_ID=TARGET_BUILD_ID` rules.\n- `--commit-build-id` commits a Build ID as `commit-build-id` would,\n  without checking for recent pollers.\n\nNew Workflows are spread evenly across ramp percentages, so `--sample`\nof 100 shows one Workflow per percentage point. Every Build ID in the\nrules, and any given with `--build-id`, is shown with the Build ID its\ntasks are redirected to.\n\nAssignment rules that no Workflow can reach, redirect rules that are\nnever applied, and redirect cycles are reported as problems."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Annotations = make(map[string]string)
+	s.Command.Annotations["deprecationWarning"] = "This API has been deprecated by Worker Deployment."
+	s.Command.Flags().StringVar(&s.RulesFile, "rules-file", "", "Path to a JSON file of rules to use instead of the current rules.")
+	s.Command.Flags().StringArrayVar(&s.InsertAssignmentRule, "insert-assignment-rule", nil, "Assignment rule to insert, as `BUILD_ID` or `BUILD_ID:PERCENTAGE`. Can be passed multiple times.")
+	s.Command.Flags().StringArrayVar(&s.AddRedirectRule, "add-redirect-rule", nil, "Redirect rule to add, as `SOURCE_BUILD_ID=TARGET_BUILD_ID`. Can be passed multiple times.")
+	s.Command.Flags().StringVar(&s.CommitBuildId, "commit-build-id", "", "Build ID to commit.")
+	s.Command.Flags().StringArrayVar(&s.BuildId, "build-id", nil, "Existing Build ID to show redirects for, in addition to those in the rules. Can be passed multiple times.")
+	s.Command.Flags().IntVar(&s.Sample, "sample", 100, "Number of new Workflows to simulate.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalWorkerCommand struct {
 	Parent  *TemporalCommand
 	Command cobra.Command
@@ -3633,6 +4364,7 @@ func NewTemporalWorkerCommand(cctx *CommandContext, parent *TemporalCommand) *Te
 	s.Command.AddCommand(&NewTemporalWorkerDeploymentCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkerDescribeCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkerListCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkerTopCommand(cctx, &s).Command)
 	s.ClientOptions.BuildFlags(s.Command.PersistentFlags())
 	s.ClientOptions.HideFlags()
 	return &s
@@ -3691,10 +4423,14 @@ func NewTemporalWorkerDeploymentCommand(cctx *CommandContext, parent *TemporalWo
 	s.Command.AddCommand(&NewTemporalWorkerDeploymentDescribeVersionCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkerDeploymentListCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkerDeploymentManagerIdentityCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkerDeploymentMigratePinnedCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkerDeploymentPruneCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkerDeploymentRolloutCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkerDeploymentSetCurrentVersionCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkerDeploymentSetRampingVersionCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkerDeploymentUpdateVersionComputeConfigCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkerDeploymentUpdateVersionMetadataCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkerDeploymentWaitDrainedCommand(cctx, &s).Command)
 	return &s
 }
 
@@ -3996,6 +4732,134 @@ func NewTemporalWorkerDeploymentManagerIdentityUnsetCommand(cctx *CommandContext
 	return &s
 }
 
+type TemporalWorkerDeploymentMigratePinnedCommand struct {
+	Parent         *TemporalWorkerDeploymentCommand
+	Command        cobra.Command
+	DeploymentName string
+	FromBuildId    string
+	ToBuildId      string
+	Mode           cliext.FlagStringEnum
+	Rps            float32
+	Concurrency    int
+	ReportFile     string
+	Wait           bool
+	DryRun         bool
+	DryRunLimit    int
+	Yes            bool
+}
+
+func NewTemporalWorkerDeploymentMigratePinnedCommand(cctx *CommandContext, parent *TemporalWorkerDeploymentCommand) *TemporalWorkerDeploymentMigratePinnedCommand {
+	var s TemporalWorkerDeploymentMigratePinnedCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "migrate-pinned [flags]"
+	s.Command.Short = "Move pinned Workflows to another Version"
+	if hasHighlighting {
+		s.Command.Long = "Pin the running Workflows pinned to one Version of a Deployment to\nanother Version of the same Deployment instead:\n\n\x1b[1mtemporal worker deployment migrate-pinned \\\n    --deployment-name YourDeploymentName \\\n    --from-build-id YourOldBuildID \\\n    --to-build-id YourNewBuildID\x1b[0m\n\nWorkflows are found through Visibility and updated as with\n\x1b[1mtemporal workflow update-options --versioning-override-behavior pinned\x1b[0m.\nUse \x1b[1m--dry-run\x1b[0m to preview the Workflows that would move.\n\nBy default a batch job updates the Workflows on the server. With\n\x1b[1m--mode loop\x1b[0m, the CLI updates them one at a time instead, at most\n\x1b[1m--rps\x1b[0m per second, and appends a JSON result for each Workflow moved\nto \x1b[1m--report-file\x1b[0m."
+	} else {
+		s.Command.Long = "Pin the running Workflows pinned to one Version of a Deployment to\nanother Version of the same Deployment instead:\n\n```\ntemporal worker deployment migrate-pinned \\\n    --deployment-name YourDeploymentName \\\n    --from-build-id YourOldBuildID \\\n    --to-build-id YourNewBuildID\n```\n\nWorkflows are found through Visibility and updated as with\n`temporal workflow update-options --versioning-override-behavior pinned`.\nUse `--dry-run` to preview the Workflows that would move.\n\nBy default a batch job updates the Workflows on the server. With\n`--mode loop`, the CLI updates them one at a time instead, at most\n`--rps` per second, and appends a JSON result for each Workflow moved\nto `--report-file`."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.DeploymentName, "deployment-name", "", "Name of the Worker Deployment. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "deployment-name")
+	s.Command.Flags().StringVar(&s.FromBuildId, "from-build-id", "", "Build ID of the Version Workflows are pinned to. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "from-build-id")
+	s.Command.Flags().StringVar(&s.ToBuildId, "to-build-id", "", "Build ID of the Version to pin Workflows to. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "to-build-id")
+	s.Mode = cliext.NewFlagStringEnum([]string{"batch", "loop"}, "batch")
+	s.Command.Flags().Var(&s.Mode, "mode", "Whether to update Workflows with a batch job or one at a time. Accepted values: batch, loop.")
+	s.Command.Flags().Float32Var(&s.Rps, "rps", 0, "Limit Workflow updates per second. Unlimited when 0 in loop mode, or the server default in batch mode.")
+	s.Command.Flags().IntVar(&s.Concurrency, "concurrency", 10, "Number of Workflows to update at a time in loop mode.")
+	s.Command.Flags().StringVar(&s.ReportFile, "report-file", "", "Path to a JSONL file to append per-Workflow results to in loop mode.")
+	s.Command.Flags().BoolVar(&s.Wait, "wait", false, "Wait for the batch job to complete in batch mode.")
+	s.Command.Flags().BoolVar(&s.DryRun, "dry-run", false, "Preview the Workflows to move without moving them.")
+	s.Command.Flags().IntVar(&s.DryRunLimit, "dry-run-limit", 20, "Maximum number of Workflows listed in a dry run.")
+	s.Command.Flags().BoolVarP(&s.Yes, "yes", "y", false, "Don't prompt to confirm moving Workflows.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalWorkerDeploymentPruneCommand struct {
+	Parent         *TemporalWorkerDeploymentCommand
+	Command        cobra.Command
+	DeploymentName string
+	KeepLast       int
+	DryRun         bool
+	Yes            bool
+}
+
+func NewTemporalWorkerDeploymentPruneCommand(cctx *CommandContext, parent *TemporalWorkerDeploymentCommand) *TemporalWorkerDeploymentPruneCommand {
+	var s TemporalWorkerDeploymentPruneCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "prune [flags]"
+	s.Command.Short = "Delete drained Versions of a Worker Deployment"
+	if hasHighlighting {
+		s.Command.Long = "Delete the Versions of a Deployment that are drained and have no\npollers, as with \x1b[1mdelete-version\x1b[0m:\n\n\x1b[1mtemporal worker deployment prune \\\n    --deployment-name YourDeploymentName \\\n    --keep-last 3 --dry-run\x1b[0m\n\nThe \x1b[1m--keep-last\x1b[0m most recently created Versions are kept whatever their\nstatus. Use \x1b[1m--dry-run\x1b[0m to list the Versions that would be deleted\nwithout deleting them. Versions failing to delete are reported and the\nrest are still deleted."
+	} else {
+		s.Command.Long = "Delete the Versions of a Deployment that are drained and have no\npollers, as with `delete-version`:\n\n```\ntemporal worker deployment prune \\\n    --deployment-name YourDeploymentName \\\n    --keep-last 3 --dry-run\n```\n\nThe `--keep-last` most recently created Versions are kept whatever their\nstatus. Use `--dry-run` to list the Versions that would be deleted\nwithout deleting them. Versions failing to delete are reported and the\nrest are still deleted."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.DeploymentName, "deployment-name", "", "Name of the Worker Deployment. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "deployment-name")
+	s.Command.Flags().IntVar(&s.KeepLast, "keep-last", 0, "Number of most recently created Versions to always keep.")
+	s.Command.Flags().BoolVar(&s.DryRun, "dry-run", false, "List the Versions to delete without deleting them.")
+	s.Command.Flags().BoolVarP(&s.Yes, "yes", "y", false, "Don't prompt to confirm deleting Versions.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalWorkerDeploymentRolloutCommand struct {
+	Parent  *TemporalWorkerDeploymentCommand
+	Command cobra.Command
+	DeploymentVersionOptions
+	Steps                   string
+	Interval                cliext.FlagDuration
+	MaxFailureRate          float32
+	MaxBacklog              int
+	OnFailure               cliext.FlagStringEnum
+	IgnoreMissingTaskQueues bool
+	Yes                     bool
+}
+
+func NewTemporalWorkerDeploymentRolloutCommand(cctx *CommandContext, parent *TemporalWorkerDeploymentCommand) *TemporalWorkerDeploymentRolloutCommand {
+	var s TemporalWorkerDeploymentRolloutCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "rollout [flags]"
+	s.Command.Short = "Progressively ramp a Version until it is Current"
+	if hasHighlighting {
+		s.Command.Long = "Ramp a Worker Deployment Version through increasing percentages and make\nit the Current Version after the last step:\n\n\x1b[1mtemporal worker deployment rollout \\\n    --deployment-name YourDeploymentName --build-id YourBuildID \\\n    --steps 5,25,50,100 --interval 10m\x1b[0m\n\nAfter waiting \x1b[1m--interval\x1b[0m at each ramp percentage, gates are checked\nbefore advancing:\n\n* The task failure rate of the Version's running workers over the\n  interval, from worker heartbeats, must not exceed\n  \x1b[1m--max-failure-rate\x1b[0m.\n* The total backlog of the Version's task queues must not exceed\n  \x1b[1m--max-backlog\x1b[0m, when set.\n\nWhen a gate fails, the rollout pauses at the current percentage, or\nwith \x1b[1m--on-failure rollback\x1b[0m, unsets the Ramping Version so all tasks\ngo to the previous Current Version. Either way the command fails.\n\nEach update is rejected if the Deployment's routing was changed by\nanything else since the rollout last checked it. Running the same\ncommand again resumes a paused or interrupted rollout from the current\nramp percentage, waiting out what is left of its interval. A step of\n100 makes the Version Current; without it, the rollout ends at the last\nramp percentage."
+	} else {
+		s.Command.Long = "Ramp a Worker Deployment Version through increasing percentages and make\nit the Current Version after the last step:\n\n```\ntemporal worker deployment rollout \\\n    --deployment-name YourDeploymentName --build-id YourBuildID \\\n    --steps 5,25,50,100 --interval 10m\n```\n\nAfter waiting `--interval` at each ramp percentage, gates are checked\nbefore advancing:\n\n* The task failure rate of the Version's running workers over the\n  interval, from worker heartbeats, must not exceed\n  `--max-failure-rate`.\n* The total backlog of the Version's task queues must not exceed\n  `--max-backlog`, when set.\n\nWhen a gate fails, the rollout pauses at the current percentage, or\nwith `--on-failure rollback`, unsets the Ramping Version so all tasks\ngo to the previous Current Version. Either way the command fails.\n\nEach update is rejected if the Deployment's routing was changed by\nanything else since the rollout last checked it. Running the same\ncommand again resumes a paused or interrupted rollout from the current\nramp percentage, waiting out what is left of its interval. A step of\n100 makes the Version Current; without it, the rollout ends at the last\nramp percentage."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.Steps, "steps", "5,25,50,100", "Comma-separated ramp percentages, increasing and in the range (0,100].")
+	s.Interval = cliext.MustParseFlagDuration("10m")
+	s.Command.Flags().Var(&s.Interval, "interval", "Time to wait at each ramp percentage before checking gates.")
+	s.Command.Flags().Float32Var(&s.MaxFailureRate, "max-failure-rate", 5, "Maximum task failure rate percentage of the Version's workers.")
+	s.Command.Flags().IntVar(&s.MaxBacklog, "max-backlog", 0, "Maximum total backlog count of the Version's task queues. Not checked when 0.")
+	s.OnFailure = cliext.NewFlagStringEnum([]string{"pause", "rollback"}, "pause")
+	s.Command.Flags().Var(&s.OnFailure, "on-failure", "What to do when a gate fails. Accepted values: pause, rollback.")
+	s.Command.Flags().BoolVar(&s.IgnoreMissingTaskQueues, "ignore-missing-task-queues", false, "Override protection to accidentally remove task queues.")
+	s.Command.Flags().BoolVarP(&s.Yes, "yes", "y", false, "Don't prompt to confirm starting the rollout.")
+	s.DeploymentVersionOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalWorkerDeploymentSetCurrentVersionCommand struct {
 	Parent  *TemporalWorkerDeploymentCommand
 	Command cobra.Command
@@ -4150,6 +5014,39 @@ func NewTemporalWorkerDeploymentUpdateVersionMetadataCommand(cctx *CommandContex
 	return &s
 }
 
+type TemporalWorkerDeploymentWaitDrainedCommand struct {
+	Parent  *TemporalWorkerDeploymentCommand
+	Command cobra.Command
+	DeploymentVersionOptions
+	Timeout      cliext.FlagDuration
+	PollInterval cliext.FlagDuration
+}
+
+func NewTemporalWorkerDeploymentWaitDrainedCommand(cctx *CommandContext, parent *TemporalWorkerDeploymentCommand) *TemporalWorkerDeploymentWaitDrainedCommand {
+	var s TemporalWorkerDeploymentWaitDrainedCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "wait-drained [flags]"
+	s.Command.Short = "Wait until a Worker Deployment Version is drained"
+	if hasHighlighting {
+		s.Command.Long = "Wait until a Worker Deployment Version that is no longer Current or\nRamping has no open pinned Workflows, printing its drainage status as\nthe server refreshes it:\n\n\x1b[1mtemporal worker deployment wait-drained \\\n    --deployment-name YourDeploymentName --build-id YourBuildID \\\n    --timeout 1h\x1b[0m\n\nFails if the Version is Current or Ramping, since it can't drain, or if\nit isn't drained before \x1b[1m--timeout\x1b[0m. A Version that was never Current or\nRamping has nothing to drain and returns right away."
+	} else {
+		s.Command.Long = "Wait until a Worker Deployment Version that is no longer Current or\nRamping has no open pinned Workflows, printing its drainage status as\nthe server refreshes it:\n\n```\ntemporal worker deployment wait-drained \\\n    --deployment-name YourDeploymentName --build-id YourBuildID \\\n    --timeout 1h\n```\n\nFails if the Version is Current or Ramping, since it can't drain, or if\nit isn't drained before `--timeout`. A Version that was never Current or\nRamping has nothing to drain and returns right away."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Timeout = 0
+	s.Command.Flags().Var(&s.Timeout, "timeout", "Maximum time to wait. Waits until drained when 0.")
+	s.PollInterval = cliext.MustParseFlagDuration("10s")
+	s.Command.Flags().Var(&s.PollInterval, "poll-interval", "How often to check the drainage status.")
+	s.DeploymentVersionOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalWorkerDescribeCommand struct {
 	Parent            *TemporalWorkerCommand
 	Command           cobra.Command
@@ -4209,6 +5106,46 @@ func NewTemporalWorkerListCommand(cctx *CommandContext, parent *TemporalWorkerCo
 	return &s
 }
 
+type TemporalWorkerTopCommand struct {
+	Parent               *TemporalWorkerCommand
+	Command              cobra.Command
+	Query                string
+	IncludeSystemWorkers bool
+	Sort                 cliext.FlagStringEnum
+	Interval             cliext.FlagDuration
+	StaleAfter           cliext.FlagDuration
+	Iterations           int
+}
+
+func NewTemporalWorkerTopCommand(cctx *CommandContext, parent *TemporalWorkerCommand) *TemporalWorkerTopCommand {
+	var s TemporalWorkerTopCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "top [flags]"
+	s.Command.Short = "Show a live dashboard of workers (EXPERIMENTAL)"
+	if hasHighlighting {
+		s.Command.Long = "Show an auto-refreshing table of workers built from their heartbeats,\nincluding slot usage, tasks per second, failure rate, and time since the\nlast heartbeat. Workers whose heartbeat is older than \x1b[1m--stale-after\x1b[0m are\nflagged as overdue. Press Ctrl-C to exit:\n\n\x1b[1mtemporal worker top \\\n    --namespace YourNamespace \\\n    --query 'TaskQueue=\"YourTaskQueue\"' \\\n    --sort failure-rate\x1b[0m\n\nTasks per second and failure rate cover the interval between each\nworker's last two heartbeats. With structured output, each refresh is\nprinted as a list item, one JSON line each with \x1b[1m-o jsonl\x1b[0m."
+	} else {
+		s.Command.Long = "Show an auto-refreshing table of workers built from their heartbeats,\nincluding slot usage, tasks per second, failure rate, and time since the\nlast heartbeat. Workers whose heartbeat is older than `--stale-after` are\nflagged as overdue. Press Ctrl-C to exit:\n\n```\ntemporal worker top \\\n    --namespace YourNamespace \\\n    --query 'TaskQueue=\"YourTaskQueue\"' \\\n    --sort failure-rate\n```\n\nTasks per second and failure rate cover the interval between each\nworker's last two heartbeats. With structured output, each refresh is\nprinted as a list item, one JSON line each with `-o jsonl`."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVarP(&s.Query, "query", "q", "", "Content for an SQL-like `QUERY` List Filter.")
+	s.Command.Flags().BoolVar(&s.IncludeSystemWorkers, "include-system-workers", false, "Include system workers created by the server.")
+	s.Sort = cliext.NewFlagStringEnum([]string{"tasks-per-sec", "failure-rate", "slots-used", "heartbeat-age", "task-queue", "identity"}, "tasks-per-sec")
+	s.Command.Flags().Var(&s.Sort, "sort", "Column to sort workers by. Accepted values: tasks-per-sec, failure-rate, slots-used, heartbeat-age, task-queue, identity.")
+	s.Interval = cliext.MustParseFlagDuration("2s")
+	s.Command.Flags().Var(&s.Interval, "interval", "How often to refresh.")
+	s.StaleAfter = cliext.MustParseFlagDuration("90s")
+	s.Command.Flags().Var(&s.StaleAfter, "stale-after", "Heartbeat age after which a worker is flagged as overdue.")
+	s.Command.Flags().IntVar(&s.Iterations, "iterations", 0, "Number of refreshes before exiting. Refreshes until interrupted when 0.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalWorkflowCommand struct {
 	Parent  *TemporalCommand
 	Command cobra.Command
@@ -4226,10 +5163,13 @@ func NewTemporalWorkflowCommand(cctx *CommandContext, parent *TemporalCommand) *
 		s.Command.Long = "Workflow commands perform operations on Workflow Executions:\n\n```\ntemporal workflow [command] [options]\n```\n\nFor example:\n\n```\ntemporal workflow list\n```"
 	}
 	s.Command.Args = cobra.NoArgs
+	s.Command.AddCommand(&NewTemporalWorkflowAnalyzeCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkflowBulkCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkflowCancelCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkflowCountCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkflowDeleteCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkflowDescribeCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkflowDiffCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkflowExecuteCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkflowExecuteUpdateWithStartCommand(cctx, &s).Command)
 	s.Command.AddCommand(&NewTemporalWorkflowFixHistoryJsonCommand(cctx, &s).Command)
@@ -4255,10 +5195,256 @@ func NewTemporalWorkflowCommand(cctx *CommandContext, parent *TemporalCommand) *
 	return &s
 }
 
+type TemporalWorkflowAnalyzeCommand struct {
+	Parent      *TemporalWorkflowCommand
+	Command     cobra.Command
+	HistoryFile string
+	Top         int
+}
+
+func NewTemporalWorkflowAnalyzeCommand(cctx *CommandContext, parent *TemporalWorkflowCommand) *TemporalWorkflowAnalyzeCommand {
+	var s TemporalWorkflowAnalyzeCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "analyze [flags]"
+	s.Command.Short = "Analyze an exported Event History offline"
+	if hasHighlighting {
+		s.Command.Long = "Analyze an Event History JSON file without connecting to a Temporal\nService. Export the history with \x1b[1mtemporal workflow show --output json\x1b[0m\nfirst:\n\n\x1b[1mtemporal workflow analyze \\\n    --history-file /path/to/history.json\x1b[0m\n\nThe report includes Activity, Timer, and Child Workflow durations and\nretry counts, the longest gaps between Workflow Tasks, the events with\nthe largest payloads, and the chains of failures found in the history."
+	} else {
+		s.Command.Long = "Analyze an Event History JSON file without connecting to a Temporal\nService. Export the history with `temporal workflow show --output json`\nfirst:\n\n```\ntemporal workflow analyze \\\n    --history-file /path/to/history.json\n```\n\nThe report includes Activity, Timer, and Child Workflow durations and\nretry counts, the longest gaps between Workflow Tasks, the events with\nthe largest payloads, and the chains of failures found in the history."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.HistoryFile, "history-file", "", "Path to an Event History JSON file. Required.")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "history-file")
+	s.Command.Flags().IntVar(&s.Top, "top", 5, "Number of entries to show for the longest Workflow Task gaps and largest event payloads.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalWorkflowBulkCommand struct {
+	Parent  *TemporalWorkflowCommand
+	Command cobra.Command
+}
+
+func NewTemporalWorkflowBulkCommand(cctx *CommandContext, parent *TemporalWorkflowCommand) *TemporalWorkflowBulkCommand {
+	var s TemporalWorkflowBulkCommand
+	s.Parent = parent
+	s.Command.Use = "bulk"
+	s.Command.Short = "Run an operation per Workflow from the CLI"
+	if hasHighlighting {
+		s.Command.Long = "Bulk commands send one request per Workflow Execution from the CLI\ninstead of starting a server-side batch job. Use them for operations\nbatch jobs don't support, such as Queries and Updates, or when you need\na result for every Workflow.\n\nSelect Workflow Executions with a Visibility Query or a file of\nWorkflow IDs:\n\n\x1b[1mtemporal workflow bulk signal \\\n    --query 'WorkflowType=\"YourWorkflowType\"' \\\n    --name YourSignal \\\n    --input '{\"YourInputKey\": \"YourInputValue\"}' \\\n    --checkpoint-file signal.checkpoint \\\n    --report-file signal-report.jsonl\x1b[0m\n\nEach line of an ID file is a Workflow ID, optionally followed by\nwhitespace and a Run ID, or a JSON object with \x1b[1mworkflowId\x1b[0m, \x1b[1mrunId\x1b[0m,\nand \x1b[1minput\x1b[0m fields. An \x1b[1minput\x1b[0m value replaces \x1b[1m--input\x1b[0m as the single\nargument for that Workflow. Blank lines and lines starting with \x1b[1m#\x1b[0m are\nignored.\n\nWorkflows recorded in the checkpoint file are skipped, so rerunning an\ninterrupted command with the same checkpoint file resumes where it\nstopped. Failed Workflows are not recorded and are retried. The report\nfile receives one JSON line per Workflow with its status, error, and\nresult. The command fails if any Workflow operation fails."
+	} else {
+		s.Command.Long = "Bulk commands send one request per Workflow Execution from the CLI\ninstead of starting a server-side batch job. Use them for operations\nbatch jobs don't support, such as Queries and Updates, or when you need\na result for every Workflow.\n\nSelect Workflow Executions with a Visibility Query or a file of\nWorkflow IDs:\n\n```\ntemporal workflow bulk signal \\\n    --query 'WorkflowType=\"YourWorkflowType\"' \\\n    --name YourSignal \\\n    --input '{\"YourInputKey\": \"YourInputValue\"}' \\\n    --checkpoint-file signal.checkpoint \\\n    --report-file signal-report.jsonl\n```\n\nEach line of an ID file is a Workflow ID, optionally followed by\nwhitespace and a Run ID, or a JSON object with `workflowId`, `runId`,\nand `input` fields. An `input` value replaces `--input` as the single\nargument for that Workflow. Blank lines and lines starting with `#` are\nignored.\n\nWorkflows recorded in the checkpoint file are skipped, so rerunning an\ninterrupted command with the same checkpoint file resumes where it\nstopped. Failed Workflows are not recorded and are retried. The report\nfile receives one JSON line per Workflow with its status, error, and\nresult. The command fails if any Workflow operation fails."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.AddCommand(&NewTemporalWorkflowBulkCancelCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkflowBulkDeleteCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkflowBulkQueryCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkflowBulkSignalCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkflowBulkTerminateCommand(cctx, &s).Command)
+	s.Command.AddCommand(&NewTemporalWorkflowBulkUpdateCommand(cctx, &s).Command)
+	return &s
+}
+
+type TemporalWorkflowBulkCancelCommand struct {
+	Parent  *TemporalWorkflowBulkCommand
+	Command cobra.Command
+	BulkExecutionOptions
+}
+
+func NewTemporalWorkflowBulkCancelCommand(cctx *CommandContext, parent *TemporalWorkflowBulkCommand) *TemporalWorkflowBulkCancelCommand {
+	var s TemporalWorkflowBulkCancelCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "cancel [flags]"
+	s.Command.Short = "Cancel Workflow Executions one at a time"
+	if hasHighlighting {
+		s.Command.Long = "Request cancellation of each selected Workflow Execution:\n\n\x1b[1mtemporal workflow bulk cancel \\\n    --id-file workflow-ids.txt \\\n    --concurrency 20 \\\n    --rps 50\x1b[0m"
+	} else {
+		s.Command.Long = "Request cancellation of each selected Workflow Execution:\n\n```\ntemporal workflow bulk cancel \\\n    --id-file workflow-ids.txt \\\n    --concurrency 20 \\\n    --rps 50\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.BulkExecutionOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalWorkflowBulkDeleteCommand struct {
+	Parent  *TemporalWorkflowBulkCommand
+	Command cobra.Command
+	BulkExecutionOptions
+}
+
+func NewTemporalWorkflowBulkDeleteCommand(cctx *CommandContext, parent *TemporalWorkflowBulkCommand) *TemporalWorkflowBulkDeleteCommand {
+	var s TemporalWorkflowBulkDeleteCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "delete [flags]"
+	s.Command.Short = "Delete Workflow Executions one at a time"
+	if hasHighlighting {
+		s.Command.Long = "Delete each selected Workflow Execution and its Event History:\n\n\x1b[1mtemporal workflow bulk delete \\\n    --query 'ExecutionStatus=\"Completed\"' \\\n    --checkpoint-file delete.checkpoint\x1b[0m"
+	} else {
+		s.Command.Long = "Delete each selected Workflow Execution and its Event History:\n\n```\ntemporal workflow bulk delete \\\n    --query 'ExecutionStatus=\"Completed\"' \\\n    --checkpoint-file delete.checkpoint\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.BulkExecutionOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalWorkflowBulkQueryCommand struct {
+	Parent  *TemporalWorkflowBulkCommand
+	Command cobra.Command
+	BulkExecutionOptions
+	PayloadInputOptions
+	QueryModifiersOptions
+	Name string
+}
+
+func NewTemporalWorkflowBulkQueryCommand(cctx *CommandContext, parent *TemporalWorkflowBulkCommand) *TemporalWorkflowBulkQueryCommand {
+	var s TemporalWorkflowBulkQueryCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "query [flags]"
+	s.Command.Short = "Query Workflow Executions one at a time"
+	if hasHighlighting {
+		s.Command.Long = "Send a Query to each selected Workflow Execution. Results are written\nto the report file:\n\n\x1b[1mtemporal workflow bulk query \\\n    --query 'WorkflowType=\"YourWorkflowType\"' \\\n    --name YourQuery \\\n    --report-file query-results.jsonl\x1b[0m"
+	} else {
+		s.Command.Long = "Send a Query to each selected Workflow Execution. Results are written\nto the report file:\n\n```\ntemporal workflow bulk query \\\n    --query 'WorkflowType=\"YourWorkflowType\"' \\\n    --name YourQuery \\\n    --report-file query-results.jsonl\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.Name, "name", "", "Query Type/Name. Required. Aliased as \"--type\".")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "name")
+	s.BulkExecutionOptions.BuildFlags(s.Command.Flags())
+	s.PayloadInputOptions.BuildFlags(s.Command.Flags())
+	s.QueryModifiersOptions.BuildFlags(s.Command.Flags())
+	s.Command.Flags().SetNormalizeFunc(aliasNormalizer(map[string]string{
+		"type": "name",
+	}))
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalWorkflowBulkSignalCommand struct {
+	Parent  *TemporalWorkflowBulkCommand
+	Command cobra.Command
+	BulkExecutionOptions
+	PayloadInputOptions
+	Name string
+}
+
+func NewTemporalWorkflowBulkSignalCommand(cctx *CommandContext, parent *TemporalWorkflowBulkCommand) *TemporalWorkflowBulkSignalCommand {
+	var s TemporalWorkflowBulkSignalCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "signal [flags]"
+	s.Command.Short = "Signal Workflow Executions one at a time"
+	if hasHighlighting {
+		s.Command.Long = "Send a Signal to each selected Workflow Execution. Inputs from the ID\nfile let you send a different value to each Workflow:\n\n\x1b[1mtemporal workflow bulk signal \\\n    --id-file workflows.jsonl \\\n    --name YourSignal\x1b[0m"
+	} else {
+		s.Command.Long = "Send a Signal to each selected Workflow Execution. Inputs from the ID\nfile let you send a different value to each Workflow:\n\n```\ntemporal workflow bulk signal \\\n    --id-file workflows.jsonl \\\n    --name YourSignal\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.Name, "name", "", "Signal name. Required. Aliased as \"--type\".")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "name")
+	s.BulkExecutionOptions.BuildFlags(s.Command.Flags())
+	s.PayloadInputOptions.BuildFlags(s.Command.Flags())
+	s.Command.Flags().SetNormalizeFunc(aliasNormalizer(map[string]string{
+		"type": "name",
+	}))
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalWorkflowBulkTerminateCommand struct {
+	Parent  *TemporalWorkflowBulkCommand
+	Command cobra.Command
+	BulkExecutionOptions
+	Reason string
+}
+
+func NewTemporalWorkflowBulkTerminateCommand(cctx *CommandContext, parent *TemporalWorkflowBulkCommand) *TemporalWorkflowBulkTerminateCommand {
+	var s TemporalWorkflowBulkTerminateCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "terminate [flags]"
+	s.Command.Short = "Terminate Workflow Executions one at a time"
+	if hasHighlighting {
+		s.Command.Long = "Terminate each selected Workflow Execution:\n\n\x1b[1mtemporal workflow bulk terminate \\\n    --query 'WorkflowType=\"YourWorkflowType\"' \\\n    --reason YourReasonForTermination\x1b[0m"
+	} else {
+		s.Command.Long = "Terminate each selected Workflow Execution:\n\n```\ntemporal workflow bulk terminate \\\n    --query 'WorkflowType=\"YourWorkflowType\"' \\\n    --reason YourReasonForTermination\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.Reason, "reason", "", "Reason for termination. Defaults to a message with the current user's name.")
+	s.BulkExecutionOptions.BuildFlags(s.Command.Flags())
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
+type TemporalWorkflowBulkUpdateCommand struct {
+	Parent  *TemporalWorkflowBulkCommand
+	Command cobra.Command
+	BulkExecutionOptions
+	PayloadInputOptions
+	Name string
+}
+
+func NewTemporalWorkflowBulkUpdateCommand(cctx *CommandContext, parent *TemporalWorkflowBulkCommand) *TemporalWorkflowBulkUpdateCommand {
+	var s TemporalWorkflowBulkUpdateCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "update [flags]"
+	s.Command.Short = "Update Workflow Executions one at a time"
+	if hasHighlighting {
+		s.Command.Long = "Send an Update to each selected Workflow Execution and wait for its\nresult. Results are written to the report file:\n\n\x1b[1mtemporal workflow bulk update \\\n    --id-file workflow-ids.txt \\\n    --name YourUpdate \\\n    --input '{\"YourInputKey\": \"YourInputValue\"}' \\\n    --report-file update-results.jsonl\x1b[0m"
+	} else {
+		s.Command.Long = "Send an Update to each selected Workflow Execution and wait for its\nresult. Results are written to the report file:\n\n```\ntemporal workflow bulk update \\\n    --id-file workflow-ids.txt \\\n    --name YourUpdate \\\n    --input '{\"YourInputKey\": \"YourInputValue\"}' \\\n    --report-file update-results.jsonl\n```"
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVar(&s.Name, "name", "", "Update name. Required. Aliased as \"--type\".")
+	_ = cobra.MarkFlagRequired(s.Command.Flags(), "name")
+	s.BulkExecutionOptions.BuildFlags(s.Command.Flags())
+	s.PayloadInputOptions.BuildFlags(s.Command.Flags())
+	s.Command.Flags().SetNormalizeFunc(aliasNormalizer(map[string]string{
+		"type": "name",
+	}))
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalWorkflowCancelCommand struct {
 	Parent  *TemporalWorkflowCommand
 	Command cobra.Command
 	SingleWorkflowOrBatchOptions
+	BatchJobOptions
 }
 
 func NewTemporalWorkflowCancelCommand(cctx *CommandContext, parent *TemporalWorkflowCommand) *TemporalWorkflowCancelCommand {
@@ -4274,6 +5460,7 @@ func NewTemporalWorkflowCancelCommand(cctx *CommandContext, parent *TemporalWork
 	}
 	s.Command.Args = cobra.NoArgs
 	s.SingleWorkflowOrBatchOptions.BuildFlags(s.Command.Flags())
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -4313,6 +5500,7 @@ type TemporalWorkflowDeleteCommand struct {
 	Parent  *TemporalWorkflowCommand
 	Command cobra.Command
 	SingleWorkflowOrBatchOptions
+	BatchJobOptions
 }
 
 func NewTemporalWorkflowDeleteCommand(cctx *CommandContext, parent *TemporalWorkflowCommand) *TemporalWorkflowDeleteCommand {
@@ -4328,6 +5516,7 @@ func NewTemporalWorkflowDeleteCommand(cctx *CommandContext, parent *TemporalWork
 	}
 	s.Command.Args = cobra.NoArgs
 	s.SingleWorkflowOrBatchOptions.BuildFlags(s.Command.Flags())
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -4367,6 +5556,45 @@ func NewTemporalWorkflowDescribeCommand(cctx *CommandContext, parent *TemporalWo
 	return &s
 }
 
+type TemporalWorkflowDiffCommand struct {
+	Parent           *TemporalWorkflowCommand
+	Command          cobra.Command
+	WorkflowId       string
+	RunId            string
+	HistoryFile      string
+	OtherWorkflowId  string
+	OtherRunId       string
+	OtherHistoryFile string
+	OnlyDifferences  bool
+}
+
+func NewTemporalWorkflowDiffCommand(cctx *CommandContext, parent *TemporalWorkflowCommand) *TemporalWorkflowDiffCommand {
+	var s TemporalWorkflowDiffCommand
+	s.Parent = parent
+	s.Command.DisableFlagsInUseLine = true
+	s.Command.Use = "diff [flags]"
+	s.Command.Short = "Compare the Event Histories of two Workflow Executions"
+	if hasHighlighting {
+		s.Command.Long = "Compare two Event Histories side by side, such as a Workflow Execution\nand the run created by resetting it, or runs of the same Workflow on\ndifferent builds:\n\n\x1b[1mtemporal workflow diff \\\n    --workflow-id YourWorkflowId \\\n    --run-id YourRunId \\\n    --other-run-id YourOtherRunId\x1b[0m\n\nEither side may instead be an Event History JSON file exported with\n\x1b[1mtemporal workflow show --output json\x1b[0m:\n\n\x1b[1mtemporal workflow diff \\\n    --history-file /path/to/history.json \\\n    --other-history-file /path/to/other-history.json\x1b[0m\n\nEvents are aligned by Activity ID, Timer ID, Child Workflow type, Update\nID, Signal and Marker name, or otherwise by event type and position.\nAligned events are reported when their event types or their inputs,\nresults, or failures differ. Payloads in history files are decoded with\nthe configured codec. Time deltas show how much later (or earlier) each\nevent happened relative to the start of its Workflow Execution."
+	} else {
+		s.Command.Long = "Compare two Event Histories side by side, such as a Workflow Execution\nand the run created by resetting it, or runs of the same Workflow on\ndifferent builds:\n\n```\ntemporal workflow diff \\\n    --workflow-id YourWorkflowId \\\n    --run-id YourRunId \\\n    --other-run-id YourOtherRunId\n```\n\nEither side may instead be an Event History JSON file exported with\n`temporal workflow show --output json`:\n\n```\ntemporal workflow diff \\\n    --history-file /path/to/history.json \\\n    --other-history-file /path/to/other-history.json\n```\n\nEvents are aligned by Activity ID, Timer ID, Child Workflow type, Update\nID, Signal and Marker name, or otherwise by event type and position.\nAligned events are reported when their event types or their inputs,\nresults, or failures differ. Payloads in history files are decoded with\nthe configured codec. Time deltas show how much later (or earlier) each\nevent happened relative to the start of its Workflow Execution."
+	}
+	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVarP(&s.WorkflowId, "workflow-id", "w", "", "Workflow ID of the first Execution. Required unless --history-file is set.")
+	s.Command.Flags().StringVarP(&s.RunId, "run-id", "r", "", "Run ID of the first Execution.")
+	s.Command.Flags().StringVar(&s.HistoryFile, "history-file", "", "Path to the Event History JSON file of the first Execution.")
+	s.Command.Flags().StringVar(&s.OtherWorkflowId, "other-workflow-id", "", "Workflow ID of the second Execution. Defaults to --workflow-id.")
+	s.Command.Flags().StringVar(&s.OtherRunId, "other-run-id", "", "Run ID of the second Execution.")
+	s.Command.Flags().StringVar(&s.OtherHistoryFile, "other-history-file", "", "Path to the Event History JSON file of the second Execution.")
+	s.Command.Flags().BoolVar(&s.OnlyDifferences, "only-differences", false, "Only show events that differ between the two histories.")
+	s.Command.Run = func(c *cobra.Command, args []string) {
+		if err := s.run(cctx, args); err != nil {
+			cctx.Options.Fail(err)
+		}
+	}
+	return &s
+}
+
 type TemporalWorkflowExecuteCommand struct {
 	Parent  *TemporalWorkflowCommand
 	Command cobra.Command
@@ -4486,12 +5714,13 @@ func NewTemporalWorkflowFixHistoryJsonCommand(cctx *CommandContext, parent *Temp
 }
 
 type TemporalWorkflowListCommand struct {
-	Parent   *TemporalWorkflowCommand
-	Command  cobra.Command
-	Query    string
-	Archived bool
-	Limit    int
-	PageSize int
+	Parent      *TemporalWorkflowCommand
+	Command     cobra.Command
+	Query       string
+	Archived    bool
+	Limit       int
+	PageSize    int
+	Interactive bool
 }
 
 func NewTemporalWorkflowListCommand(cctx *CommandContext, parent *TemporalWorkflowCommand) *TemporalWorkflowListCommand {
@@ -4501,15 +5730,16 @@ func NewTemporalWorkflowListCommand(cctx *CommandContext, parent *TemporalWorkfl
 	s.Command.Use = "list [flags]"
 	s.Command.Short = "Show Workflow Executions"
 	if hasHighlighting {
-		s.Command.Long = "List Workflow Executions. The optional \x1b[1m--query\x1b[0m limits the output to\nWorkflows matching a Query:\n\n\x1b[1mtemporal workflow list \\\n    --query YourQuery\x1b[0m\n\nVisit https://docs.temporal.io/visibility to read more about Search Attributes\nand Query creation. See \x1b[1mtemporal batch --help\x1b[0m for a quick reference.\n\nView a list of archived Workflow Executions:\n\n\x1b[1mtemporal workflow list \\\n    --archived\x1b[0m"
+		s.Command.Long = "List Workflow Executions. The optional \x1b[1m--query\x1b[0m limits the output to\nWorkflows matching a Query:\n\n\x1b[1mtemporal workflow list \\\n    --query YourQuery\x1b[0m\n\nVisit https://docs.temporal.io/visibility to read more about Search Attributes\nand Query creation. See \x1b[1mtemporal batch --help\x1b[0m for a quick reference.\n\nView a list of archived Workflow Executions:\n\n\x1b[1mtemporal workflow list \\\n    --archived\x1b[0m\n\nBrowse Workflow Executions in the terminal:\n\n\x1b[1mtemporal workflow list \\\n    --query YourQuery \\\n    --interactive\x1b[0m\n\nInteractive mode shows one page at a time. Use the arrow keys (or \x1b[1mj\x1b[0m\nand \x1b[1mk\x1b[0m) to select a Workflow, \x1b[1mn\x1b[0m and \x1b[1mp\x1b[0m to change pages, \x1b[1mEnter\x1b[0m to\ndescribe it, and \x1b[1mh\x1b[0m to show its Event History. Press \x1b[1ms\x1b[0m to signal,\n\x1b[1mc\x1b[0m to cancel, \x1b[1mt\x1b[0m to terminate, or \x1b[1mr\x1b[0m to reset the selected Workflow,\n\x1b[1mR\x1b[0m to refresh, \x1b[1mEsc\x1b[0m to go back, and \x1b[1mq\x1b[0m to quit. Actions ask for\nconfirmation first."
 	} else {
-		s.Command.Long = "List Workflow Executions. The optional `--query` limits the output to\nWorkflows matching a Query:\n\n```\ntemporal workflow list \\\n    --query YourQuery\n```\n\nVisit https://docs.temporal.io/visibility to read more about Search Attributes\nand Query creation. See `temporal batch --help` for a quick reference.\n\nView a list of archived Workflow Executions:\n\n```\ntemporal workflow list \\\n    --archived\n```"
+		s.Command.Long = "List Workflow Executions. The optional `--query` limits the output to\nWorkflows matching a Query:\n\n```\ntemporal workflow list \\\n    --query YourQuery\n```\n\nVisit https://docs.temporal.io/visibility to read more about Search Attributes\nand Query creation. See `temporal batch --help` for a quick reference.\n\nView a list of archived Workflow Executions:\n\n```\ntemporal workflow list \\\n    --archived\n```\n\nBrowse Workflow Executions in the terminal:\n\n```\ntemporal workflow list \\\n    --query YourQuery \\\n    --interactive\n```\n\nInteractive mode shows one page at a time. Use the arrow keys (or `j`\nand `k`) to select a Workflow, `n` and `p` to change pages, `Enter` to\ndescribe it, and `h` to show its Event History. Press `s` to signal,\n`c` to cancel, `t` to terminate, or `r` to reset the selected Workflow,\n`R` to refresh, `Esc` to go back, and `q` to quit. Actions ask for\nconfirmation first."
 	}
 	s.Command.Args = cobra.NoArgs
 	s.Command.Flags().StringVarP(&s.Query, "query", "q", "", "Content for an SQL-like `QUERY` List Filter.")
 	s.Command.Flags().BoolVar(&s.Archived, "archived", false, "Limit output to archived Workflow Executions. EXPERIMENTAL.")
 	s.Command.Flags().IntVar(&s.Limit, "limit", 0, "Maximum number of Workflow Executions to display.")
 	s.Command.Flags().IntVar(&s.PageSize, "page-size", 0, "Maximum number of Workflow Executions to fetch at a time from the server.")
+	s.Command.Flags().BoolVar(&s.Interactive, "interactive", false, "Browse Workflow Executions interactively. Cannot be used with JSON output or --limit.")
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -4610,8 +5840,9 @@ func NewTemporalWorkflowQueryCommand(cctx *CommandContext, parent *TemporalWorkf
 }
 
 type TemporalWorkflowResetCommand struct {
-	Parent         *TemporalWorkflowCommand
-	Command        cobra.Command
+	Parent  *TemporalWorkflowCommand
+	Command cobra.Command
+	BatchJobOptions
 	WorkflowId     string
 	RunId          string
 	EventId        int
@@ -4651,6 +5882,7 @@ func NewTemporalWorkflowResetCommand(cctx *CommandContext, parent *TemporalWorkf
 	s.Command.PersistentFlags().StringVar(&s.BuildId, "build-id", "", "A Build ID. Use only with the BuildId `--type`. Resets the first Workflow task processed by this ID. By default, this reset may be in a prior run, earlier than a Continue as New point.")
 	s.Command.PersistentFlags().StringVarP(&s.Query, "query", "q", "", "Content for an SQL-like `QUERY` List Filter.")
 	s.Command.PersistentFlags().BoolVarP(&s.Yes, "yes", "y", false, "Don't prompt to confirm. Only allowed when `--query` is present.")
+	s.BatchJobOptions.BuildFlags(s.Command.PersistentFlags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -4747,6 +5979,7 @@ type TemporalWorkflowSignalCommand struct {
 	Command cobra.Command
 	SingleWorkflowOrBatchOptions
 	PayloadInputOptions
+	BatchJobOptions
 	Name string
 }
 
@@ -4766,6 +5999,7 @@ func NewTemporalWorkflowSignalCommand(cctx *CommandContext, parent *TemporalWork
 	_ = cobra.MarkFlagRequired(s.Command.Flags(), "name")
 	s.SingleWorkflowOrBatchOptions.BuildFlags(s.Command.Flags())
 	s.PayloadInputOptions.BuildFlags(s.Command.Flags())
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Flags().SetNormalizeFunc(aliasNormalizer(map[string]string{
 		"type": "name",
 	}))
@@ -4944,8 +6178,9 @@ func NewTemporalWorkflowStartUpdateWithStartCommand(cctx *CommandContext, parent
 }
 
 type TemporalWorkflowTerminateCommand struct {
-	Parent     *TemporalWorkflowCommand
-	Command    cobra.Command
+	Parent  *TemporalWorkflowCommand
+	Command cobra.Command
+	BatchJobOptions
 	WorkflowId string
 	Query      string
 	RunId      string
@@ -4972,6 +6207,7 @@ func NewTemporalWorkflowTerminateCommand(cctx *CommandContext, parent *TemporalW
 	s.Command.Flags().StringVar(&s.Reason, "reason", "", "Reason for termination. Defaults to message with the current user's name.")
 	s.Command.Flags().BoolVarP(&s.Yes, "yes", "y", false, "Don't prompt to confirm termination. Can only be used with --query.")
 	s.Command.Flags().Float32Var(&s.Rps, "rps", 0, "Limit batch's requests per second. Only allowed if query is present.")
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -4981,13 +6217,16 @@ func NewTemporalWorkflowTerminateCommand(cctx *CommandContext, parent *TemporalW
 }
 
 type TemporalWorkflowTraceCommand struct {
-	Parent  *TemporalWorkflowCommand
-	Command cobra.Command
-	WorkflowReferenceOptions
-	Fold        []string
-	NoFold      bool
-	Depth       int
-	Concurrency int
+	Parent          *TemporalWorkflowCommand
+	Command         cobra.Command
+	WorkflowId      string
+	RunId           string
+	HistoryFile     string
+	ChildHistoryDir string
+	Fold            []string
+	NoFold          bool
+	Depth           int
+	Concurrency     int
 }
 
 func NewTemporalWorkflowTraceCommand(cctx *CommandContext, parent *TemporalWorkflowCommand) *TemporalWorkflowTraceCommand {
@@ -4997,16 +6236,19 @@ func NewTemporalWorkflowTraceCommand(cctx *CommandContext, parent *TemporalWorkf
 	s.Command.Use = "trace [flags]"
 	s.Command.Short = "Workflow Execution live progress"
 	if hasHighlighting {
-		s.Command.Long = "Display the progress of a Workflow Execution and its child workflows with a\nreal-time trace. This view helps you understand how Workflows are proceeding:\n\n\x1b[1mtemporal workflow trace \\\n    --workflow-id YourWorkflowId\x1b[0m"
+		s.Command.Long = "Display the progress of a Workflow Execution and its child workflows with a\nreal-time trace. This view helps you understand how Workflows are proceeding:\n\n\x1b[1mtemporal workflow trace \\\n    --workflow-id YourWorkflowId\x1b[0m\n\nTo render an exported or archived Execution without a Temporal Service,\npass its Event History JSON file (from \x1b[1mtemporal workflow show --output\njson\x1b[0m) instead. Child Workflow histories are read from the JSON files in\na directory, named after their Workflow or Run ID:\n\n\x1b[1mtemporal workflow trace \\\n    --history-file /path/to/history.json \\\n    --child-history-dir /path/to/children\x1b[0m"
 	} else {
-		s.Command.Long = "Display the progress of a Workflow Execution and its child workflows with a\nreal-time trace. This view helps you understand how Workflows are proceeding:\n\n```\ntemporal workflow trace \\\n    --workflow-id YourWorkflowId\n```"
+		s.Command.Long = "Display the progress of a Workflow Execution and its child workflows with a\nreal-time trace. This view helps you understand how Workflows are proceeding:\n\n```\ntemporal workflow trace \\\n    --workflow-id YourWorkflowId\n```\n\nTo render an exported or archived Execution without a Temporal Service,\npass its Event History JSON file (from `temporal workflow show --output\njson`) instead. Child Workflow histories are read from the JSON files in\na directory, named after their Workflow or Run ID:\n\n```\ntemporal workflow trace \\\n    --history-file /path/to/history.json \\\n    --child-history-dir /path/to/children\n```"
 	}
 	s.Command.Args = cobra.NoArgs
+	s.Command.Flags().StringVarP(&s.WorkflowId, "workflow-id", "w", "", "Workflow ID. Required unless --history-file is set.")
+	s.Command.Flags().StringVarP(&s.RunId, "run-id", "r", "", "Run ID.")
+	s.Command.Flags().StringVar(&s.HistoryFile, "history-file", "", "Path to an Event History JSON file to render instead of fetching the history from the Temporal Service.")
+	s.Command.Flags().StringVar(&s.ChildHistoryDir, "child-history-dir", "", "Directory of Child Workflow Event History JSON files. Only used with --history-file.")
 	s.Command.Flags().StringArrayVar(&s.Fold, "fold", nil, "Fold away Child Workflows with the specified statuses. Case-insensitive. Ignored if --no-fold supplied. Available values: running, completed, failed, canceled, terminated, timedout, continueasnew. Can be passed multiple times.")
 	s.Command.Flags().BoolVar(&s.NoFold, "no-fold", false, "Disable folding. Fetch and display Child Workflows within the set depth.")
 	s.Command.Flags().IntVar(&s.Depth, "depth", -1, "Set depth for your Child Workflow fetches. Pass -1 to fetch child workflows at any depth.")
 	s.Command.Flags().IntVar(&s.Concurrency, "concurrency", 10, "Number of Workflow Histories to fetch at a time.")
-	s.WorkflowReferenceOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -5185,6 +6427,7 @@ type TemporalWorkflowUpdateOptionsCommand struct {
 	Parent  *TemporalWorkflowCommand
 	Command cobra.Command
 	SingleWorkflowOrBatchOptions
+	BatchJobOptions
 	VersioningOverrideBehavior       cliext.FlagStringEnum
 	VersioningOverrideDeploymentName string
 	VersioningOverrideBuildId        string
@@ -5208,6 +6451,7 @@ func NewTemporalWorkflowUpdateOptionsCommand(cctx *CommandContext, parent *Tempo
 	s.Command.Flags().StringVar(&s.VersioningOverrideDeploymentName, "versioning-override-deployment-name", "", "When overriding to a `pinned` or `one_time` behavior, specifies the Deployment Name of the version to target.")
 	s.Command.Flags().StringVar(&s.VersioningOverrideBuildId, "versioning-override-build-id", "", "When overriding to a `pinned` or `one_time` behavior, specifies the Build ID of the version to target.")
 	s.SingleWorkflowOrBatchOptions.BuildFlags(s.Command.Flags())
+	s.BatchJobOptions.BuildFlags(s.Command.Flags())
 	s.Command.Run = func(c *cobra.Command, args []string) {
 		if err := s.run(cctx, args); err != nil {
 			cctx.Options.Fail(err)
@@ -89,6 +89,9 @@ type CommandOptions struct {
 	Fail func(error)
 
 	AdditionalClientGRPCDialOptions []grpc.DialOption
+
+	// Used to open URLs for login. If nil, the OS default browser is used.
+	OpenBrowser func(url string) error
 }
 
 type DeprecatedEnvConfig struct {
@@ -100,6 +103,20 @@ type DeprecatedEnvConfig struct {
 	DisableEnvConfig bool
 }
 
+// ExitCodeError fails a command with a specific exit code instead of 1.
+type ExitCodeError struct {
+	Code int
+	Err  error
+}
+
+func (err ExitCodeError) Error() string {
+	return err.Err.Error()
+}
+
+func (err ExitCodeError) Unwrap() error {
+	return err.Err
+}
+
 // NewCommandContext creates a CommandContext for use by the rest of the CLI.
 // Among other things, this parses the env config file and modifies
 // options/flags according to the parameters set there.
@@ -155,6 +172,9 @@ func (c *CommandContext) preprocessOptions() error {
 				os.Exit(exitError.ExitCode())
 			}
 			fmt.Fprintf(c.Options.Stderr, "Error: %v\n", err)
+			if exitError, ok := errors.AsType[ExitCodeError](err); ok {
+				os.Exit(exitError.Code)
+			}
 			os.Exit(1)
 		}
 	}
@@ -623,8 +643,8 @@ func (c *TemporalCommand) initCommand(cctx *CommandContext) {
 
 		res := c.preRun(cctx)
 
-		// Always disable color if JSON output is on (must be run after preRun so JSONOutput is set)
-		if cctx.JSONOutput {
+		// Always disable color if JSON or CSV output is on (must be run after preRun so JSONOutput is set)
+		if cctx.JSONOutput || c.Output.Value == "csv" {
 			color.NoColor = true
 		}
 		cctx.ActuallyRanCommand = true
@@ -676,17 +696,35 @@ func (c *TemporalCommand) preRun(cctx *CommandContext) error {
 		}
 	}
 
-	// Configure printer if not already on context
-	cctx.JSONOutput = c.Output.Value == "json" || c.Output.Value == "jsonl"
+	// Output templates are applied to the JSON representation of each item
+	var outputTemplate printer.OutputTemplate
+	if c.OutputTemplate != "" && c.OutputJsonpath != "" {
+		return fmt.Errorf("cannot set both --output-template and --output-jsonpath")
+	} else if c.OutputTemplate != "" {
+		var err error
+		if outputTemplate, err = printer.NewGoTemplate(c.OutputTemplate); err != nil {
+			return fmt.Errorf("invalid output template: %w", err)
+		}
+	} else if c.OutputJsonpath != "" {
+		var err error
+		if outputTemplate, err = printer.NewJSONPath(c.OutputJsonpath); err != nil {
+			return fmt.Errorf("invalid output JSONPath: %w", err)
+		}
+	}
+
+	// Configure printer if not already on context. YAML and templates are
+	// printed from the same structured values as JSON.
+	cctx.JSONOutput = outputTemplate != nil ||
+		c.Output.Value == "json" || c.Output.Value == "jsonl" || c.Output.Value == "yaml"
 	// Only indent JSON if not jsonl
 	var jsonIndent string
-	if c.Output.Value == "json" {
+	if c.Output.Value == "json" && outputTemplate == nil {
 		jsonIndent = "  "
 	}
 	if cctx.Printer == nil {
 		printerOutput := cctx.Options.Stdout
 		// Disable printer by making writer noop if "none" chosen
-		if c.Output.Value == "none" {
+		if c.Output.Value == "none" && outputTemplate == nil {
 			printerOutput = nopWriter{}
 		}
 		cctx.Printer = &printer.Printer{
@@ -694,6 +732,9 @@ func (c *TemporalCommand) preRun(cctx *CommandContext) error {
 			JSON:                 cctx.JSONOutput,
 			JSONIndent:           jsonIndent,
 			JSONPayloadShorthand: !c.NoJsonShorthandPayloads,
+			YAML:                 c.Output.Value == "yaml" && outputTemplate == nil,
+			CSV:                  c.Output.Value == "csv" && outputTemplate == nil,
+			Template:             outputTemplate,
 		}
 		switch c.TimeFormat.Value {
 		case "iso":
@@ -0,0 +1,234 @@
+package temporalcli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/temporalio/cli/cliext"
+	"golang.org/x/oauth2"
+)
+
+func (c *TemporalLoginCommand) run(cctx *CommandContext, args []string) error {
+	if cctx.RootCommand.DisableConfigFile {
+		return fmt.Errorf("cannot log in when config file is disabled")
+	}
+	existing, err := cliext.LoadClientOAuth(cliext.LoadClientOAuthOptions{
+		ConfigFilePath: cctx.RootCommand.ConfigFile,
+		ProfileName:    cctx.RootCommand.Profile,
+		EnvLookup:      cctx.Options.EnvLookup,
+	})
+	if err != nil {
+		return err
+	}
+	conf := c.clientConfig(existing.OAuth)
+	if conf.ClientID == "" {
+		return fmt.Errorf("client ID is required")
+	} else if conf.Endpoint.TokenURL == "" {
+		return fmt.Errorf("token URL is required")
+	}
+
+	ctx, cancel := context.WithTimeout(cctx, c.Timeout.Duration())
+	defer cancel()
+	var token *oauth2.Token
+	if c.Device {
+		token, err = c.deviceCodeLogin(cctx, ctx, conf)
+	} else {
+		token, err = c.authCodeLogin(cctx, ctx, conf)
+	}
+	if err != nil {
+		return fmt.Errorf("login failed: %w", err)
+	}
+
+	err = cliext.StoreClientOAuth(cliext.StoreClientOAuthOptions{
+		ConfigFilePath: existing.ConfigFilePath,
+		ProfileName:    existing.ProfileName,
+		OAuth:          &cliext.OAuthConfig{ClientConfig: conf, Token: token},
+		Address:        c.Address,
+		EnvLookup:      cctx.Options.EnvLookup,
+	})
+	if err != nil {
+		return err
+	}
+	cctx.Printer.Printlnf("Logged in, token stored in profile %q of %v", existing.ProfileName, existing.ConfigFilePath)
+	return nil
+}
+
+// clientConfig builds the OAuth client config from the flags, falling back to
+// the settings stored in the profile.
+func (c *TemporalLoginCommand) clientConfig(existing *cliext.OAuthConfig) *oauth2.Config {
+	conf := &oauth2.Config{}
+	if existing != nil && existing.ClientConfig != nil {
+		*conf = *existing.ClientConfig
+	}
+	if c.ClientId != "" {
+		conf.ClientID = c.ClientId
+	}
+	if c.ClientSecret != "" {
+		conf.ClientSecret = c.ClientSecret
+	}
+	if c.AuthUrl != "" {
+		conf.Endpoint.AuthURL = c.AuthUrl
+	}
+	if c.TokenUrl != "" {
+		conf.Endpoint.TokenURL = c.TokenUrl
+	}
+	if c.DeviceAuthUrl != "" {
+		conf.Endpoint.DeviceAuthURL = c.DeviceAuthUrl
+	}
+	if len(c.Scope) > 0 {
+		conf.Scopes = c.Scope
+	}
+	return conf
+}
+
+// authCodeLogin runs the authorization code flow with PKCE, receiving the code
+// on a loopback listener.
+func (c *TemporalLoginCommand) authCodeLogin(
+	cctx *CommandContext,
+	ctx context.Context,
+	conf *oauth2.Config,
+) (*oauth2.Token, error) {
+	if conf.Endpoint.AuthURL == "" {
+		return nil, fmt.Errorf("auth URL is required")
+	}
+	ln, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(c.RedirectPort)))
+	if err != nil {
+		return nil, fmt.Errorf("failed starting redirect listener: %w", err)
+	}
+	conf.RedirectURL = fmt.Sprintf("http://%v/callback", ln.Addr())
+
+	state := oauth2.GenerateVerifier()
+	verifier := oauth2.GenerateVerifier()
+	type callbackResult struct {
+		code string
+		err  error
+	}
+	resultCh := make(chan callbackResult, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		var result callbackResult
+		switch {
+		case query.Get("state") != state:
+			http.Error(w, "Invalid state.", http.StatusBadRequest)
+			return
+		case query.Get("error") != "":
+			result.err = fmt.Errorf("authorization error: %v %v", query.Get("error"), query.Get("error_description"))
+			http.Error(w, "Login failed, return to the terminal for details.", http.StatusBadRequest)
+		case query.Get("code") == "":
+			result.err = fmt.Errorf("authorization response missing code")
+			http.Error(w, "Login failed, return to the terminal for details.", http.StatusBadRequest)
+		default:
+			result.code = query.Get("code")
+			_, _ = fmt.Fprintln(w, "Login complete, you may close this window.")
+		}
+		select {
+		case resultCh <- result:
+		default:
+		}
+	})
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = srv.Serve(ln) }()
+	defer srv.Close()
+
+	authURL := conf.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))
+	if c.NoBrowser {
+		cctx.Printer.Printlnf("Open the following URL to log in:\n\n  %v\n", authURL)
+	} else {
+		cctx.Printer.Printlnf("Opening browser to log in. If it does not open, visit:\n\n  %v\n", authURL)
+		openBrowser := cctx.Options.OpenBrowser
+		if openBrowser == nil {
+			openBrowser = openSystemBrowser
+		}
+		if err := openBrowser(authURL); err != nil {
+			fmt.Fprintf(cctx.Options.Stderr, "Warning: Failed opening browser, visit the URL above: %v\n", err)
+		}
+	}
+
+	var result callbackResult
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result = <-resultCh:
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return conf.Exchange(ctx, result.code, oauth2.VerifierOption(verifier))
+}
+
+// deviceCodeLogin runs the device code flow, polling until the user has
+// entered the code elsewhere.
+func (c *TemporalLoginCommand) deviceCodeLogin(
+	cctx *CommandContext,
+	ctx context.Context,
+	conf *oauth2.Config,
+) (*oauth2.Token, error) {
+	if conf.Endpoint.DeviceAuthURL == "" {
+		return nil, fmt.Errorf("device auth URL is required")
+	}
+	resp, err := conf.DeviceAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.VerificationURIComplete != "" {
+		cctx.Printer.Printlnf("Visit the following URL to log in:\n\n  %v\n", resp.VerificationURIComplete)
+	} else {
+		cctx.Printer.Printlnf("Visit %v and enter the code: %v\n", resp.VerificationURI, resp.UserCode)
+	}
+	token, err := conf.DeviceAccessToken(ctx, resp)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("timed out waiting for device authorization")
+	}
+	return token, err
+}
+
+func openSystemBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+func (c *TemporalLogoutCommand) run(cctx *CommandContext, args []string) error {
+	if cctx.RootCommand.DisableConfigFile {
+		return fmt.Errorf("cannot log out when config file is disabled")
+	}
+	existing, err := cliext.LoadClientOAuth(cliext.LoadClientOAuthOptions{
+		ConfigFilePath: cctx.RootCommand.ConfigFile,
+		ProfileName:    cctx.RootCommand.Profile,
+		EnvLookup:      cctx.Options.EnvLookup,
+	})
+	if err != nil {
+		return err
+	} else if existing.OAuth == nil || existing.OAuth.Token == nil || existing.OAuth.Token.AccessToken == "" {
+		cctx.Printer.Printlnf("Not logged in to profile %q", existing.ProfileName)
+		return nil
+	}
+	// Keep client settings for the next login
+	existing.OAuth.Token = &oauth2.Token{}
+	err = cliext.StoreClientOAuth(cliext.StoreClientOAuthOptions{
+		ConfigFilePath: existing.ConfigFilePath,
+		ProfileName:    existing.ProfileName,
+		OAuth:          existing.OAuth,
+		EnvLookup:      cctx.Options.EnvLookup,
+	})
+	if err != nil {
+		return err
+	}
+	cctx.Printer.Printlnf("Logged out of profile %q", existing.ProfileName)
+	return nil
+}
@@ -0,0 +1,147 @@
+package temporalcli_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/temporalio/cli/cliext"
+)
+
+// fakeOAuthServer is a minimal authorization server supporting the
+// authorization code flow with PKCE and the device code flow.
+type fakeOAuthServer struct {
+	*httptest.Server
+	mu        sync.Mutex
+	challenge string
+}
+
+func newFakeOAuthServer(t *testing.T) *fakeOAuthServer {
+	s := &fakeOAuthServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("client_id") != "my-client" || q.Get("code_challenge_method") != "S256" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		s.challenge = q.Get("code_challenge")
+		s.mu.Unlock()
+		redirect, _ := url.Parse(q.Get("redirect_uri"))
+		redirect.RawQuery = url.Values{"code": {"my-code"}, "state": {q.Get("state")}}.Encode()
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	})
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":      "my-device-code",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": s.URL + "/activate",
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		var accessToken string
+		switch r.Form.Get("grant_type") {
+		case "authorization_code":
+			sum := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+			s.mu.Lock()
+			valid := r.Form.Get("code") == "my-code" && base64.RawURLEncoding.EncodeToString(sum[:]) == s.challenge
+			s.mu.Unlock()
+			if !valid {
+				http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+			accessToken = "auth-code-token"
+		case "urn:ietf:params:oauth:grant-type:device_code":
+			if r.Form.Get("device_code") != "my-device-code" {
+				http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+			accessToken = "device-token"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  accessToken,
+			"refresh_token": "my-refresh-token",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestLogin_AuthCode_Logout_Device(t *testing.T) {
+	h := NewCommandHarness(t)
+	srv := newFakeOAuthServer(t)
+	configFile := filepath.Join(t.TempDir(), "temporal.toml")
+	// Browser just follows redirects back to the CLI
+	h.Options.OpenBrowser = func(u string) error {
+		go func() {
+			if resp, err := http.Get(u); err == nil {
+				resp.Body.Close()
+			}
+		}()
+		return nil
+	}
+	loadOAuth := func() *cliext.OAuthConfig {
+		res, err := cliext.LoadClientOAuth(cliext.LoadClientOAuthOptions{ConfigFilePath: configFile, ProfileName: "foo"})
+		h.NoError(err)
+		h.NotNil(res.OAuth)
+		return res.OAuth
+	}
+
+	res := h.Execute(
+		"login",
+		"--config-file", configFile,
+		"--profile", "foo",
+		"--client-id", "my-client",
+		"--auth-url", srv.URL+"/authorize",
+		"--token-url", srv.URL+"/token",
+		"--device-auth-url", srv.URL+"/device",
+		"--scope", "openid",
+		"--address", "my-address:7233",
+	)
+	h.NoError(res.Err)
+	h.Contains(res.Stdout.String(), `Logged in, token stored in profile "foo"`)
+	oauth := loadOAuth()
+	h.Equal("auth-code-token", oauth.Token.AccessToken)
+	h.Equal("my-refresh-token", oauth.Token.RefreshToken)
+	h.Equal("my-client", oauth.ClientConfig.ClientID)
+	h.Equal([]string{"openid"}, oauth.ClientConfig.Scopes)
+	res = h.Execute("config", "get", "--config-file", configFile, "--profile", "foo", "--prop", "address")
+	h.NoError(res.Err)
+	h.Contains(res.Stdout.String(), "my-address:7233")
+
+	// Logout keeps client settings
+	res = h.Execute("logout", "--config-file", configFile, "--profile", "foo")
+	h.NoError(res.Err)
+	h.Contains(res.Stdout.String(), `Logged out of profile "foo"`)
+	oauth = loadOAuth()
+	h.Empty(oauth.Token.AccessToken)
+	h.Empty(oauth.Token.RefreshToken)
+	h.Equal("my-client", oauth.ClientConfig.ClientID)
+	res = h.Execute("logout", "--config-file", configFile, "--profile", "foo")
+	h.NoError(res.Err)
+	h.Contains(res.Stdout.String(), `Not logged in to profile "foo"`)
+
+	// Device flow reuses stored settings
+	res = h.Execute("login", "--config-file", configFile, "--profile", "foo", "--device")
+	h.NoError(res.Err)
+	h.Contains(res.Stdout.String(), "Visit "+srv.URL+"/activate and enter the code: ABCD-EFGH")
+	h.Equal("device-token", loadOAuth().Token.AccessToken)
+
+	// Nothing to reuse in another profile
+	res = h.Execute("login", "--config-file", configFile, "--profile", "bar", "--device")
+	h.EqualError(res.Err, "client ID is required")
+}
@@ -0,0 +1,650 @@
+package temporalcli
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/temporalio/cli/cliext"
+	"github.com/temporalio/cli/internal/printer"
+	enums "go.temporal.io/api/enums/v1"
+	nexuspb "go.temporal.io/api/nexus/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// operatorManifest is the file format read by operator apply and written by
+// operator export.
+type operatorManifest struct {
+	Namespace        operatorManifestNamespace        `yaml:"namespace" json:"namespace"`
+	SearchAttributes map[string]string                `yaml:"searchAttributes,omitempty" json:"searchAttributes,omitempty"`
+	NexusEndpoints   []*operatorManifestNexusEndpoint `yaml:"nexusEndpoints,omitempty" json:"nexusEndpoints,omitempty"`
+	TaskQueues       []*operatorManifestTaskQueue     `yaml:"taskQueues,omitempty" json:"taskQueues,omitempty"`
+}
+
+type operatorManifestNamespace struct {
+	Name        string            `yaml:"name,omitempty" json:"name,omitempty"`
+	Description string            `yaml:"description,omitempty" json:"description,omitempty"`
+	OwnerEmail  string            `yaml:"ownerEmail,omitempty" json:"ownerEmail,omitempty"`
+	Retention   string            `yaml:"retention,omitempty" json:"retention,omitempty"`
+	Data        map[string]string `yaml:"data,omitempty" json:"data,omitempty"`
+}
+
+type operatorManifestNexusEndpoint struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	// Defaults to the manifest namespace when a target task queue is set
+	TargetNamespace string `yaml:"targetNamespace,omitempty" json:"targetNamespace,omitempty"`
+	TargetTaskQueue string `yaml:"targetTaskQueue,omitempty" json:"targetTaskQueue,omitempty"`
+	TargetUrl       string `yaml:"targetUrl,omitempty" json:"targetUrl,omitempty"`
+}
+
+// operatorManifestTaskQueue is the full configuration of a task queue, so
+// unset limits and weights are removed on apply.
+type operatorManifestTaskQueue struct {
+	Name                       string             `yaml:"name" json:"name"`
+	Type                       string             `yaml:"type,omitempty" json:"type,omitempty"`
+	QueueRpsLimit              *float32           `yaml:"queueRpsLimit,omitempty" json:"queueRpsLimit,omitempty"`
+	FairnessKeyRpsLimitDefault *float32           `yaml:"fairnessKeyRpsLimitDefault,omitempty" json:"fairnessKeyRpsLimitDefault,omitempty"`
+	FairnessKeyWeights         map[string]float32 `yaml:"fairnessKeyWeights,omitempty" json:"fairnessKeyWeights,omitempty"`
+}
+
+type operatorApplyPlan struct {
+	Namespace string                 `json:"namespace"`
+	Create    []string               `json:"create"`
+	Update    []*operatorApplyUpdate `json:"update"`
+	Unchanged []string               `json:"unchanged"`
+}
+
+type operatorApplyUpdate struct {
+	Resource string            `json:"resource"`
+	Changes  []*manifestChange `json:"changes"`
+}
+
+// namespaceReadyTimeout is how long apply waits for a new namespace to be
+// usable, which is bound by the server's namespace cache refresh interval.
+const namespaceReadyTimeout = 30 * time.Second
+
+// operatorApplyStep is a single call made by apply, built during planning so
+// invalid resources fail before any change.
+type operatorApplyStep struct {
+	apply func() error
+	done  string
+}
+
+// operatorApplyPlanner compares a manifest against the server and collects
+// the plan along with the steps to execute it.
+type operatorApplyPlanner struct {
+	cctx *CommandContext
+	cl   client.Client
+	// Client options with the manifest namespace
+	clientOptions cliext.ClientOptions
+	// Whether the namespace is created by this apply
+	createNamespace bool
+
+	plan  operatorApplyPlan
+	steps []*operatorApplyStep
+}
+
+func (c *TemporalOperatorApplyCommand) run(cctx *CommandContext, args []string) error {
+	manifest, err := readOperatorManifest(c.File)
+	if err != nil {
+		return err
+	}
+	if manifest.Namespace.Name == "" {
+		manifest.Namespace.Name = c.Parent.Namespace
+	}
+	cl, err := dialClient(cctx, &c.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	p := &operatorApplyPlanner{cctx: cctx, cl: cl, clientOptions: c.Parent.ClientOptions}
+	p.clientOptions.Namespace = manifest.Namespace.Name
+	p.plan.Namespace = manifest.Namespace.Name
+	if err := p.planNamespace(&manifest.Namespace); err != nil {
+		return err
+	} else if err := p.planSearchAttributes(manifest.SearchAttributes); err != nil {
+		return err
+	} else if err := p.planNexusEndpoints(manifest.NexusEndpoints); err != nil {
+		return err
+	}
+	for _, tq := range manifest.TaskQueues {
+		if err := p.planTaskQueue(tq); err != nil {
+			return err
+		}
+	}
+
+	printOperatorApplyPlan(cctx, &p.plan)
+	if c.DryRun || len(p.steps) == 0 {
+		return nil
+	}
+	if yes, err := cctx.promptYes("Apply these changes? y/N", c.Yes); err != nil {
+		return err
+	} else if !yes {
+		return fmt.Errorf("user denied confirmation")
+	}
+	for _, step := range p.steps {
+		if err := p.applyStep(step); err != nil {
+			return err
+		}
+		cctx.Printer.Println(step.done)
+	}
+	return nil
+}
+
+// applyStep runs the step, retrying while a namespace created by this apply is
+// not yet known to the server's namespace caches.
+func (p *operatorApplyPlanner) applyStep(step *operatorApplyStep) error {
+	deadline := time.Now().Add(namespaceReadyTimeout)
+	for {
+		err := step.apply()
+		var notFound *serviceerror.NamespaceNotFound
+		if !p.createNamespace || !errors.As(err, &notFound) || time.Now().After(deadline) {
+			return err
+		}
+		select {
+		case <-p.cctx.Done():
+			return p.cctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (p *operatorApplyPlanner) addStep(done string, apply func() error) {
+	p.steps = append(p.steps, &operatorApplyStep{apply: apply, done: done})
+}
+
+func (p *operatorApplyPlanner) planNamespace(ns *operatorManifestNamespace) error {
+	resource := "namespace/" + ns.Name
+	resp, err := p.cl.WorkflowService().DescribeNamespace(p.cctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: ns.Name,
+	})
+	var notFound *serviceerror.NamespaceNotFound
+	if errors.As(err, &notFound) {
+		// Start from the flag defaults of namespace create
+		create := NewTemporalOperatorNamespaceCreateCommand(p.cctx, &TemporalOperatorNamespaceCommand{})
+		create.Description = ns.Description
+		create.Email = ns.OwnerEmail
+		create.Data = manifestKeyValuePairs(ns.Data)
+		if ns.Retention != "" {
+			if err := create.Retention.Set(ns.Retention); err != nil {
+				return fmt.Errorf("invalid namespace retention: %w", err)
+			}
+		}
+		req, err := create.registerNamespaceRequest(ns.Name)
+		if err != nil {
+			return err
+		}
+		p.createNamespace = true
+		p.plan.Create = append(p.plan.Create, resource)
+		p.addStep("Created "+resource, func() error {
+			if _, err := p.cl.WorkflowService().RegisterNamespace(p.cctx, req); err != nil {
+				return fmt.Errorf("unable to create namespace %s: %w", ns.Name, err)
+			}
+			return nil
+		})
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed describing namespace %s: %w", ns.Name, err)
+	}
+
+	// Only fields and data keys set in the file are compared since update
+	// cannot unset them
+	var fields []manifestField
+	update := &TemporalOperatorNamespaceUpdateCommand{}
+	if ns.Description != "" {
+		fields = append(fields, manifestField{"description", resp.NamespaceInfo.GetDescription(), ns.Description})
+		update.Description = ns.Description
+	}
+	if ns.OwnerEmail != "" {
+		fields = append(fields, manifestField{"ownerEmail", resp.NamespaceInfo.GetOwnerEmail(), ns.OwnerEmail})
+		update.Email = ns.OwnerEmail
+	}
+	if ns.Retention != "" {
+		if err := update.Retention.Set(ns.Retention); err != nil {
+			return fmt.Errorf("invalid namespace retention: %w", err)
+		}
+		fields = append(fields, manifestField{"retention",
+			canonicalManifestDuration(resp.Config.GetWorkflowExecutionRetentionTtl().AsDuration().String()),
+			canonicalManifestDuration(ns.Retention)})
+	}
+	for _, k := range sortedKeys(ns.Data) {
+		if existing, ok := resp.NamespaceInfo.GetData()[k]; !ok || existing != ns.Data[k] {
+			fields = append(fields, manifestField{"data." + k, existing, ns.Data[k]})
+			update.Data = append(update.Data, k+"="+ns.Data[k])
+		}
+	}
+	changes := diffManifestFields(fields...)
+	if len(changes) == 0 {
+		p.plan.Unchanged = append(p.plan.Unchanged, resource)
+		return nil
+	}
+	req, err := update.updateNamespaceRequest(ns.Name, resp)
+	if err != nil {
+		return err
+	}
+	p.plan.Update = append(p.plan.Update, &operatorApplyUpdate{Resource: resource, Changes: changes})
+	p.addStep("Updated "+resource, func() error {
+		if _, err := p.cl.WorkflowService().UpdateNamespace(p.cctx, req); err != nil {
+			return fmt.Errorf("namespace update failed: %w", err)
+		}
+		return nil
+	})
+	return nil
+}
+
+func (p *operatorApplyPlanner) planSearchAttributes(searchAttributes map[string]string) error {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	existing := &operatorservice.ListSearchAttributesResponse{}
+	if !p.createNamespace {
+		var err error
+		existing, err = p.cl.OperatorService().ListSearchAttributes(p.cctx, &operatorservice.ListSearchAttributesRequest{
+			Namespace: p.clientOptions.Namespace,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to get existing search attributes: %w", err)
+		}
+	}
+	create := &TemporalOperatorSearchAttributeCreateCommand{
+		Parent: &TemporalOperatorSearchAttributeCommand{
+			Parent: &TemporalOperatorCommand{ClientOptions: p.clientOptions},
+		},
+	}
+	for _, name := range sortedKeys(searchAttributes) {
+		resource := "search-attribute/" + name
+		if _, ok := existing.CustomAttributes[name]; ok {
+			p.plan.Unchanged = append(p.plan.Unchanged, resource)
+		} else {
+			p.plan.Create = append(p.plan.Create, resource)
+		}
+		create.Name = append(create.Name, name)
+		create.Type.Values = append(create.Type.Values, searchAttributes[name])
+	}
+	// Also validates the types of existing attributes
+	req, err := create.addSearchAttributesRequest(existing)
+	if err != nil {
+		return err
+	}
+	for name := range req.SearchAttributes {
+		if _, ok := existing.CustomAttributes[name]; ok {
+			delete(req.SearchAttributes, name)
+		}
+	}
+	if len(req.SearchAttributes) == 0 {
+		return nil
+	}
+	p.addStep(fmt.Sprintf("Added %v search attribute(s)", len(req.SearchAttributes)), func() error {
+		if _, err := p.cl.OperatorService().AddSearchAttributes(p.cctx, req); err != nil {
+			return fmt.Errorf("unable to add search attributes: %w", err)
+		}
+		return nil
+	})
+	return nil
+}
+
+func (p *operatorApplyPlanner) planNexusEndpoints(endpoints []*operatorManifestNexusEndpoint) error {
+	if len(endpoints) == 0 {
+		return nil
+	}
+	existing, err := listNexusEndpoints(p.cctx, p.cl)
+	if err != nil {
+		return err
+	}
+	for _, ep := range endpoints {
+		resource := "nexus-endpoint/" + ep.Name
+		targetNamespace := ep.TargetNamespace
+		if targetNamespace == "" && ep.TargetTaskQueue != "" {
+			targetNamespace = p.clientOptions.Namespace
+		}
+		config := NexusEndpointConfigOptions{
+			Description:     ep.Description,
+			TargetNamespace: targetNamespace,
+			TargetTaskQueue: ep.TargetTaskQueue,
+			TargetUrl:       ep.TargetUrl,
+		}
+		identity := NexusEndpointIdentityOptions{Name: ep.Name}
+		live := existing[ep.Name]
+		if live == nil {
+			create := &TemporalOperatorNexusEndpointCreateCommand{
+				Parent:                       &TemporalOperatorNexusEndpointCommand{},
+				NexusEndpointIdentityOptions: identity,
+				NexusEndpointConfigOptions:   config,
+			}
+			req, err := create.createEndpointRequest()
+			if err != nil {
+				return fmt.Errorf("invalid nexus endpoint %q: %w", ep.Name, err)
+			}
+			p.plan.Create = append(p.plan.Create, resource)
+			p.addStep("Created "+resource, func() error {
+				if _, err := p.cl.OperatorService().CreateNexusEndpoint(p.cctx, req); err != nil {
+					return fmt.Errorf("unable to create endpoint %q: %w", ep.Name, err)
+				}
+				return nil
+			})
+			continue
+		}
+
+		liveDescription, err := nexusEndpointDescription(live)
+		if err != nil {
+			return err
+		}
+		target := live.GetSpec().GetTarget()
+		changes := diffManifestFields(
+			manifestField{"description", liveDescription, ep.Description},
+			manifestField{"targetNamespace", target.GetWorker().GetNamespace(), targetNamespace},
+			manifestField{"targetTaskQueue", target.GetWorker().GetTaskQueue(), ep.TargetTaskQueue},
+			manifestField{"targetUrl", target.GetExternal().GetUrl(), ep.TargetUrl},
+		)
+		if len(changes) == 0 {
+			p.plan.Unchanged = append(p.plan.Unchanged, resource)
+			continue
+		}
+		update := &TemporalOperatorNexusEndpointUpdateCommand{
+			Parent:                       &TemporalOperatorNexusEndpointCommand{},
+			NexusEndpointIdentityOptions: identity,
+			NexusEndpointConfigOptions:   config,
+			UnsetDescription:             ep.Description == "",
+		}
+		req, err := update.updateEndpointRequest(live)
+		if err != nil {
+			return fmt.Errorf("invalid nexus endpoint %q: %w", ep.Name, err)
+		}
+		p.plan.Update = append(p.plan.Update, &operatorApplyUpdate{Resource: resource, Changes: changes})
+		p.addStep("Updated "+resource, func() error {
+			if _, err := p.cl.OperatorService().UpdateNexusEndpoint(p.cctx, req); err != nil {
+				return fmt.Errorf("unable to update endpoint %q: %w", ep.Name, err)
+			}
+			return nil
+		})
+	}
+	return nil
+}
+
+func (p *operatorApplyPlanner) planTaskQueue(tq *operatorManifestTaskQueue) error {
+	resource := "task-queue/" + tq.Type + "/" + tq.Name
+	taskQueueType, err := parseTaskQueueType(tq.Type)
+	if err != nil {
+		return err
+	}
+	var live operatorManifestTaskQueue
+	if !p.createNamespace {
+		config, err := describeTaskQueueConfig(p.cctx, p.cl, p.clientOptions.Namespace, tq.Name, taskQueueType)
+		if err != nil {
+			return err
+		}
+		live = taskQueueConfigToManifest(config)
+	}
+	weights := tq.FairnessKeyWeights
+	if len(weights) == 0 {
+		weights = nil
+	}
+	changes := diffManifestFields(
+		manifestField{"queueRpsLimit", live.QueueRpsLimit, tq.QueueRpsLimit},
+		manifestField{"fairnessKeyRpsLimitDefault", live.FairnessKeyRpsLimitDefault, tq.FairnessKeyRpsLimitDefault},
+		manifestField{"fairnessKeyWeights", live.FairnessKeyWeights, weights},
+	)
+	if len(changes) == 0 {
+		p.plan.Unchanged = append(p.plan.Unchanged, resource)
+		return nil
+	}
+
+	// Set only the flags for what changed, same as a user running config set
+	set := NewTemporalTaskQueueConfigSetCommand(p.cctx, &TemporalTaskQueueConfigCommand{
+		Parent: &TemporalTaskQueueCommand{ClientOptions: p.clientOptions},
+	})
+	flags := map[string][]string{"task-queue": {tq.Name}, "task-queue-type": {tq.Type}}
+	for _, change := range changes {
+		switch change.Field {
+		case "queueRpsLimit":
+			flags["queue-rps-limit"] = []string{formatManifestRateLimit(tq.QueueRpsLimit)}
+		case "fairnessKeyRpsLimitDefault":
+			flags["fairness-key-rps-limit-default"] = []string{formatManifestRateLimit(tq.FairnessKeyRpsLimitDefault)}
+		case "fairnessKeyWeights":
+			for _, k := range sortedKeys(weights) {
+				if liveWeight, ok := live.FairnessKeyWeights[k]; !ok || liveWeight != weights[k] {
+					flags["fairness-key-weight"] = append(flags["fairness-key-weight"], fmt.Sprintf("%v=%v", k, weights[k]))
+				}
+			}
+			for _, k := range sortedKeys(live.FairnessKeyWeights) {
+				if _, ok := weights[k]; !ok {
+					flags["fairness-key-weight"] = append(flags["fairness-key-weight"], k+"=default")
+				}
+			}
+		}
+	}
+	for name, values := range flags {
+		for _, value := range values {
+			if err := set.Command.Flags().Set(name, value); err != nil {
+				return fmt.Errorf("invalid task queue %q: %w", tq.Name, err)
+			}
+		}
+	}
+	req, err := set.updateConfigRequest(p.cctx)
+	if err != nil {
+		return fmt.Errorf("invalid task queue %q: %w", tq.Name, err)
+	}
+	p.plan.Update = append(p.plan.Update, &operatorApplyUpdate{Resource: resource, Changes: changes})
+	p.addStep("Updated "+resource, func() error {
+		if _, err := p.cl.WorkflowService().UpdateTaskQueueConfig(p.cctx, req); err != nil {
+			return fmt.Errorf("failed to update task queue config for %s/%s: %w", req.Namespace, req.TaskQueue, err)
+		}
+		return nil
+	})
+	return nil
+}
+
+func printOperatorApplyPlan(cctx *CommandContext, plan *operatorApplyPlan) {
+	if cctx.JSONOutput {
+		_ = cctx.Printer.PrintStructured(plan, printer.StructuredOptions{})
+		return
+	}
+	for _, resource := range plan.Create {
+		cctx.Printer.Printlnf("+ create %v", resource)
+	}
+	for _, update := range plan.Update {
+		cctx.Printer.Printlnf("~ update %v", update.Resource)
+		printManifestChanges(cctx, update.Changes)
+	}
+	cctx.Printer.Printlnf("Plan: %v to create, %v to update, %v unchanged",
+		len(plan.Create), len(plan.Update), len(plan.Unchanged))
+}
+
+func (c *TemporalOperatorExportCommand) run(cctx *CommandContext, args []string) error {
+	cl, err := dialClient(cctx, &c.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+	nsName := c.Parent.Namespace
+
+	resp, err := cl.WorkflowService().DescribeNamespace(cctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe namespace %s: %w", nsName, err)
+	}
+	manifest := operatorManifest{
+		Namespace: operatorManifestNamespace{
+			Name:        nsName,
+			Description: resp.NamespaceInfo.GetDescription(),
+			OwnerEmail:  resp.NamespaceInfo.GetOwnerEmail(),
+			Retention:   canonicalManifestDuration(resp.Config.GetWorkflowExecutionRetentionTtl().AsDuration().String()),
+			Data:        resp.NamespaceInfo.GetData(),
+		},
+	}
+
+	saResp, err := cl.OperatorService().ListSearchAttributes(cctx, &operatorservice.ListSearchAttributesRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list search attributes: %w", err)
+	}
+	for name, saType := range saResp.CustomAttributes {
+		if manifest.SearchAttributes == nil {
+			manifest.SearchAttributes = map[string]string{}
+		}
+		manifest.SearchAttributes[name] = saType.String()
+	}
+
+	endpoints, err := listNexusEndpoints(cctx, cl)
+	if err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(endpoints) {
+		ep := endpoints[name]
+		// Only endpoints handled in this namespace belong to it
+		if ep.GetSpec().GetTarget().GetWorker().GetNamespace() != nsName {
+			continue
+		}
+		description, err := nexusEndpointDescription(ep)
+		if err != nil {
+			return err
+		}
+		manifest.NexusEndpoints = append(manifest.NexusEndpoints, &operatorManifestNexusEndpoint{
+			Name:            name,
+			Description:     description,
+			TargetTaskQueue: ep.GetSpec().GetTarget().GetWorker().GetTaskQueue(),
+		})
+	}
+
+	for _, name := range c.TaskQueue {
+		for _, tqType := range []string{"workflow", "activity", "nexus"} {
+			taskQueueType, _ := parseTaskQueueType(tqType)
+			config, err := describeTaskQueueConfig(cctx, cl, nsName, name, taskQueueType)
+			if err != nil {
+				return err
+			}
+			tq := taskQueueConfigToManifest(config)
+			if tq.QueueRpsLimit != nil || tq.FairnessKeyRpsLimitDefault != nil || tq.FairnessKeyWeights != nil {
+				tq.Name, tq.Type = name, tqType
+				manifest.TaskQueues = append(manifest.TaskQueues, &tq)
+			}
+		}
+	}
+
+	if toFile, err := writeManifest(cctx, manifest, c.OutputFile); err != nil {
+		return err
+	} else if toFile {
+		cctx.Printer.Printlnf("Exported namespace %v to %v", nsName, c.OutputFile)
+	}
+	return nil
+}
+
+func readOperatorManifest(file string) (*operatorManifest, error) {
+	var manifest operatorManifest
+	if err := readManifest(file, &manifest); err != nil {
+		return nil, err
+	}
+	seenEndpoints := map[string]bool{}
+	for i, ep := range manifest.NexusEndpoints {
+		if ep == nil || ep.Name == "" {
+			return nil, fmt.Errorf("nexus endpoint at index %v missing name", i)
+		} else if seenEndpoints[ep.Name] {
+			return nil, fmt.Errorf("nexus endpoint %q defined more than once", ep.Name)
+		}
+		seenEndpoints[ep.Name] = true
+	}
+	seenTaskQueues := map[string]bool{}
+	for i, tq := range manifest.TaskQueues {
+		if tq == nil || tq.Name == "" {
+			return nil, fmt.Errorf("task queue at index %v missing name", i)
+		}
+		if tq.Type == "" {
+			tq.Type = "workflow"
+		}
+		if _, err := parseTaskQueueType(tq.Type); err != nil {
+			return nil, fmt.Errorf("invalid task queue %q: %w", tq.Name, err)
+		} else if seenTaskQueues[tq.Type+"/"+tq.Name] {
+			return nil, fmt.Errorf("task queue %q of type %v defined more than once", tq.Name, tq.Type)
+		}
+		seenTaskQueues[tq.Type+"/"+tq.Name] = true
+	}
+	return &manifest, nil
+}
+
+func listNexusEndpoints(cctx *CommandContext, cl client.Client) (map[string]*nexuspb.Endpoint, error) {
+	endpoints := map[string]*nexuspb.Endpoint{}
+	var token []byte
+	for {
+		resp, err := cl.OperatorService().ListNexusEndpoints(cctx, &operatorservice.ListNexusEndpointsRequest{
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to list endpoints: %w", err)
+		}
+		for _, ep := range resp.Endpoints {
+			endpoints[ep.GetSpec().GetName()] = ep
+		}
+		if token = resp.NextPageToken; len(token) == 0 {
+			return endpoints, nil
+		}
+	}
+}
+
+func describeTaskQueueConfig(
+	cctx *CommandContext,
+	cl client.Client,
+	namespace string,
+	taskQueue string,
+	taskQueueType enums.TaskQueueType,
+) (*taskqueue.TaskQueueConfig, error) {
+	resp, err := cl.WorkflowService().DescribeTaskQueue(cctx, &workflowservice.DescribeTaskQueueRequest{
+		Namespace: namespace,
+		TaskQueue: &taskqueue.TaskQueue{
+			Name: taskQueue,
+			Kind: enums.TASK_QUEUE_KIND_NORMAL,
+		},
+		TaskQueueType: taskQueueType,
+		ReportConfig:  true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting task queue config: %w", err)
+	}
+	return resp.Config, nil
+}
+
+func taskQueueConfigToManifest(config *taskqueue.TaskQueueConfig) operatorManifestTaskQueue {
+	var tq operatorManifestTaskQueue
+	if rl := config.GetQueueRateLimit().GetRateLimit(); rl != nil {
+		tq.QueueRpsLimit = &rl.RequestsPerSecond
+	}
+	if rl := config.GetFairnessKeysRateLimitDefault().GetRateLimit(); rl != nil {
+		tq.FairnessKeyRpsLimitDefault = &rl.RequestsPerSecond
+	}
+	if len(config.GetFairnessWeightOverrides()) > 0 {
+		tq.FairnessKeyWeights = config.GetFairnessWeightOverrides()
+	}
+	return tq
+}
+
+func formatManifestRateLimit(rps *float32) string {
+	if rps == nil {
+		return "default"
+	}
+	return fmt.Sprint(*rps)
+}
+
+func manifestKeyValuePairs(m map[string]string) []string {
+	var pairs []string
+	for _, k := range sortedKeys(m) {
+		pairs = append(pairs, k+"="+m[k])
+	}
+	return pairs
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
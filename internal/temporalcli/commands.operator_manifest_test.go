@@ -0,0 +1,212 @@
+package temporalcli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+func (s *SharedServerSuite) TestOperator_ApplyExport() {
+	suffix := fmt.Sprintf("%x", rand.Uint32())
+	ns := "operator-apply-" + suffix
+	endpoint := "operator-apply-" + suffix
+	taskQueue := "operator-apply-" + suffix
+	defer func() {
+		resp, err := s.Client.OperatorService().ListNexusEndpoints(s.Context, &operatorservice.ListNexusEndpointsRequest{Name: endpoint})
+		if err == nil && len(resp.Endpoints) == 1 {
+			_, _ = s.Client.OperatorService().DeleteNexusEndpoint(s.Context, &operatorservice.DeleteNexusEndpointRequest{
+				Id:      resp.Endpoints[0].Id,
+				Version: resp.Endpoints[0].Version,
+			})
+		}
+	}()
+	dir := s.T().TempDir()
+	writeManifest := func(retention, weights string) string {
+		file := filepath.Join(dir, "namespace.yaml")
+		s.NoError(os.WriteFile(file, []byte(fmt.Sprintf(`
+namespace:
+  name: %[1]v
+  description: Orders
+  retention: %[4]v
+  data:
+    team: payments
+searchAttributes:
+  OperatorApplyKeyword: Keyword
+  OperatorApplyInt: Int
+nexusEndpoints:
+  - name: %[2]v
+    description: Order operations
+    targetTaskQueue: %[3]v
+taskQueues:
+  - name: %[3]v
+    type: activity
+    queueRpsLimit: 100
+    fairnessKeyWeights: %[5]v
+`, ns, endpoint, taskQueue, retention, weights)), 0644))
+		return file
+	}
+	file := writeManifest("7d", "{high: 2, low: 0.5}")
+
+	// Dry run changes nothing
+	res := s.Execute(
+		"operator", "apply",
+		"--address", s.Address(),
+		"-f", file,
+		"--dry-run",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "+ create namespace/"+ns)
+	s.Contains(res.Stdout.String(), "+ create search-attribute/OperatorApplyKeyword")
+	s.Contains(res.Stdout.String(), "+ create nexus-endpoint/"+endpoint)
+	s.Contains(res.Stdout.String(), "~ update task-queue/activity/"+taskQueue)
+	s.Contains(res.Stdout.String(), "Plan: 4 to create, 1 to update, 0 unchanged")
+	_, err := s.Client.WorkflowService().DescribeNamespace(s.Context, &workflowservice.DescribeNamespaceRequest{Namespace: ns})
+	s.Error(err)
+
+	// Create
+	res = s.Execute(
+		"operator", "apply",
+		"--address", s.Address(),
+		"-f", file,
+		"-y",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "Created namespace/"+ns)
+	s.Contains(res.Stdout.String(), "Added 2 search attribute(s)")
+	s.Contains(res.Stdout.String(), "Created nexus-endpoint/"+endpoint)
+	s.Contains(res.Stdout.String(), "Updated task-queue/activity/"+taskQueue)
+	nsResp, err := s.Client.WorkflowService().DescribeNamespace(s.Context, &workflowservice.DescribeNamespaceRequest{Namespace: ns})
+	s.NoError(err)
+	s.Equal("Orders", nsResp.NamespaceInfo.Description)
+	s.Equal(7*24*time.Hour, nsResp.Config.WorkflowExecutionRetentionTtl.AsDuration())
+	s.Equal("payments", nsResp.NamespaceInfo.Data["team"])
+	tqResp, err := s.Client.WorkflowService().DescribeTaskQueue(s.Context, &workflowservice.DescribeTaskQueueRequest{
+		Namespace:     ns,
+		TaskQueue:     &taskqueue.TaskQueue{Name: taskQueue, Kind: enums.TASK_QUEUE_KIND_NORMAL},
+		TaskQueueType: enums.TASK_QUEUE_TYPE_ACTIVITY,
+		ReportConfig:  true,
+	})
+	s.NoError(err)
+	s.Equal(float32(100), tqResp.Config.QueueRateLimit.RateLimit.RequestsPerSecond)
+	s.Equal(map[string]float32{"high": 2, "low": 0.5}, tqResp.Config.FairnessWeightOverrides)
+
+	// Same file again is unchanged
+	res = s.Execute(
+		"operator", "apply",
+		"--address", s.Address(),
+		"-f", file,
+		"-y",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "Plan: 0 to create, 0 to update, 5 unchanged")
+
+	// Changes only update what differs, and removed weights are unset
+	file = writeManifest("168h", "{high: 3}")
+	res = s.Execute(
+		"operator", "apply",
+		"--address", s.Address(),
+		"-f", file,
+		"-y",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "~ update task-queue/activity/"+taskQueue)
+	s.Contains(res.Stdout.String(), `fairnessKeyWeights: {"high":2,"low":0.5} => {"high":3}`)
+	s.Contains(res.Stdout.String(), "Plan: 0 to create, 1 to update, 4 unchanged")
+	tqResp, err = s.Client.WorkflowService().DescribeTaskQueue(s.Context, &workflowservice.DescribeTaskQueueRequest{
+		Namespace:     ns,
+		TaskQueue:     &taskqueue.TaskQueue{Name: taskQueue, Kind: enums.TASK_QUEUE_KIND_NORMAL},
+		TaskQueueType: enums.TASK_QUEUE_TYPE_ACTIVITY,
+		ReportConfig:  true,
+	})
+	s.NoError(err)
+	s.Equal(map[string]float32{"high": 3}, tqResp.Config.FairnessWeightOverrides)
+
+	// Export and apply the export unchanged
+	exported := filepath.Join(dir, "exported.yaml")
+	res = s.Execute(
+		"operator", "export",
+		"--address", s.Address(),
+		"-n", ns,
+		"-t", taskQueue,
+		"-f", exported,
+	)
+	s.NoError(res.Err)
+	b, err := os.ReadFile(exported)
+	s.NoError(err)
+	s.Contains(string(b), "name: "+ns)
+	s.Contains(string(b), "retention: 168h")
+	s.Contains(string(b), "OperatorApplyKeyword: Keyword")
+	s.Contains(string(b), "targetTaskQueue: "+taskQueue)
+	s.Contains(string(b), "queueRpsLimit: 100")
+	res = s.Execute(
+		"operator", "apply",
+		"--address", s.Address(),
+		"-f", exported,
+		"--dry-run",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "Plan: 0 to create, 0 to update, ")
+
+	// JSON export
+	res = s.Execute(
+		"operator", "export",
+		"--address", s.Address(),
+		"-n", ns,
+		"-o", "json",
+	)
+	s.NoError(res.Err)
+	var manifest struct {
+		Namespace struct {
+			Name string            `json:"name"`
+			Data map[string]string `json:"data"`
+		} `json:"namespace"`
+		NexusEndpoints []struct {
+			Name        string `json:"name"`
+			Description string `json:"description"`
+		} `json:"nexusEndpoints"`
+	}
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &manifest))
+	s.Equal(ns, manifest.Namespace.Name)
+	s.Equal("payments", manifest.Namespace.Data["team"])
+	s.Len(manifest.NexusEndpoints, 1)
+	s.Equal("Order operations", manifest.NexusEndpoints[0].Description)
+
+	// YAML output is the same as the default
+	res = s.Execute(
+		"operator", "export",
+		"--address", s.Address(),
+		"-n", ns,
+		"-o", "yaml",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "namespace:\n  name: "+ns)
+}
+
+func (s *SharedServerSuite) TestOperator_Apply_Invalid() {
+	file := filepath.Join(s.T().TempDir(), "namespace.yaml")
+	for content, expectedErr := range map[string]string{
+		"unknown: true\n": "field unknown not found",
+		"nexusEndpoints:\n  - targetUrl: http://localhost\n":                              "missing name",
+		"taskQueues:\n  - name: tq\n    type: other\n":                                    "invalid task queue type",
+		"taskQueues:\n  - name: tq\n    queueRpsLimit: 5\n":                               "not allowed",
+		"searchAttributes:\n  OperatorApplyInvalid: NotAType\n":                           "unsupported search attribute type",
+		"nexusEndpoints:\n  - name: a\n    targetUrl: x\n  - name: a\n    targetUrl: x\n": "defined more than once",
+	} {
+		s.NoError(os.WriteFile(file, []byte(content), 0644))
+		res := s.Execute(
+			"operator", "apply",
+			"--address", s.Address(),
+			"-f", file,
+			"--dry-run",
+		)
+		s.ErrorContains(res.Err, expectedErr)
+	}
+}
@@ -37,6 +37,21 @@ func (c *TemporalOperatorNamespaceCreateCommand) run(cctx *CommandContext, args
 	}
 	defer cl.Close()
 
+	req, err := c.registerNamespaceRequest(nsName)
+	if err != nil {
+		return err
+	}
+	_, err = cl.WorkflowService().RegisterNamespace(cctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to create namespace %s: %w", nsName, err)
+	}
+	cctx.Printer.Println(color.GreenString("Namespace %s successfully registered.", nsName))
+	return nil
+}
+
+func (c *TemporalOperatorNamespaceCreateCommand) registerNamespaceRequest(
+	nsName string,
+) (*workflowservice.RegisterNamespaceRequest, error) {
 	var clusters []*replication.ClusterReplicationConfig
 	for _, clusterName := range c.Cluster {
 		clusters = append(clusters, &replication.ClusterReplicationConfig{
@@ -46,13 +61,14 @@ func (c *TemporalOperatorNamespaceCreateCommand) run(cctx *CommandContext, args
 
 	var data map[string]string
 	if len(c.Data) > 0 {
+		var err error
 		data, err = stringKeysValues(c.Data)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	_, err = cl.WorkflowService().RegisterNamespace(cctx, &workflowservice.RegisterNamespaceRequest{
+	return &workflowservice.RegisterNamespaceRequest{
 		Namespace:                        nsName,
 		Description:                      c.Description,
 		OwnerEmail:                       c.Email,
@@ -65,12 +81,7 @@ func (c *TemporalOperatorNamespaceCreateCommand) run(cctx *CommandContext, args
 		HistoryArchivalUri:               c.HistoryUri,
 		VisibilityArchivalState:          archivalState(c.VisibilityArchivalState.Value),
 		VisibilityArchivalUri:            c.VisibilityUri,
-	})
-	if err != nil {
-		return fmt.Errorf("unable to create namespace %s: %w", nsName, err)
-	}
-	cctx.Printer.Println(color.GreenString("Namespace %s successfully registered.", nsName))
-	return nil
+	}, nil
 }
 
 func (c *TemporalOperatorNamespaceDeleteCommand) run(cctx *CommandContext, args []string) error {
@@ -233,61 +244,8 @@ func (c *TemporalOperatorNamespaceUpdateCommand) run(cctx *CommandContext, args
 		if err != nil {
 			return fmt.Errorf("namespace update failed: %w", err)
 		}
-
-		description := resp.NamespaceInfo.GetDescription()
-		ownerEmail := resp.NamespaceInfo.GetOwnerEmail()
-		retention := resp.Config.GetWorkflowExecutionRetentionTtl()
-
-		if len(c.Description) > 0 {
-			description = c.Description
-		}
-		if len(c.Email) > 0 {
-			ownerEmail = c.Email
-		}
-
-		data := map[string]string{}
-		if len(c.Data) > 0 {
-			data, err = stringKeysValues(c.Data)
-			if err != nil {
-				return err
-			}
-		}
-
-		if c.Retention > 0 {
-			retention = durationpb.New(c.Retention.Duration())
-		}
-
-		var clusters []*replication.ClusterReplicationConfig
-		if len(c.Cluster) > 0 {
-			for _, clusterName := range c.Cluster {
-				clusters = append(clusters, &replication.ClusterReplicationConfig{
-					ClusterName: clusterName,
-				})
-			}
-		}
-
-		updateInfo := &namespace.UpdateNamespaceInfo{
-			Description: description,
-			OwnerEmail:  ownerEmail,
-			Data:        data,
-		}
-
-		updateConfig := &namespace.NamespaceConfig{
-			WorkflowExecutionRetentionTtl: retention,
-			HistoryArchivalState:          archivalState(c.HistoryArchivalState.String()),
-			HistoryArchivalUri:            c.HistoryUri,
-			VisibilityArchivalState:       archivalState(c.VisibilityArchivalState.String()),
-			VisibilityArchivalUri:         c.VisibilityUri,
-		}
-		replicationConfig := &replication.NamespaceReplicationConfig{
-			Clusters: clusters,
-			State:    replicationState(c.ReplicationState.String()),
-		}
-		updateRequest = &workflowservice.UpdateNamespaceRequest{
-			Namespace:         nsName,
-			UpdateInfo:        updateInfo,
-			Config:            updateConfig,
-			ReplicationConfig: replicationConfig,
+		if updateRequest, err = c.updateNamespaceRequest(nsName, resp); err != nil {
+			return err
 		}
 	}
 
@@ -304,6 +262,70 @@ func (c *TemporalOperatorNamespaceUpdateCommand) run(cctx *CommandContext, args
 	return nil
 }
 
+// updateNamespaceRequest builds an update of the given namespace, keeping
+// existing values for unset flags.
+func (c *TemporalOperatorNamespaceUpdateCommand) updateNamespaceRequest(
+	nsName string,
+	resp *workflowservice.DescribeNamespaceResponse,
+) (*workflowservice.UpdateNamespaceRequest, error) {
+	description := resp.NamespaceInfo.GetDescription()
+	ownerEmail := resp.NamespaceInfo.GetOwnerEmail()
+	retention := resp.Config.GetWorkflowExecutionRetentionTtl()
+
+	if len(c.Description) > 0 {
+		description = c.Description
+	}
+	if len(c.Email) > 0 {
+		ownerEmail = c.Email
+	}
+
+	data := map[string]string{}
+	if len(c.Data) > 0 {
+		var err error
+		data, err = stringKeysValues(c.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Retention > 0 {
+		retention = durationpb.New(c.Retention.Duration())
+	}
+
+	var clusters []*replication.ClusterReplicationConfig
+	if len(c.Cluster) > 0 {
+		for _, clusterName := range c.Cluster {
+			clusters = append(clusters, &replication.ClusterReplicationConfig{
+				ClusterName: clusterName,
+			})
+		}
+	}
+
+	updateInfo := &namespace.UpdateNamespaceInfo{
+		Description: description,
+		OwnerEmail:  ownerEmail,
+		Data:        data,
+	}
+
+	updateConfig := &namespace.NamespaceConfig{
+		WorkflowExecutionRetentionTtl: retention,
+		HistoryArchivalState:          archivalState(c.HistoryArchivalState.String()),
+		HistoryArchivalUri:            c.HistoryUri,
+		VisibilityArchivalState:       archivalState(c.VisibilityArchivalState.String()),
+		VisibilityArchivalUri:         c.VisibilityUri,
+	}
+	replicationConfig := &replication.NamespaceReplicationConfig{
+		Clusters: clusters,
+		State:    replicationState(c.ReplicationState.String()),
+	}
+	return &workflowservice.UpdateNamespaceRequest{
+		Namespace:         nsName,
+		UpdateInfo:        updateInfo,
+		Config:            updateConfig,
+		ReplicationConfig: replicationConfig,
+	}, nil
+}
+
 func printNamespaceDescriptions(cctx *CommandContext, responses ...*workflowservice.DescribeNamespaceResponse) error {
 	namespaces := make([]map[string]any, len(responses))
 	for i, resp := range responses {
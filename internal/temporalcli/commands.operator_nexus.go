@@ -15,17 +15,10 @@ import (
 )
 
 func (c *TemporalOperatorNexusEndpointCreateCommand) run(cctx *CommandContext, _ []string) error {
-	description, err := c.Parent.descriptionToPayload(c.Description, c.DescriptionFile)
-	if err != nil {
-		return err
-	}
-	target, err := c.Parent.endpointTargetFromArgs(c.TargetNamespace, c.TargetTaskQueue, c.TargetUrl)
+	req, err := c.createEndpointRequest()
 	if err != nil {
 		return err
 	}
-	if target == nil {
-		return fmt.Errorf("either --target-namespace and --target-task-queue or --target-url are required")
-	}
 
 	cl, err := dialClient(cctx, &c.Parent.Parent.Parent.ClientOptions)
 	if err != nil {
@@ -33,13 +26,7 @@ func (c *TemporalOperatorNexusEndpointCreateCommand) run(cctx *CommandContext, _
 	}
 	defer cl.Close()
 
-	_, err = cl.OperatorService().CreateNexusEndpoint(cctx, &operatorservice.CreateNexusEndpointRequest{
-		Spec: &nexuspb.EndpointSpec{
-			Name:        c.Name,
-			Description: description,
-			Target:      target,
-		},
-	})
+	_, err = cl.OperatorService().CreateNexusEndpoint(cctx, req)
 	if err != nil {
 		return fmt.Errorf("unable to create endpoint %q: %w", c.Name, err)
 	}
@@ -47,6 +34,27 @@ func (c *TemporalOperatorNexusEndpointCreateCommand) run(cctx *CommandContext, _
 	return nil
 }
 
+func (c *TemporalOperatorNexusEndpointCreateCommand) createEndpointRequest() (*operatorservice.CreateNexusEndpointRequest, error) {
+	description, err := c.Parent.descriptionToPayload(c.Description, c.DescriptionFile)
+	if err != nil {
+		return nil, err
+	}
+	target, err := c.Parent.endpointTargetFromArgs(c.TargetNamespace, c.TargetTaskQueue, c.TargetUrl)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, fmt.Errorf("either --target-namespace and --target-task-queue or --target-url are required")
+	}
+	return &operatorservice.CreateNexusEndpointRequest{
+		Spec: &nexuspb.EndpointSpec{
+			Name:        c.Name,
+			Description: description,
+			Target:      target,
+		},
+	}, nil
+}
+
 func (c *TemporalOperatorNexusEndpointDeleteCommand) run(cctx *CommandContext, _ []string) error {
 	cl, err := dialClient(cctx, &c.Parent.Parent.Parent.ClientOptions)
 	if err != nil {
@@ -123,30 +131,60 @@ func (c *TemporalOperatorNexusEndpointListCommand) run(cctx *CommandContext, _ [
 }
 
 func (c *TemporalOperatorNexusEndpointUpdateCommand) run(cctx *CommandContext, _ []string) error {
-	description, err := c.Parent.descriptionToPayload(c.Description, c.DescriptionFile)
+	if err := c.validateFlags(); err != nil {
+		return err
+	}
+
+	cl, err := dialClient(cctx, &c.Parent.Parent.Parent.ClientOptions)
 	if err != nil {
 		return err
 	}
-	if description != nil && c.UnsetDescription {
-		return fmt.Errorf("--unset-description should not be set if --description or --description-file is set")
+	defer cl.Close()
+
+	endpoint, err := c.Parent.getEndpointByName(cctx, cl, c.Name)
+	if err != nil {
+		return err
 	}
 
+	req, err := c.updateEndpointRequest(endpoint)
+	if err != nil {
+		return err
+	}
+	_, err = cl.OperatorService().UpdateNexusEndpoint(cctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to update endpoint %q: %w", c.Name, err)
+	}
+	cctx.Printer.Println(color.GreenString("Endpoint %s successfully updated.", c.Name))
+	return nil
+}
+
+func (c *TemporalOperatorNexusEndpointUpdateCommand) validateFlags() error {
+	if c.Description != "" && c.DescriptionFile != "" {
+		return fmt.Errorf("provided both --description and --description-file")
+	}
+	if (c.Description != "" || c.DescriptionFile != "") && c.UnsetDescription {
+		return fmt.Errorf("--unset-description should not be set if --description or --description-file is set")
+	}
 	if c.TargetNamespace != "" && c.TargetUrl != "" {
 		return fmt.Errorf("provided both --target-namespace and --target-url")
 	}
 	if c.TargetTaskQueue != "" && c.TargetUrl != "" {
 		return fmt.Errorf("provided both --target-task-queue and --target-url")
 	}
+	return nil
+}
 
-	cl, err := dialClient(cctx, &c.Parent.Parent.Parent.ClientOptions)
-	if err != nil {
-		return err
+// updateEndpointRequest builds an update of the given endpoint, keeping
+// existing values for unset flags.
+func (c *TemporalOperatorNexusEndpointUpdateCommand) updateEndpointRequest(
+	endpoint *nexuspb.Endpoint,
+) (*operatorservice.UpdateNexusEndpointRequest, error) {
+	if err := c.validateFlags(); err != nil {
+		return nil, err
 	}
-	defer cl.Close()
-
-	endpoint, err := c.Parent.getEndpointByName(cctx, cl, c.Name)
+	description, err := c.Parent.descriptionToPayload(c.Description, c.DescriptionFile)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	existingDescription := endpoint.GetSpec().GetDescription()
@@ -157,7 +195,7 @@ func (c *TemporalOperatorNexusEndpointUpdateCommand) run(cctx *CommandContext, _
 	target := endpoint.GetSpec().GetTarget()
 	if endpoint.GetSpec().GetTarget().GetExternal() != nil &&
 		(c.TargetNamespace == "" && c.TargetTaskQueue != "" || c.TargetNamespace != "" && c.TargetTaskQueue == "") {
-		return fmt.Errorf("both --target-namespace and --target-task-queue are required when changing target type from external to worker")
+		return nil, fmt.Errorf("both --target-namespace and --target-task-queue are required when changing target type from external to worker")
 	}
 	if c.TargetUrl != "" {
 		target = &nexuspb.EndpointTarget{
@@ -181,7 +219,7 @@ func (c *TemporalOperatorNexusEndpointUpdateCommand) run(cctx *CommandContext, _
 		}
 	}
 
-	_, err = cl.OperatorService().UpdateNexusEndpoint(cctx, &operatorservice.UpdateNexusEndpointRequest{
+	return &operatorservice.UpdateNexusEndpointRequest{
 		Id:      endpoint.Id,
 		Version: endpoint.Version,
 		Spec: &nexuspb.EndpointSpec{
@@ -189,12 +227,7 @@ func (c *TemporalOperatorNexusEndpointUpdateCommand) run(cctx *CommandContext, _
 			Description: description,
 			Target:      target,
 		},
-	})
-	if err != nil {
-		return fmt.Errorf("unable to update endpoint %q: %w", c.Name, err)
-	}
-	cctx.Printer.Println(color.GreenString("Endpoint %s successfully updated.", c.Name))
-	return nil
+	}, nil
 }
 
 func (c *TemporalOperatorNexusEndpointCommand) descriptionToPayload(description, descriptionFile string) (*commonpb.Payload, error) {
@@ -272,14 +305,22 @@ func (c *TemporalOperatorNexusEndpointCommand) getEndpointByName(ctx context.Con
 	return resp.Endpoints[0], nil
 }
 
+func nexusEndpointDescription(ep *nexuspb.Endpoint) (string, error) {
+	var description string
+	if desc := ep.GetSpec().GetDescription(); desc != nil && string(desc.Metadata["encoding"]) == "json/plain" {
+		if err := json.Unmarshal(desc.Data, &description); err != nil {
+			return "", fmt.Errorf("malformed description for endpoint: %q, expected a string encoded as JSON", ep.GetSpec().GetName())
+		}
+	}
+	return description, nil
+}
+
 func printNexusEndpoints(cctx *CommandContext, endpoints ...*nexuspb.Endpoint) error {
 	mapped := make([]map[string]any, len(endpoints))
 	for i, ep := range endpoints {
-		var description string
-		if desc := ep.GetSpec().GetDescription(); desc != nil && string(desc.Metadata["encoding"]) == "json/plain" {
-			if err := json.Unmarshal(desc.Data, &description); err != nil {
-				return fmt.Errorf("malformed description for endpoint: %q, expected a string encoded as JSON", ep.GetSpec().GetName())
-			}
+		description, err := nexusEndpointDescription(ep)
+		if err != nil {
+			return err
 		}
 		mapped[i] = map[string]any{
 			"ID":                      ep.Id,
@@ -28,31 +28,40 @@ func (c *TemporalOperatorSearchAttributeCreateCommand) run(cctx *CommandContext,
 		return fmt.Errorf("unable to get existing search attributes: %w", err)
 	}
 
+	request, err := c.addSearchAttributesRequest(existingSearchAttributes)
+	if err != nil {
+		return err
+	}
+
+	_, err = cl.OperatorService().AddSearchAttributes(cctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to add search attributes: %w", err)
+	}
+	cctx.Printer.Println(color.GreenString("Search attributes have been added"))
+	return nil
+}
+
+func (c *TemporalOperatorSearchAttributeCreateCommand) addSearchAttributesRequest(
+	existing *operatorservice.ListSearchAttributesResponse,
+) (*operatorservice.AddSearchAttributesRequest, error) {
 	searchAttributes := make(map[string]enums.IndexedValueType, len(c.Type.Values))
 	for i, saType := range c.Type.Values {
 		saName := c.Name[i]
 		typeInt, err := searchAttributeTypeStringToEnum(saType)
 		if err != nil {
-			return fmt.Errorf("unable to parse search attribute type %s: %w", saType, err)
+			return nil, fmt.Errorf("unable to parse search attribute type %s: %w", saType, err)
 		}
-		existingSearchAttributeType, searchAttributeExists := existingSearchAttributes.CustomAttributes[saName]
+		existingSearchAttributeType, searchAttributeExists := existing.CustomAttributes[saName]
 		if searchAttributeExists && existingSearchAttributeType != typeInt {
-			return fmt.Errorf("search attribute %s already exists and has different type %s", saName, existingSearchAttributeType.String())
+			return nil, fmt.Errorf("search attribute %s already exists and has different type %s", saName, existingSearchAttributeType.String())
 		}
 		searchAttributes[saName] = typeInt
 	}
 
-	request := &operatorservice.AddSearchAttributesRequest{
+	return &operatorservice.AddSearchAttributesRequest{
 		SearchAttributes: searchAttributes,
 		Namespace:        c.Parent.Parent.Namespace,
-	}
-
-	_, err = cl.OperatorService().AddSearchAttributes(cctx, request)
-	if err != nil {
-		return fmt.Errorf("unable to add search attributes: %w", err)
-	}
-	cctx.Printer.Println(color.GreenString("Search attributes have been added"))
-	return nil
+	}, nil
 }
 
 func searchAttributeTypeStringToEnum(search string) (enums.IndexedValueType, error) {
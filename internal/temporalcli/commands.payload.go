@@ -0,0 +1,75 @@
+package temporalcli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"go.temporal.io/api/common/v1"
+)
+
+func (c *TemporalPayloadEncodeCommand) run(cctx *CommandContext, args []string) error {
+	codec, err := c.Parent.buildCodec(cctx.Options.EnvLookup)
+	if err != nil {
+		return err
+	}
+	return transformPayloadJSON(cctx, c.InputFile, codec.Encode)
+}
+
+func (c *TemporalPayloadDecodeCommand) run(cctx *CommandContext, args []string) error {
+	codec, err := c.Parent.buildCodec(cctx.Options.EnvLookup)
+	if err != nil {
+		return err
+	}
+	return transformPayloadJSON(cctx, c.InputFile, codec.Decode)
+}
+
+// transformPayloadJSON reads either a single payload or a payloads object from
+// the file (or stdin), transforms it, and writes it back out in the same shape.
+func transformPayloadJSON(
+	cctx *CommandContext,
+	inputFile string,
+	transform func([]*common.Payload) ([]*common.Payload, error),
+) error {
+	var b []byte
+	var err error
+	if inputFile != "" {
+		b, err = os.ReadFile(inputFile)
+	} else {
+		b, err = io.ReadAll(cctx.Options.Stdin)
+	}
+	if err != nil {
+		return fmt.Errorf("failed reading input: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return fmt.Errorf("input is not a JSON object: %w", err)
+	}
+	// Shorthand is disabled since encoded payloads are binary
+	_, multiple := fields["payloads"]
+	var payloads common.Payloads
+	if multiple {
+		err = UnmarshalProtoJSONWithOptions(b, &payloads, false)
+	} else {
+		var payload common.Payload
+		err = UnmarshalProtoJSONWithOptions(b, &payload, false)
+		payloads.Payloads = []*common.Payload{&payload}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid payload JSON: %w", err)
+	}
+	if payloads.Payloads, err = transform(payloads.Payloads); err != nil {
+		return err
+	}
+	if multiple {
+		b, err = cctx.MarshalProtoJSONWithOptions(&payloads, false)
+	} else {
+		b, err = cctx.MarshalProtoJSONWithOptions(payloads.Payloads[0], false)
+	}
+	if err != nil {
+		return fmt.Errorf("failed marshaling payload JSON: %w", err)
+	}
+	_, err = fmt.Fprintln(cctx.Printer.Output, string(b))
+	return err
+}
@@ -0,0 +1,56 @@
+package temporalcli_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testPayloadKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+
+func TestPayload_EncodeDecode(t *testing.T) {
+	h := NewCommandHarness(t)
+	h.Options.EnvLookup = EnvLookupMap{"TEMPORAL_TEST_PAYLOAD_KEY": testPayloadKey}
+	codecArgs := []string{"--codec", "zstd", "--codec", "zlib", "--codec", "aes-gcm", "--key-env", "TEMPORAL_TEST_PAYLOAD_KEY"}
+	plain := `{"metadata":{"encoding":"anNvbi9wbGFpbg=="},"data":"eyJmb28iOiJiYXIifQ=="}`
+
+	// Single payload is encrypted and round trips
+	h.Stdin.WriteString(plain)
+	res := h.Execute(append([]string{"payload", "encode"}, codecArgs...)...)
+	h.NoError(res.Err)
+	var encoded map[string]any
+	h.NoError(json.Unmarshal(res.Stdout.Bytes(), &encoded))
+	h.Equal(map[string]any{
+		"encoding":          base64.StdEncoding.EncodeToString([]byte("binary/encrypted")),
+		"encryption-key-id": base64.StdEncoding.EncodeToString([]byte("default")),
+	}, encoded["metadata"])
+
+	h.Stdin.WriteString(res.Stdout.String())
+	res = h.Execute(append([]string{"payload", "decode"}, codecArgs...)...)
+	h.NoError(res.Err)
+	h.JSONEq(plain, res.Stdout.String())
+
+	// Multiple payloads from a file, decoding with the wrong key ID fails
+	file := filepath.Join(t.TempDir(), "payloads.json")
+	h.NoError(os.WriteFile(file, []byte(`{"payloads":[`+plain+`,`+plain+`]}`), 0644))
+	res = h.Execute(append([]string{"payload", "encode", "--input-file", file}, codecArgs...)...)
+	h.NoError(res.Err)
+	var encodedMultiple struct{ Payloads []any }
+	h.NoError(json.Unmarshal(res.Stdout.Bytes(), &encodedMultiple))
+	h.Len(encodedMultiple.Payloads, 2)
+
+	h.Stdin.WriteString(res.Stdout.String())
+	res = h.Execute(append([]string{"payload", "decode", "--key-id", "other"}, codecArgs...)...)
+	h.ErrorContains(res.Err, `payload encrypted with key ID "default", expected "other"`)
+
+	// Bad keys
+	h.Options.EnvLookup = EnvLookupMap{"TEMPORAL_TEST_PAYLOAD_KEY": base64.StdEncoding.EncodeToString([]byte("too-short"))}
+	h.Stdin.WriteString(plain)
+	res = h.Execute(append([]string{"payload", "encode"}, codecArgs...)...)
+	h.EqualError(res.Err, "key must be 16, 24, or 32 bytes, got 9")
+	h.Stdin.WriteString(plain)
+	res = h.Execute("payload", "encode", "--codec", "aes-gcm")
+	h.EqualError(res.Err, "must set key file or key env for aes-gcm codec")
+}
@@ -287,6 +287,15 @@ func (c *TemporalScheduleCreateCommand) run(cctx *CommandContext, args []string)
 	}
 	defer cl.Close()
 
+	opts, err := c.scheduleOptions()
+	if err != nil {
+		return err
+	}
+	_, err = cl.ScheduleClient().Create(cctx, opts)
+	return err
+}
+
+func (c *TemporalScheduleCreateCommand) scheduleOptions() (client.ScheduleOptions, error) {
 	opts := client.ScheduleOptions{
 		ID:               c.ScheduleId,
 		PauseOnFailure:   c.PauseOnFailure,
@@ -298,20 +307,19 @@ func (c *TemporalScheduleCreateCommand) run(cctx *CommandContext, args []string)
 		// ScheduleBackfill not supported
 	}
 
+	var err error
 	if err = c.toScheduleSpec(&opts.Spec); err != nil {
-		return err
+		return opts, err
 	} else if opts.Action, err = toScheduleAction(&c.SharedWorkflowStartOptions, &c.PayloadInputOptions); err != nil {
-		return err
+		return opts, err
 	} else if opts.Overlap, err = enumspb.ScheduleOverlapPolicyFromString(c.OverlapPolicy.Value); err != nil {
-		return err
+		return opts, err
 	} else if opts.Memo, err = stringKeysJSONValues(c.ScheduleMemo, false); err != nil {
-		return fmt.Errorf("invalid memo values: %w", err)
+		return opts, fmt.Errorf("invalid memo values: %w", err)
 	} else if opts.SearchAttributes, err = stringKeysJSONValues(c.ScheduleSearchAttribute, false); err != nil {
-		return fmt.Errorf("invalid search attribute values: %w", err)
+		return opts, fmt.Errorf("invalid search attribute values: %w", err)
 	}
-
-	_, err = cl.ScheduleClient().Create(cctx, opts)
-	return err
+	return opts, nil
 }
 
 func (c *TemporalScheduleDeleteCommand) run(cctx *CommandContext, args []string) error {
@@ -520,7 +528,23 @@ func (c *TemporalScheduleUpdateCommand) run(cctx *CommandContext, args []string)
 	}
 	defer cl.Close()
 
-	newSchedule := client.Schedule{
+	newSchedule, err := c.schedule()
+	if err != nil {
+		return err
+	}
+	sch := cl.ScheduleClient().GetHandle(cctx, c.ScheduleId)
+	return sch.Update(cctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(u client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			// replace whole schedule
+			return &client.ScheduleUpdate{
+				Schedule: newSchedule,
+			}, nil
+		},
+	})
+}
+
+func (c *TemporalScheduleUpdateCommand) schedule() (*client.Schedule, error) {
+	newSchedule := &client.Schedule{
 		Spec: &client.ScheduleSpec{},
 		Policy: &client.SchedulePolicies{
 			CatchupWindow:  c.CatchupWindow.Duration(),
@@ -532,8 +556,9 @@ func (c *TemporalScheduleUpdateCommand) run(cctx *CommandContext, args []string)
 		},
 	}
 
+	var err error
 	if newSchedule.Policy.Overlap, err = enumspb.ScheduleOverlapPolicyFromString(c.OverlapPolicy.Value); err != nil {
-		return err
+		return nil, err
 	}
 
 	if c.RemainingActions > 0 {
@@ -542,20 +567,11 @@ func (c *TemporalScheduleUpdateCommand) run(cctx *CommandContext, args []string)
 	}
 
 	if err = c.toScheduleSpec(newSchedule.Spec); err != nil {
-		return err
+		return nil, err
 	} else if newSchedule.Action, err = toScheduleAction(&c.SharedWorkflowStartOptions, &c.PayloadInputOptions); err != nil {
-		return err
+		return nil, err
 	}
-
-	sch := cl.ScheduleClient().GetHandle(cctx, c.ScheduleId)
-	return sch.Update(cctx, client.ScheduleUpdateOptions{
-		DoUpdate: func(u client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
-			// replace whole schedule
-			return &client.ScheduleUpdate{
-				Schedule: &newSchedule,
-			}, nil
-		},
-	})
+	return newSchedule, nil
 }
 
 func formatCalendarSpec(spec client.ScheduleCalendarSpec) *schedpb.CalendarSpec {
@@ -0,0 +1,859 @@
+package temporalcli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/temporalio/cli/cliext"
+	"github.com/temporalio/cli/internal/printer"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedpb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+)
+
+// scheduleManifest is the file format read by schedule apply and written by
+// schedule export.
+type scheduleManifest struct {
+	Schedules []*scheduleManifestEntry `yaml:"schedules" json:"schedules"`
+}
+
+type scheduleManifestEntry struct {
+	ID string `yaml:"id" json:"id"`
+	// Spec
+	Cron      []string                    `yaml:"cron,omitempty" json:"cron,omitempty"`
+	Calendar  []*scheduleManifestCalendar `yaml:"calendar,omitempty" json:"calendar,omitempty"`
+	Interval  []string                    `yaml:"interval,omitempty" json:"interval,omitempty"`
+	StartTime string                      `yaml:"startTime,omitempty" json:"startTime,omitempty"`
+	EndTime   string                      `yaml:"endTime,omitempty" json:"endTime,omitempty"`
+	Jitter    string                      `yaml:"jitter,omitempty" json:"jitter,omitempty"`
+	TimeZone  string                      `yaml:"timeZone,omitempty" json:"timeZone,omitempty"`
+	// Action
+	Workflow manifestWorkflow `yaml:"workflow" json:"workflow"`
+	// Policy
+	OverlapPolicy  string `yaml:"overlapPolicy,omitempty" json:"overlapPolicy,omitempty"`
+	CatchupWindow  string `yaml:"catchupWindow,omitempty" json:"catchupWindow,omitempty"`
+	PauseOnFailure bool   `yaml:"pauseOnFailure,omitempty" json:"pauseOnFailure,omitempty"`
+	// State
+	Notes            string `yaml:"notes,omitempty" json:"notes,omitempty"`
+	Paused           bool   `yaml:"paused,omitempty" json:"paused,omitempty"`
+	RemainingActions int    `yaml:"remainingActions,omitempty" json:"remainingActions,omitempty"`
+	// Only set on create
+	Memo             map[string]any `yaml:"memo,omitempty" json:"memo,omitempty"`
+	SearchAttributes map[string]any `yaml:"searchAttributes,omitempty" json:"searchAttributes,omitempty"`
+}
+
+// scheduleManifestCalendar has the same fields as the --calendar JSON.
+type scheduleManifestCalendar struct {
+	Second     string `yaml:"second,omitempty" json:"second,omitempty"`
+	Minute     string `yaml:"minute,omitempty" json:"minute,omitempty"`
+	Hour       string `yaml:"hour,omitempty" json:"hour,omitempty"`
+	DayOfMonth string `yaml:"dayOfMonth,omitempty" json:"dayOfMonth,omitempty"`
+	Month      string `yaml:"month,omitempty" json:"month,omitempty"`
+	Year       string `yaml:"year,omitempty" json:"year,omitempty"`
+	DayOfWeek  string `yaml:"dayOfWeek,omitempty" json:"dayOfWeek,omitempty"`
+	Comment    string `yaml:"comment,omitempty" json:"comment,omitempty"`
+}
+
+// manifestWorkflow is a workflow to start, with the same fields as the workflow
+// start options.
+type manifestWorkflow struct {
+	ID               string         `yaml:"id,omitempty" json:"id,omitempty"`
+	Type             string         `yaml:"type" json:"type"`
+	TaskQueue        string         `yaml:"taskQueue" json:"taskQueue"`
+	ExecutionTimeout string         `yaml:"executionTimeout,omitempty" json:"executionTimeout,omitempty"`
+	RunTimeout       string         `yaml:"runTimeout,omitempty" json:"runTimeout,omitempty"`
+	TaskTimeout      string         `yaml:"taskTimeout,omitempty" json:"taskTimeout,omitempty"`
+	Input            []any          `yaml:"input,omitempty" json:"input,omitempty"`
+	Memo             map[string]any `yaml:"memo,omitempty" json:"memo,omitempty"`
+	SearchAttributes map[string]any `yaml:"searchAttributes,omitempty" json:"searchAttributes,omitempty"`
+	StaticSummary    string         `yaml:"staticSummary,omitempty" json:"staticSummary,omitempty"`
+	StaticDetails    string         `yaml:"staticDetails,omitempty" json:"staticDetails,omitempty"`
+}
+
+type scheduleApplyPlan struct {
+	Create    []string               `json:"create"`
+	Update    []*scheduleApplyUpdate `json:"update"`
+	Delete    []string               `json:"delete"`
+	Unchanged []string               `json:"unchanged"`
+}
+
+type scheduleApplyUpdate struct {
+	ScheduleId string            `json:"scheduleId"`
+	Changes    []*manifestChange `json:"changes"`
+}
+
+func (c *TemporalScheduleApplyCommand) run(cctx *CommandContext, args []string) error {
+	manifest, err := readScheduleManifest(c.File)
+	if err != nil {
+		return err
+	}
+	cl, err := dialClient(cctx, &c.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	// Build everything up front so invalid entries fail before any change
+	var plan scheduleApplyPlan
+	creates := map[string]*TemporalScheduleCreateCommand{}
+	updates := map[string]*TemporalScheduleUpdateCommand{}
+	for _, entry := range manifest.Schedules {
+		create, err := entry.toCreateCommand()
+		if err != nil {
+			return fmt.Errorf("invalid schedule %q: %w", entry.ID, err)
+		}
+		desc, err := cl.ScheduleClient().GetHandle(cctx, entry.ID).Describe(cctx)
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			plan.Create = append(plan.Create, entry.ID)
+			creates[entry.ID] = create
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed describing schedule %q: %w", entry.ID, err)
+		}
+		live, err := printableToManifestEntry(describeResultToPrintable(entry.ID, desc))
+		if err != nil {
+			return fmt.Errorf("failed reading schedule %q: %w", entry.ID, err)
+		}
+		if changes := diffScheduleManifestEntries(live, entry.canonical()); len(changes) > 0 {
+			plan.Update = append(plan.Update, &scheduleApplyUpdate{ScheduleId: entry.ID, Changes: changes})
+			updates[entry.ID] = create.toUpdateCommand()
+		} else {
+			plan.Unchanged = append(plan.Unchanged, entry.ID)
+		}
+	}
+	if c.Prune {
+		iter, err := cl.ScheduleClient().List(cctx, client.ScheduleListOptions{Query: c.Query})
+		if err != nil {
+			return err
+		}
+		for iter.HasNext() {
+			ent, err := iter.Next()
+			if err != nil {
+				return err
+			}
+			if !slices.ContainsFunc(manifest.Schedules, func(e *scheduleManifestEntry) bool { return e.ID == ent.ID }) {
+				plan.Delete = append(plan.Delete, ent.ID)
+			}
+		}
+		slices.Sort(plan.Delete)
+	}
+
+	printScheduleApplyPlan(cctx, &plan)
+	if c.DryRun || len(plan.Create)+len(plan.Update)+len(plan.Delete) == 0 {
+		return nil
+	}
+	if yes, err := cctx.promptYes("Apply these changes? y/N", c.Yes); err != nil {
+		return err
+	} else if !yes {
+		return fmt.Errorf("user denied confirmation")
+	}
+
+	for _, id := range plan.Create {
+		opts, err := creates[id].scheduleOptions()
+		if err != nil {
+			return err
+		}
+		if _, err := cl.ScheduleClient().Create(cctx, opts); err != nil {
+			return fmt.Errorf("failed creating schedule %q: %w", id, err)
+		}
+		cctx.Printer.Printlnf("Created schedule %v", id)
+	}
+	for _, update := range plan.Update {
+		newSchedule, err := updates[update.ScheduleId].schedule()
+		if err != nil {
+			return err
+		}
+		err = cl.ScheduleClient().GetHandle(cctx, update.ScheduleId).Update(cctx, client.ScheduleUpdateOptions{
+			DoUpdate: func(u client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+				return &client.ScheduleUpdate{Schedule: newSchedule}, nil
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed updating schedule %q: %w", update.ScheduleId, err)
+		}
+		cctx.Printer.Printlnf("Updated schedule %v", update.ScheduleId)
+	}
+	for _, id := range plan.Delete {
+		if err := cl.ScheduleClient().GetHandle(cctx, id).Delete(cctx); err != nil {
+			return fmt.Errorf("failed deleting schedule %q: %w", id, err)
+		}
+		cctx.Printer.Printlnf("Deleted schedule %v", id)
+	}
+	return nil
+}
+
+func printScheduleApplyPlan(cctx *CommandContext, plan *scheduleApplyPlan) {
+	if cctx.JSONOutput {
+		_ = cctx.Printer.PrintStructured(plan, printer.StructuredOptions{})
+		return
+	}
+	for _, id := range plan.Create {
+		cctx.Printer.Printlnf("+ create %v", id)
+	}
+	for _, update := range plan.Update {
+		cctx.Printer.Printlnf("~ update %v", update.ScheduleId)
+		printManifestChanges(cctx, update.Changes)
+	}
+	for _, id := range plan.Delete {
+		cctx.Printer.Printlnf("- delete %v", id)
+	}
+	cctx.Printer.Printlnf("Plan: %v to create, %v to update, %v to delete, %v unchanged",
+		len(plan.Create), len(plan.Update), len(plan.Delete), len(plan.Unchanged))
+}
+
+func (c *TemporalScheduleExportCommand) run(cctx *CommandContext, args []string) error {
+	cl, err := dialClient(cctx, &c.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	ids := []string{c.ScheduleId}
+	if c.ScheduleId == "" {
+		ids = nil
+		iter, err := cl.ScheduleClient().List(cctx, client.ScheduleListOptions{Query: c.Query})
+		if err != nil {
+			return err
+		}
+		for iter.HasNext() {
+			ent, err := iter.Next()
+			if err != nil {
+				return err
+			}
+			ids = append(ids, ent.ID)
+		}
+		slices.Sort(ids)
+	}
+
+	manifest := scheduleManifest{Schedules: []*scheduleManifestEntry{}}
+	for _, id := range ids {
+		desc, err := cl.ScheduleClient().GetHandle(cctx, id).Describe(cctx)
+		if err != nil {
+			return fmt.Errorf("failed describing schedule %q: %w", id, err)
+		}
+		entry, err := printableToManifestEntry(describeResultToPrintable(id, desc))
+		if err != nil {
+			return fmt.Errorf("failed exporting schedule %q: %w", id, err)
+		}
+		manifest.Schedules = append(manifest.Schedules, entry)
+	}
+
+	toFile, err := writeManifest(cctx, manifest, c.OutputFile)
+	if err != nil {
+		return err
+	} else if toFile {
+		cctx.Printer.Printlnf("Exported %v schedule(s) to %v", len(manifest.Schedules), c.OutputFile)
+	}
+	return nil
+}
+
+func readScheduleManifest(file string) (*scheduleManifest, error) {
+	var manifest scheduleManifest
+	if err := readManifest(file, &manifest); err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for i, entry := range manifest.Schedules {
+		if entry == nil || entry.ID == "" {
+			return nil, fmt.Errorf("schedule at index %v missing id", i)
+		} else if seen[entry.ID] {
+			return nil, fmt.Errorf("schedule %q defined more than once", entry.ID)
+		}
+		seen[entry.ID] = true
+	}
+	return &manifest, nil
+}
+
+// toCreateCommand converts the entry into the options of schedule create so
+// both commands build schedules the same way.
+func (e *scheduleManifestEntry) toCreateCommand() (*TemporalScheduleCreateCommand, error) {
+	c := &TemporalScheduleCreateCommand{}
+	c.ScheduleId = e.ID
+	c.Cron = e.Cron
+	for _, cal := range e.Calendar {
+		b, err := json.Marshal(cal)
+		if err != nil {
+			return nil, err
+		}
+		c.Calendar = append(c.Calendar, string(b))
+	}
+	c.Interval = e.Interval
+	c.TimeZone = e.TimeZone
+	c.Notes = e.Notes
+	c.Paused = e.Paused
+	c.PauseOnFailure = e.PauseOnFailure
+	c.RemainingActions = e.RemainingActions
+	c.OverlapPolicy.Value = e.OverlapPolicy
+	if c.OverlapPolicy.Value == "" {
+		c.OverlapPolicy.Value = "Skip"
+	}
+	for _, ts := range []struct {
+		name string
+		s    string
+		to   *cliext.FlagTimestamp
+	}{
+		{"startTime", e.StartTime, &c.StartTime},
+		{"endTime", e.EndTime, &c.EndTime},
+	} {
+		if ts.s != "" {
+			if err := ts.to.Set(ts.s); err != nil {
+				return nil, fmt.Errorf("invalid %v: %w", ts.name, err)
+			}
+		}
+	}
+	for _, d := range []struct {
+		name string
+		s    string
+		to   *cliext.FlagDuration
+	}{
+		{"jitter", e.Jitter, &c.Jitter},
+		{"catchupWindow", e.CatchupWindow, &c.CatchupWindow},
+	} {
+		if d.s != "" {
+			if err := d.to.Set(d.s); err != nil {
+				return nil, fmt.Errorf("invalid %v: %w", d.name, err)
+			}
+		}
+	}
+
+	var err error
+	if err = e.Workflow.setStartOptions(&c.SharedWorkflowStartOptions, &c.PayloadInputOptions, "workflow."); err != nil {
+		return nil, err
+	} else if c.ScheduleMemo, err = keyJSONValuePairs(e.Memo); err != nil {
+		return nil, fmt.Errorf("invalid memo: %w", err)
+	} else if c.ScheduleSearchAttribute, err = keyJSONValuePairs(e.SearchAttributes); err != nil {
+		return nil, fmt.Errorf("invalid searchAttributes: %w", err)
+	}
+
+	// Validate the rest of the conversion
+	if _, err := c.scheduleOptions(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// setStartOptions converts the workflow into the options of workflow start.
+// The prefix is added to field names in errors.
+func (w *manifestWorkflow) setStartOptions(
+	sw *SharedWorkflowStartOptions,
+	input *PayloadInputOptions,
+	prefix string,
+) error {
+	if w.Type == "" {
+		return fmt.Errorf("missing %vtype", prefix)
+	} else if w.TaskQueue == "" {
+		return fmt.Errorf("missing %vtaskQueue", prefix)
+	}
+	for _, d := range []struct {
+		name string
+		s    string
+		to   *cliext.FlagDuration
+	}{
+		{"executionTimeout", w.ExecutionTimeout, &sw.ExecutionTimeout},
+		{"runTimeout", w.RunTimeout, &sw.RunTimeout},
+		{"taskTimeout", w.TaskTimeout, &sw.TaskTimeout},
+	} {
+		if d.s != "" {
+			if err := d.to.Set(d.s); err != nil {
+				return fmt.Errorf("invalid %v%v: %w", prefix, d.name, err)
+			}
+		}
+	}
+	sw.WorkflowId = w.ID
+	sw.Type = w.Type
+	sw.TaskQueue = w.TaskQueue
+	sw.StaticSummary = w.StaticSummary
+	sw.StaticDetails = w.StaticDetails
+	for _, in := range w.Input {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("invalid %vinput: %w", prefix, err)
+		}
+		input.Input = append(input.Input, string(b))
+	}
+	var err error
+	if sw.Memo, err = keyJSONValuePairs(w.Memo); err != nil {
+		return fmt.Errorf("invalid %vmemo: %w", prefix, err)
+	} else if sw.SearchAttribute, err = keyJSONValuePairs(w.SearchAttributes); err != nil {
+		return fmt.Errorf("invalid %vsearchAttributes: %w", prefix, err)
+	}
+	return nil
+}
+
+func (c *TemporalScheduleCreateCommand) toUpdateCommand() *TemporalScheduleUpdateCommand {
+	return &TemporalScheduleUpdateCommand{
+		ScheduleConfigurationOptions: c.ScheduleConfigurationOptions,
+		ScheduleIdOptions:            c.ScheduleIdOptions,
+		OverlapPolicyOptions:         c.OverlapPolicyOptions,
+		SharedWorkflowStartOptions:   c.SharedWorkflowStartOptions,
+		PayloadInputOptions:          c.PayloadInputOptions,
+	}
+}
+
+// keyJSONValuePairs is the inverse of stringKeysJSONValues.
+func keyJSONValuePairs(m map[string]any) ([]string, error) {
+	var pairs []string
+	for k, v := range m {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, k+"="+string(b))
+	}
+	slices.Sort(pairs)
+	return pairs, nil
+}
+
+// printableToManifestEntry converts a described schedule into the manifest
+// format in the canonical form compared against by apply.
+func printableToManifestEntry(p *printableSchedule) (*scheduleManifestEntry, error) {
+	e := &scheduleManifestEntry{
+		ID:             p.ScheduleId,
+		TimeZone:       p.TimeZoneName,
+		Notes:          p.Notes,
+		Paused:         p.Paused,
+		PauseOnFailure: p.PauseOnFailure,
+		OverlapPolicy:  canonicalOverlapPolicy(p.OverlapPolicy),
+		StartTime:      canonicalManifestTime(p.StartAt),
+		EndTime:        canonicalManifestTime(p.EndAt),
+		Jitter:         canonicalManifestDuration(strings.ReplaceAll(p.Jitter, " ", "")),
+		CatchupWindow:  canonicalCatchupWindow(strings.ReplaceAll(p.CatchupWindow, " ", "")),
+	}
+	for _, spec := range p.Spec {
+		switch spec := spec.(type) {
+		case *schedpb.CalendarSpec:
+			e.Calendar = append(e.Calendar, &scheduleManifestCalendar{
+				Second:     spec.Second,
+				Minute:     spec.Minute,
+				Hour:       spec.Hour,
+				DayOfMonth: spec.DayOfMonth,
+				Month:      spec.Month,
+				Year:       spec.Year,
+				DayOfWeek:  spec.DayOfWeek,
+				Comment:    spec.Comment,
+			})
+		case printableInterval:
+			interval := spec.Every
+			if spec.Offset != "" {
+				interval += "/" + spec.Offset
+			}
+			e.Interval = append(e.Interval, canonicalManifestInterval(strings.ReplaceAll(interval, " ", "")))
+		}
+	}
+	if p.LimitedActions {
+		e.RemainingActions, _ = strconv.Atoi(p.RemainingActions)
+	}
+
+	action, ok := p.Action.(*client.ScheduleWorkflowAction)
+	if !ok {
+		return nil, fmt.Errorf("unsupported schedule action %T", p.Action)
+	}
+	e.Workflow = manifestWorkflow{
+		ID:               action.ID,
+		Type:             fmt.Sprint(action.Workflow),
+		TaskQueue:        action.TaskQueue,
+		ExecutionTimeout: canonicalManifestDuration(action.WorkflowExecutionTimeout.String()),
+		RunTimeout:       canonicalManifestDuration(action.WorkflowRunTimeout.String()),
+		TaskTimeout:      canonicalManifestDuration(action.WorkflowTaskTimeout.String()),
+		StaticSummary:    action.StaticSummary,
+		StaticDetails:    action.StaticDetails,
+	}
+	var err error
+	for _, arg := range action.Args {
+		payload, ok := arg.(*commonpb.Payload)
+		if !ok {
+			return nil, fmt.Errorf("unexpected workflow input %T", arg)
+		}
+		value, err := manifestPayloadValue(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding workflow input: %w", err)
+		}
+		e.Workflow.Input = append(e.Workflow.Input, value)
+	}
+	if e.Workflow.Memo, err = manifestPayloadValues(action.Memo); err != nil {
+		return nil, fmt.Errorf("failed decoding workflow memo: %w", err)
+	}
+	searchAttrs := map[string]any{}
+	for k, v := range action.TypedSearchAttributes.GetUntypedValues() {
+		searchAttrs[k.GetName()] = v
+	}
+	for k, v := range action.UntypedSearchAttributes {
+		searchAttrs[k] = v
+	}
+	if e.Workflow.SearchAttributes, err = manifestPayloadValues(searchAttrs); err != nil {
+		return nil, fmt.Errorf("failed decoding workflow search attributes: %w", err)
+	}
+	memo := map[string]any{}
+	for k, v := range p.Memo.GetFields() {
+		memo[k] = v
+	}
+	if e.Memo, err = manifestPayloadValues(memo); err != nil {
+		return nil, fmt.Errorf("failed decoding memo: %w", err)
+	}
+	searchAttrs = map[string]any{}
+	for k, v := range p.SearchAttributes.GetIndexedFields() {
+		searchAttrs[k] = v
+	}
+	if e.SearchAttributes, err = manifestPayloadValues(searchAttrs); err != nil {
+		return nil, fmt.Errorf("failed decoding search attributes: %w", err)
+	}
+	return e, nil
+}
+
+func manifestPayloadValue(payload *commonpb.Payload) (any, error) {
+	var value any
+	if err := converter.GetDefaultDataConverter().FromPayload(payload, &value); err != nil {
+		return nil, err
+	}
+	return canonicalManifestValue(value), nil
+}
+
+// manifestPayloadValues decodes payload values and normalizes others,
+// returning nil for an empty map.
+func manifestPayloadValues(m map[string]any) (map[string]any, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		if payload, ok := v.(*commonpb.Payload); ok {
+			var err error
+			if out[k], err = manifestPayloadValue(payload); err != nil {
+				return nil, fmt.Errorf("key %v: %w", k, err)
+			}
+		} else {
+			out[k] = canonicalManifestValue(v)
+		}
+	}
+	return out, nil
+}
+
+// canonicalManifestValue round trips the value through JSON so values from
+// files and from payloads compare equal.
+func canonicalManifestValue(v any) any {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return v
+	}
+	return out
+}
+
+// canonical returns a copy of the entry in the form the server would describe
+// it, so it can be compared with a live schedule. Values that fail to parse
+// are left as is since they are validated before apply.
+func (e *scheduleManifestEntry) canonical() *scheduleManifestEntry {
+	out := *e
+	out.Cron, out.Calendar, out.Interval = nil, nil, nil
+	// The server orders calendars from cron strings after structured ones, and
+	// both --cron and --calendar are sent as cron strings.
+	for _, cron := range e.Cron {
+		if cal, err := canonicalCronCalendar(cron); err == nil {
+			out.Calendar = append(out.Calendar, cal)
+		} else {
+			out.Cron = append(out.Cron, cron)
+		}
+	}
+	for _, cal := range e.Calendar {
+		cron, err := toCronString(&schedpb.CalendarSpec{
+			Second:     cal.Second,
+			Minute:     cal.Minute,
+			Hour:       cal.Hour,
+			DayOfMonth: cal.DayOfMonth,
+			Month:      cal.Month,
+			Year:       cal.Year,
+			DayOfWeek:  cal.DayOfWeek,
+			Comment:    cal.Comment,
+		})
+		if err == nil {
+			if canonical, err := canonicalCronCalendar(cron); err == nil {
+				out.Calendar = append(out.Calendar, canonical)
+				continue
+			}
+		}
+		out.Calendar = append(out.Calendar, cal)
+	}
+	for _, interval := range e.Interval {
+		out.Interval = append(out.Interval, canonicalManifestInterval(interval))
+	}
+	out.StartTime = canonicalManifestTimeString(e.StartTime)
+	out.EndTime = canonicalManifestTimeString(e.EndTime)
+	out.Jitter = canonicalManifestDuration(e.Jitter)
+	out.CatchupWindow = canonicalCatchupWindow(e.CatchupWindow)
+	if overlap, err := enumspb.ScheduleOverlapPolicyFromString(e.OverlapPolicy); err == nil {
+		out.OverlapPolicy = canonicalOverlapPolicy(overlap)
+	}
+	out.Workflow.ExecutionTimeout = canonicalManifestDuration(e.Workflow.ExecutionTimeout)
+	out.Workflow.RunTimeout = canonicalManifestDuration(e.Workflow.RunTimeout)
+	out.Workflow.TaskTimeout = canonicalManifestDuration(e.Workflow.TaskTimeout)
+	out.Workflow.Input = nil
+	for _, input := range e.Workflow.Input {
+		out.Workflow.Input = append(out.Workflow.Input, canonicalManifestValue(input))
+	}
+	out.Workflow.Memo, _ = manifestPayloadValues(e.Workflow.Memo)
+	out.Workflow.SearchAttributes, _ = manifestPayloadValues(e.Workflow.SearchAttributes)
+	out.Memo, _ = manifestPayloadValues(e.Memo)
+	out.SearchAttributes, _ = manifestPayloadValues(e.SearchAttributes)
+	return &out
+}
+
+// diffScheduleManifestEntries compares the updatable fields of two canonical
+// entries. Schedule memo and search attributes are skipped since they cannot
+// be updated.
+func diffScheduleManifestEntries(old, new *scheduleManifestEntry) []*manifestChange {
+	fields := []manifestField{
+		{"cron", old.Cron, new.Cron},
+		{"calendar", old.Calendar, new.Calendar},
+		{"interval", old.Interval, new.Interval},
+		{"startTime", old.StartTime, new.StartTime},
+		{"endTime", old.EndTime, new.EndTime},
+		{"jitter", old.Jitter, new.Jitter},
+		{"timeZone", old.TimeZone, new.TimeZone},
+	}
+	// The server generates a workflow ID when unset
+	if new.Workflow.ID != "" {
+		fields = append(fields, manifestField{"workflow.id", old.Workflow.ID, new.Workflow.ID})
+	}
+	fields = append(fields,
+		manifestField{"workflow.type", old.Workflow.Type, new.Workflow.Type},
+		manifestField{"workflow.taskQueue", old.Workflow.TaskQueue, new.Workflow.TaskQueue},
+		manifestField{"workflow.executionTimeout", old.Workflow.ExecutionTimeout, new.Workflow.ExecutionTimeout},
+		manifestField{"workflow.runTimeout", old.Workflow.RunTimeout, new.Workflow.RunTimeout},
+		manifestField{"workflow.taskTimeout", old.Workflow.TaskTimeout, new.Workflow.TaskTimeout},
+		manifestField{"workflow.input", old.Workflow.Input, new.Workflow.Input},
+		manifestField{"workflow.memo", old.Workflow.Memo, new.Workflow.Memo},
+		manifestField{"workflow.searchAttributes", old.Workflow.SearchAttributes, new.Workflow.SearchAttributes},
+		manifestField{"workflow.staticSummary", old.Workflow.StaticSummary, new.Workflow.StaticSummary},
+		manifestField{"workflow.staticDetails", old.Workflow.StaticDetails, new.Workflow.StaticDetails},
+		manifestField{"overlapPolicy", old.OverlapPolicy, new.OverlapPolicy},
+		manifestField{"catchupWindow", old.CatchupWindow, new.CatchupWindow},
+		manifestField{"pauseOnFailure", old.PauseOnFailure, new.PauseOnFailure},
+		manifestField{"notes", old.Notes, new.Notes},
+		manifestField{"paused", old.Paused, new.Paused},
+		manifestField{"remainingActions", old.RemainingActions, new.RemainingActions},
+	)
+	return diffManifestFields(fields...)
+}
+
+func canonicalOverlapPolicy(p enumspb.ScheduleOverlapPolicy) string {
+	// Skip is the default
+	if p == enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED || p == enumspb.SCHEDULE_OVERLAP_POLICY_SKIP {
+		return ""
+	}
+	return p.String()
+}
+
+// The server uses a year when the catchup window is unset
+const defaultScheduleCatchupWindow = 365 * 24 * time.Hour
+
+func canonicalCatchupWindow(s string) string {
+	if d, err := cliext.ParseFlagDuration(s); err == nil && d == defaultScheduleCatchupWindow {
+		return ""
+	}
+	return canonicalManifestDuration(s)
+}
+
+func canonicalManifestTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func canonicalManifestTimeString(s string) string {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return s
+	}
+	return canonicalManifestTime(t)
+}
+
+// canonicalManifestDuration formats durations without zero units, e.g. "1h"
+// instead of "1h0m0s", and zero as empty.
+func canonicalManifestDuration(s string) string {
+	d, err := cliext.ParseFlagDuration(s)
+	if err != nil {
+		return s
+	} else if d == 0 {
+		return ""
+	}
+	out := d.String()
+	if strings.HasSuffix(out, "m0s") {
+		out = strings.TrimSuffix(out, "0s")
+	}
+	if strings.HasSuffix(out, "h0m") {
+		out = strings.TrimSuffix(out, "0m")
+	}
+	return out
+}
+
+func canonicalManifestInterval(s string) string {
+	spec, err := toIntervalSpec(s)
+	if err != nil {
+		return s
+	}
+	out := canonicalManifestDuration(spec.Every.String())
+	if spec.Offset > 0 {
+		out += "/" + canonicalManifestDuration(spec.Offset.String())
+	}
+	return out
+}
+
+var (
+	cronPredefined = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+	cronMonthNames = []string{"january", "february", "march", "april", "may", "june", "july",
+		"august", "september", "october", "november", "december"}
+	cronDayOfWeekNames = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+)
+
+// canonicalCronCalendar parses a cron string the way the server does and
+// formats the result the way schedule describe does. Cron strings with time
+// zones or @every intervals are not supported.
+func canonicalCronCalendar(cron string) (*scheduleManifestCalendar, error) {
+	cron, comment, _ := strings.Cut(strings.TrimSpace(cron), "#")
+	cron = strings.TrimSpace(cron)
+	if predefined, ok := cronPredefined[cron]; ok {
+		cron = predefined
+	}
+	cal := &schedpb.CalendarSpec{Comment: strings.TrimSpace(comment)}
+	switch f := strings.Fields(cron); len(f) {
+	case 5:
+		cal.Minute, cal.Hour, cal.DayOfMonth, cal.Month, cal.DayOfWeek = f[0], f[1], f[2], f[3], f[4]
+	case 6:
+		cal.Minute, cal.Hour, cal.DayOfMonth, cal.Month, cal.DayOfWeek, cal.Year = f[0], f[1], f[2], f[3], f[4], f[5]
+	case 7:
+		cal.Second, cal.Minute, cal.Hour, cal.DayOfMonth, cal.Month, cal.DayOfWeek, cal.Year = f[0], f[1], f[2], f[3], f[4], f[5], f[6]
+	default:
+		return nil, fmt.Errorf("cron string does not have 5-7 fields")
+	}
+	out := &scheduleManifestCalendar{Comment: cal.Comment}
+	for _, field := range []struct {
+		s  string
+		f  cronField
+		to *string
+	}{
+		{cal.Second, cronField{def: "0", min: 0, max: 59}, &out.Second},
+		{cal.Minute, cronField{def: "0", min: 0, max: 59}, &out.Minute},
+		{cal.Hour, cronField{def: "0", min: 0, max: 23}, &out.Hour},
+		{cal.DayOfMonth, cronField{def: "*", min: 1, max: 31}, &out.DayOfMonth},
+		{cal.Month, cronField{def: "*", min: 1, max: 12, names: cronMonthNames, nameBase: 1}, &out.Month},
+		{cal.Year, cronField{def: "*", min: 2000, max: 2100, year: true}, &out.Year},
+		{cal.DayOfWeek, cronField{def: "*", min: 0, max: 7, names: cronDayOfWeekNames, dayOfWeek: true}, &out.DayOfWeek},
+	} {
+		var err error
+		if *field.to, err = field.f.canonical(field.s); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+type cronField struct {
+	def       string
+	min, max  int
+	names     []string
+	nameBase  int
+	year      bool
+	dayOfWeek bool
+}
+
+// canonical mirrors the server's range parsing.
+func (f cronField) canonical(s string) (string, error) {
+	if s = strings.TrimSpace(s); s == "" {
+		s = f.def
+	}
+	// All years is represented as no ranges
+	if s == "*" && f.year {
+		return "", nil
+	}
+	parseValue := func(v string, minVal int) (int, error) {
+		minNameLen := 3
+		if f.dayOfWeek {
+			minNameLen = 2
+		}
+		if len(f.names) > 0 && len(v) >= minNameLen {
+			for i, name := range f.names {
+				if strings.HasPrefix(name, strings.ToLower(v)) {
+					v = strconv.Itoa(i + f.nameBase)
+					break
+				}
+			}
+		}
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, err
+		} else if i < minVal || i > f.max {
+			return 0, fmt.Errorf("%v is not in range [%v-%v]", v, minVal, f.max)
+		}
+		return i, nil
+	}
+	formatRange := func(start, end, step int) string {
+		out := strconv.Itoa(start)
+		if end > start {
+			out += "-" + strconv.Itoa(end)
+		}
+		if step > 1 {
+			out += "/" + strconv.Itoa(step)
+		}
+		return out
+	}
+
+	var ranges []string
+	for _, part := range strings.Split(s, ",") {
+		step, hasStep := 1, false
+		if rangeStr, stepStr, ok := strings.Cut(part, "/"); ok {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step < 1 {
+				return "", fmt.Errorf("invalid step %q", stepStr)
+			}
+			part, hasStep = rangeStr, true
+		}
+		start, end := f.min, f.max
+		if part != "*" {
+			var err error
+			if startStr, endStr, ok := strings.Cut(part, "-"); ok {
+				if start, err = parseValue(startStr, f.min); err != nil {
+					return "", err
+				} else if end, err = parseValue(endStr, start); err != nil {
+					return "", err
+				}
+			} else if start, err = parseValue(part, f.min); err != nil {
+				return "", err
+			} else if !hasStep {
+				end = start
+			}
+		}
+		// Sunday may be given as 7, which the server turns into 0
+		if f.dayOfWeek && end == 7 {
+			if (7-start)%step == 0 && (step > 1 || start > 1) {
+				ranges = append(ranges, "0")
+				if start == 7 {
+					continue
+				}
+			}
+			end = 6
+		}
+		ranges = append(ranges, formatRange(start, end, step))
+	}
+	return strings.Join(ranges, ","), nil
+}
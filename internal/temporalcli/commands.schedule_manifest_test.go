@@ -0,0 +1,227 @@
+package temporalcli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/sdk/client"
+)
+
+func (s *SharedServerSuite) TestSchedule_ApplyExport() {
+	res := s.Execute(
+		"operator", "search-attribute", "create",
+		"--address", s.Address(),
+		"--name", "TestSchedule_Apply",
+		"--type", "keyword",
+	)
+	s.NoError(res.Err)
+
+	prefix := fmt.Sprintf("sched-apply-%x", rand.Uint32())
+	idA, idB := prefix+"-a", prefix+"-b"
+	defer func() {
+		for _, id := range []string{idA, idB} {
+			_ = s.Client.ScheduleClient().GetHandle(s.Context, id).Delete(s.Context)
+		}
+	}()
+	dir := s.T().TempDir()
+	writeManifest := func(notes string) string {
+		file := filepath.Join(dir, "schedules.yaml")
+		s.NoError(os.WriteFile(file, []byte(fmt.Sprintf(`
+schedules:
+  - id: %[1]v
+    cron: ["30 12 * * Mon-Fri", "@daily # nightly"]
+    calendar:
+      - dayOfWeek: "7"
+        hour: "3"
+    jitter: 60s
+    timeZone: America/New_York
+    overlapPolicy: BufferOne
+    notes: %[4]q
+    workflow:
+      id: %[1]v-wf
+      type: DevWorkflow
+      taskQueue: %[3]v
+      runTimeout: 60m
+      input: [{"hello": "world"}, 5]
+      memo:
+        wfMemo: other data
+    memo:
+      schedMemo: data here
+    searchAttributes:
+      TestSchedule_Apply: here
+  - id: %[2]v
+    interval: ["90m/5m"]
+    paused: true
+    workflow:
+      type: DevWorkflow
+      taskQueue: %[3]v
+    searchAttributes:
+      TestSchedule_Apply: here
+`, idA, idB, s.Worker().Options.TaskQueue, notes)), 0644))
+		return file
+	}
+	file := writeManifest("first")
+
+	// Dry run changes nothing
+	res = s.Execute(
+		"schedule", "apply",
+		"--address", s.Address(),
+		"-f", file,
+		"--dry-run",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "+ create "+idA)
+	s.Contains(res.Stdout.String(), "+ create "+idB)
+	s.Contains(res.Stdout.String(), "Plan: 2 to create, 0 to update, 0 to delete, 0 unchanged")
+	_, err := s.Client.ScheduleClient().GetHandle(s.Context, idA).Describe(s.Context)
+	s.Error(err)
+
+	// Create
+	res = s.Execute(
+		"schedule", "apply",
+		"--address", s.Address(),
+		"-f", file,
+		"-y",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "Created schedule "+idA)
+	s.Contains(res.Stdout.String(), "Created schedule "+idB)
+	desc, err := s.Client.ScheduleClient().GetHandle(s.Context, idA).Describe(s.Context)
+	s.NoError(err)
+	s.Equal("first", desc.Schedule.State.Note)
+	s.Equal(time.Minute, desc.Schedule.Spec.Jitter)
+	action := desc.Schedule.Action.(*client.ScheduleWorkflowAction)
+	s.Equal(idA+"-wf", action.ID)
+	s.Len(action.Args, 2)
+	s.Contains(desc.Memo.GetFields(), "schedMemo")
+
+	// Same file again is unchanged
+	res = s.Execute(
+		"schedule", "apply",
+		"--address", s.Address(),
+		"-f", file,
+		"-y",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "Plan: 0 to create, 0 to update, 0 to delete, 2 unchanged")
+
+	// Changed notes are updated
+	file = writeManifest("second")
+	res = s.Execute(
+		"schedule", "apply",
+		"--address", s.Address(),
+		"-f", file,
+		"-y",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "~ update "+idA)
+	s.Contains(res.Stdout.String(), `notes: "first" => "second"`)
+	s.Contains(res.Stdout.String(), "Plan: 0 to create, 1 to update, 0 to delete, 1 unchanged")
+	desc, err = s.Client.ScheduleClient().GetHandle(s.Context, idA).Describe(s.Context)
+	s.NoError(err)
+	s.Equal("second", desc.Schedule.State.Note)
+
+	// Export and apply the export unchanged
+	exported := filepath.Join(dir, "exported.yaml")
+	res = s.Execute(
+		"schedule", "export",
+		"--address", s.Address(),
+		"-s", idA,
+		"-f", exported,
+	)
+	s.NoError(res.Err)
+	b, err := os.ReadFile(exported)
+	s.NoError(err)
+	s.Contains(string(b), "id: "+idA)
+	s.Contains(string(b), "overlapPolicy: BufferOne")
+	s.Contains(string(b), "schedMemo: data here")
+	res = s.Execute(
+		"schedule", "apply",
+		"--address", s.Address(),
+		"-f", exported,
+		"--dry-run",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "Plan: 0 to create, 0 to update, 0 to delete, 1 unchanged")
+
+	// JSON export
+	res = s.Execute(
+		"schedule", "export",
+		"--address", s.Address(),
+		"-s", idB,
+		"-o", "json",
+	)
+	s.NoError(res.Err)
+	var manifest struct {
+		Schedules []struct {
+			ID       string   `json:"id"`
+			Interval []string `json:"interval"`
+			Paused   bool     `json:"paused"`
+		} `json:"schedules"`
+	}
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &manifest))
+	s.Len(manifest.Schedules, 1)
+	s.Equal(idB, manifest.Schedules[0].ID)
+	s.Equal([]string{"1h30m/5m"}, manifest.Schedules[0].Interval)
+	s.True(manifest.Schedules[0].Paused)
+
+	// Prune only the schedule missing from the exported file
+	query := "TestSchedule_Apply = 'here'"
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		res = s.Execute(
+			"schedule", "apply",
+			"--address", s.Address(),
+			"-f", exported,
+			"--prune",
+			"-q", query,
+			"--dry-run",
+			"-o", "json",
+		)
+		assert.NoError(t, res.Err)
+		var plan struct {
+			Delete    []string `json:"delete"`
+			Unchanged []string `json:"unchanged"`
+		}
+		assert.NoError(t, json.Unmarshal(res.Stdout.Bytes(), &plan))
+		assert.Equal(t, []string{idB}, plan.Delete)
+		assert.Equal(t, []string{idA}, plan.Unchanged)
+	}, 10*time.Second, time.Second)
+	res = s.Execute(
+		"schedule", "apply",
+		"--address", s.Address(),
+		"-f", exported,
+		"--prune",
+		"-q", query,
+		"-y",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "Deleted schedule "+idB)
+	_, err = s.Client.ScheduleClient().GetHandle(s.Context, idB).Describe(s.Context)
+	s.Error(err)
+}
+
+func (s *SharedServerSuite) TestSchedule_Apply_Invalid() {
+	file := filepath.Join(s.T().TempDir(), "schedules.yaml")
+	for content, expectedErr := range map[string]string{
+		"schedules:\n  - workflow: {type: Wf, taskQueue: tq}\n":                               "missing id",
+		"schedules:\n  - id: a\n    workflow: {type: Wf}\n":                                   "missing workflow.taskQueue",
+		"schedules:\n  - id: a\n    jitter: soon\n    workflow: {type: Wf, taskQueue: tq}\n":  "invalid jitter",
+		"schedules:\n  - id: a\n    unknown: true\n    workflow: {type: Wf, taskQueue: tq}\n": "field unknown not found",
+		"schedules:\n  - id: a\n    workflow: {type: Wf, taskQueue: tq}\n" +
+			"  - id: a\n    workflow: {type: Wf, taskQueue: tq}\n": "defined more than once",
+	} {
+		s.NoError(os.WriteFile(file, []byte(content), 0644))
+		res := s.Execute(
+			"schedule", "apply",
+			"--address", s.Address(),
+			"-f", file,
+			"--dry-run",
+		)
+		s.ErrorContains(res.Err, expectedErr)
+	}
+}
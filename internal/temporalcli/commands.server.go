@@ -1,8 +1,10 @@
 package temporalcli
 
 import (
-	"encoding/json"
 	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/google/uuid"
@@ -32,36 +34,74 @@ var defaultDynamicConfigValues = map[string]any{
 }
 
 func (t *TemporalServerStartDevCommand) run(cctx *CommandContext, args []string) error {
+	cfg, err := t.loadConfig()
+	if err != nil {
+		return err
+	}
+	if t.PrintConfig {
+		_, err := writeManifest(cctx, cfg, "")
+		return err
+	}
 	// Have to assume "localhost" is 127.0.0.1 for server to work (it expects IP)
-	if t.Ip == "localhost" {
-		t.Ip = "127.0.0.1"
+	if cfg.Ip == "localhost" {
+		cfg.Ip = "127.0.0.1"
+	}
+	if cfg.Clusters < 1 || cfg.Clusters > maxDevClusters {
+		return fmt.Errorf("clusters must be between 1 and %v", maxDevClusters)
+	} else if cfg.Clusters > 1 && cfg.FromSnapshot != "" {
+		return fmt.Errorf("cannot start multiple clusters from a snapshot")
 	}
+	// Read the seed up front so an invalid file fails before the server starts
+	var seed *devServerSeed
+	if cfg.Seed != "" {
+		if seed, err = readDevServerSeed(cfg.Seed); err != nil {
+			return fmt.Errorf("invalid seed file: %w", err)
+		}
+	}
+	security, err := cfg.buildSecurity()
+	if err != nil {
+		return err
+	}
+	defer security.cleanup()
 	// Prepare options
 	opts := devserver.StartOptions{
-		FrontendIP:             t.Ip,
-		FrontendPort:           t.Port,
-		Namespaces:             append([]string{"default"}, t.Namespace...),
+		FrontendIP:             cfg.Ip,
+		FrontendPort:           cfg.Port,
+		Namespaces:             []string{"default"},
 		Logger:                 cctx.Logger,
-		DatabaseFile:           t.DbFilename,
-		MetricsPort:            t.MetricsPort,
-		FrontendHTTPPort:       t.HttpPort,
-		ClusterID:              uuid.NewString(),
-		MasterClusterName:      "active",
-		CurrentClusterName:     "active",
-		InitialFailoverVersion: 1,
-	}
-	// Set the log level value of the server to the overall log level given to the
-	// CLI. But if it is "never" we have to do a special value, and if it was
-	// never changed, we have to use the default of "warn" instead of the CLI
-	// default of "info" since server is noisier.
-	logLevel := t.Parent.Parent.LogLevel.Value
-	if !t.Parent.Parent.LogLevel.ChangedFromDefault {
-		logLevel = "warn"
+		DatabaseFile:           cfg.DbFilename,
+		MetricsPort:            cfg.MetricsPort,
+		PProfPort:              cfg.PprofPort,
+		FrontendHTTPPort:       cfg.HttpPort,
+		ClusterID:              cfg.ClusterId,
+		MasterClusterName:      cfg.ClusterName,
+		CurrentClusterName:     cfg.ClusterName,
+		InitialFailoverVersion: cfg.InitialFailoverVersion,
+		EnableGlobalNamespace:  cfg.EnableGlobalNamespace,
+		NamespaceRetention:     cfg.namespaceRetention(),
+		SqlitePragmas:          cfg.SqlitePragmas,
+		DynamicConfigFile:      cfg.DynamicConfigFile,
+		DynamicConfigPort:      cfg.DynamicConfigPort,
+		TLS:                    security.tls,
+		Auth:                   security.auth,
 	}
-	if logLevel == "never" {
+	for _, ns := range cfg.Namespaces {
+		if ns.Name != "default" {
+			opts.Namespaces = append(opts.Namespaces, ns.Name)
+		}
+	}
+	if opts.MasterClusterName == "" {
+		opts.MasterClusterName, opts.CurrentClusterName = "active", "active"
+	}
+	if opts.InitialFailoverVersion == 0 {
+		opts.InitialFailoverVersion = 1
+	}
+	// Set the log level value of the server to the configured log level, but if
+	// it is "never" we have to do a special value
+	if cfg.LogLevel == "never" {
 		opts.LogLevel = 100
-	} else if err := opts.LogLevel.UnmarshalText([]byte(logLevel)); err != nil {
-		return fmt.Errorf("invalid log level %q: %w", logLevel, err)
+	} else if err := opts.LogLevel.UnmarshalText([]byte(cfg.LogLevel)); err != nil {
+		return fmt.Errorf("invalid log level %q: %w", cfg.LogLevel, err)
 	}
 	if err := devserver.CheckPortFree(opts.FrontendIP, opts.FrontendPort); err != nil {
 		return fmt.Errorf("can't set frontend port %d: %w", opts.FrontendPort, err)
@@ -73,51 +113,30 @@ func (t *TemporalServerStartDevCommand) run(cctx *CommandContext, args []string)
 		}
 	}
 	// Setup UI
-	if !t.Headless {
-		opts.UIIP, opts.UIPort = t.UiIp, t.UiPort
+	if !cfg.Headless {
+		opts.UIIP, opts.UIPort = cfg.UiIp, cfg.UiPort
 		if opts.UIIP == "" {
-			opts.UIIP = t.Ip
+			opts.UIIP = cfg.Ip
 		}
 		if opts.UIPort == 0 {
-			opts.UIPort = t.Port + 1000
+			opts.UIPort = cfg.Port + 1000
 			if opts.UIPort > 65535 {
 				opts.UIPort = 65535
 			}
 			if err := devserver.CheckPortFree(opts.UIIP, opts.UIPort); err != nil {
-				return fmt.Errorf("can't use default UI port %d (%d + 1000): %w", opts.UIPort, t.Port, err)
+				return fmt.Errorf("can't use default UI port %d (%d + 1000): %w", opts.UIPort, cfg.Port, err)
 			}
 		} else {
 			if err := devserver.CheckPortFree(opts.UIIP, opts.UIPort); err != nil {
 				return fmt.Errorf("can't set UI port %d: %w", opts.UIPort, err)
 			}
 		}
-		opts.UIAssetPath, opts.UICodecEndpoint, opts.PublicPath = t.UiAssetPath, t.UiCodecEndpoint, t.UiPublicPath
-		opts.UIDisableNewsFetch = t.UiDisableNewsFetch
-	}
-	// Pragmas and dyn config
-	var err error
-	if opts.SqlitePragmas, err = stringKeysValues(t.SqlitePragma); err != nil {
-		return fmt.Errorf("invalid pragma: %w", err)
-	} else if opts.DynamicConfigValues, err = stringKeysJSONValues(t.DynamicConfigValue, true); err != nil {
-		return fmt.Errorf("invalid dynamic config values: %w", err)
-	}
-	// We have to convert all dynamic config values that JSON number to int if we
-	// can because server dynamic config expecting int won't work with the default
-	// float JSON unmarshal uses
-	for k, v := range opts.DynamicConfigValues {
-		if num, ok := v.(json.Number); ok {
-			if newV, err := num.Int64(); err == nil {
-				// Dynamic config only accepts int type, not int32 nor int64
-				opts.DynamicConfigValues[k] = int(newV)
-			} else if newV, err := num.Float64(); err == nil {
-				opts.DynamicConfigValues[k] = newV
-			} else {
-				return fmt.Errorf("invalid JSON value for key %q", k)
-			}
-		}
+		opts.UIAssetPath, opts.UICodecEndpoint, opts.PublicPath = cfg.UiAssetPath, cfg.UiCodecEndpoint, cfg.UiPublicPath
+		opts.UIDisableNewsFetch = cfg.UiDisableNewsFetch
 	}
 
 	// Apply set of default dynamic config values if not already present
+	opts.DynamicConfigValues = maps.Clone(cfg.DynamicConfig)
 	for k, v := range defaultDynamicConfigValues {
 		if _, ok := opts.DynamicConfigValues[k]; !ok {
 			if opts.DynamicConfigValues == nil {
@@ -128,22 +147,57 @@ func (t *TemporalServerStartDevCommand) run(cctx *CommandContext, args []string)
 	}
 
 	// Prepare search attributes for adding before starting server
-	searchAttrs, err := t.prepareSearchAttributes()
+	searchAttrs, err := prepareSearchAttributes(cfg.SearchAttributes)
 	if err != nil {
 		return err
 	}
 	opts.SearchAttributes = searchAttrs
 
+	// Restore the snapshot into the DB file, or a temporary one discarded on
+	// exit if no DB file is given
+	if cfg.FromSnapshot != "" {
+		snapshotOpts := ServerSnapshotOptions{SnapshotDir: cfg.SnapshotDir}
+		snapshotFile, err := snapshotOpts.existingSnapshotFile(cfg.FromSnapshot)
+		if err != nil {
+			return err
+		}
+		if opts.DatabaseFile == "" {
+			dir, err := os.MkdirTemp("", "temporal-dev-")
+			if err != nil {
+				return fmt.Errorf("failed creating temp dir: %w", err)
+			}
+			defer os.RemoveAll(dir)
+			opts.DatabaseFile = filepath.Join(dir, "temporal.db")
+		}
+		if err := devserver.RestoreDatabase(cctx, snapshotFile, opts.DatabaseFile); err != nil {
+			return fmt.Errorf("failed restoring snapshot: %w", err)
+		}
+	}
+
 	// If not using DB file, set persistent cluster ID
-	if t.DbFilename == "" {
+	if opts.ClusterID == "" && opts.DatabaseFile == "" {
 		opts.ClusterID = persistentClusterID()
+	} else if opts.ClusterID == "" {
+		opts.ClusterID = uuid.NewString()
 	}
 	// Log config if requested
-	if t.LogConfig {
+	if cfg.LogConfig {
 		opts.LogConfig = func(b []byte) {
 			_, _ = cctx.Options.Stderr.Write(b)
 		}
 	}
+	// The dynamic config endpoint is optional, so a busy default port only
+	// disables it
+	if opts.DynamicConfigPort == 0 {
+		opts.DynamicConfigPort = min(cfg.Port+2000, 65535)
+		if err := devserver.CheckPortFree("127.0.0.1", opts.DynamicConfigPort); err != nil {
+			cctx.Logger.Warn("Dynamic config endpoint disabled, default port is in use",
+				"port", opts.DynamicConfigPort, "error", err)
+			opts.DynamicConfigPort = 0
+		}
+	} else if err := devserver.CheckPortFree("127.0.0.1", opts.DynamicConfigPort); err != nil {
+		return fmt.Errorf("can't set dynamic config port %d: %w", opts.DynamicConfigPort, err)
+	}
 	// Grab a free port for metrics ahead-of-time so we know what port is selected
 	if opts.MetricsPort == 0 {
 		opts.MetricsPort = devserver.MustGetFreePort(opts.FrontendIP)
@@ -153,32 +207,75 @@ func (t *TemporalServerStartDevCommand) run(cctx *CommandContext, args []string)
 		}
 	}
 
+	clusterOpts := []devserver.StartOptions{opts}
+	if cfg.Clusters > 1 {
+		if clusterOpts, err = multiClusterOptions(opts, cfg.Clusters); err != nil {
+			return err
+		}
+	}
+
 	// Start, wait for context complete, then stop
-	s, err := devserver.Start(opts)
-	if err != nil {
-		return fmt.Errorf("failed starting server: %w", err)
+	servers := make([]*devserver.Server, 0, len(clusterOpts))
+	for _, opts := range clusterOpts {
+		s, err := devserver.Start(opts)
+		if err != nil && len(clusterOpts) > 1 {
+			return fmt.Errorf("failed starting %v: %w", opts.CurrentClusterName, err)
+		} else if err != nil {
+			return fmt.Errorf("failed starting server: %w", err)
+		}
+		defer s.Stop()
+		servers = append(servers, s)
+	}
+	if len(clusterOpts) > 1 {
+		if err := connectClusters(cctx, clusterOpts, opts.Namespaces[1:]); err != nil {
+			return err
+		}
+	}
+	if seed != nil {
+		// Seeded through the local address so TLS and auth do not apply
+		if err := applyDevServerSeed(cctx, servers[0].LocalFrontendAddress(), seed, cfg.Seed); err != nil {
+			return err
+		}
 	}
-	defer s.Stop()
 
 	cctx.Printer.Printlnf("Temporal CLI %v\n", VersionString())
-	cctx.Printer.Printlnf("%-21s %v:%v", "Temporal Server:", toFriendlyIp(opts.FrontendIP), opts.FrontendPort)
-	if t.DbFilename == "" {
-		cctx.Printer.Printlnf("%-21s %v", "Temporal Persistence:", "in-memory")
-	} else {
-		cctx.Printer.Printlnf("%-21s %v", "Temporal Persistence:", t.DbFilename)
-	}
-	// Only print HTTP port if explicitly provided to avoid promoting the unstable HTTP API.
-	if opts.FrontendHTTPPort > 0 {
-		cctx.Printer.Printlnf("%-21s %v:%v", "Temporal HTTP:", toFriendlyIp(opts.FrontendIP), opts.FrontendHTTPPort)
+	for i, opts := range clusterOpts {
+		if len(clusterOpts) > 1 {
+			if i > 0 {
+				cctx.Printer.Println()
+			}
+			cctx.Printer.Printlnf("%-21s %v", "Temporal Cluster:", opts.CurrentClusterName)
+		}
+		cctx.Printer.Printlnf("%-21s %v:%v", "Temporal Server:", toFriendlyIp(opts.FrontendIP), opts.FrontendPort)
+		if cfg.DbFilename == "" && cfg.FromSnapshot != "" {
+			cctx.Printer.Printlnf("%-21s snapshot %v (not saved)", "Temporal Persistence:", cfg.FromSnapshot)
+		} else if opts.DatabaseFile == "" {
+			cctx.Printer.Printlnf("%-21s %v", "Temporal Persistence:", "in-memory")
+		} else {
+			cctx.Printer.Printlnf("%-21s %v", "Temporal Persistence:", opts.DatabaseFile)
+		}
+		// Only print HTTP port if explicitly provided to avoid promoting the unstable HTTP API.
+		if opts.FrontendHTTPPort > 0 {
+			cctx.Printer.Printlnf("%-21s %v:%v", "Temporal HTTP:", toFriendlyIp(opts.FrontendIP), opts.FrontendHTTPPort)
+		}
+		if !cfg.Headless {
+			cctx.Printer.Printlnf("%-21s http://%v:%v%v", "Temporal UI:", toFriendlyIp(opts.UIIP), opts.UIPort, opts.PublicPath)
+		}
+		cctx.Printer.Printlnf("%-21s http://%v:%v/metrics", "Temporal Metrics:", toFriendlyIp(opts.FrontendIP), opts.MetricsPort)
+		if opts.DynamicConfigPort > 0 {
+			cctx.Printer.Printlnf("%-21s 127.0.0.1:%v", "Dynamic Config:", opts.DynamicConfigPort)
+		}
+		security.printBanner(cctx)
 	}
-	if !t.Headless {
-		cctx.Printer.Printlnf("%-21s http://%v:%v%v", "Temporal UI:", toFriendlyIp(opts.UIIP), opts.UIPort, opts.PublicPath)
+	if err := security.printClientConfig(cctx, fmt.Sprintf("%v:%v", toFriendlyIp(opts.FrontendIP), opts.FrontendPort)); err != nil {
+		return err
 	}
-	cctx.Printer.Printlnf("%-21s http://%v:%v/metrics", "Temporal Metrics:", toFriendlyIp(opts.FrontendIP), opts.MetricsPort)
 	<-cctx.Done()
 	if !t.Parent.Parent.LogLevel.ChangedFromDefault {
 		// The server routinely emits various warnings on shutdown.
-		s.SuppressWarnings()
+		for _, s := range servers {
+			s.SuppressWarnings()
+		}
 	}
 	return nil
 }
@@ -210,11 +307,7 @@ func persistentClusterID() string {
 	return id
 }
 
-func (t *TemporalServerStartDevCommand) prepareSearchAttributes() (map[string]enums.IndexedValueType, error) {
-	opts, err := stringKeysValues(t.SearchAttribute)
-	if err != nil {
-		return nil, fmt.Errorf("invalid search attributes: %w", err)
-	}
+func prepareSearchAttributes(opts map[string]string) (map[string]enums.IndexedValueType, error) {
 	attrs := make(map[string]enums.IndexedValueType, len(opts))
 	for k, v := range opts {
 		// Case-insensitive index type lookup
@@ -0,0 +1,186 @@
+package temporalcli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/temporalio/cli/internal/devserver"
+)
+
+// maxDevClusters is bound by the failover version increment of the dev server,
+// since every cluster needs a distinct initial failover version below it.
+const maxDevClusters = 9
+
+// clusterReadyTimeout is how long start-dev waits for clusters to know about
+// each other, which is bound by the cluster metadata refresh interval.
+const clusterReadyTimeout = 30 * time.Second
+
+var multiClusterDynamicConfigValues = map[string]any{
+	// Remote clusters are added after start, so refresh cluster metadata often
+	// for them to be usable right away instead of after the minute default.
+	"system.clusterMetadataRefreshInterval": "1s",
+}
+
+// multiClusterOptions returns the start options of every cluster, derived from
+// the options of the first one using consecutive ports.
+func multiClusterOptions(opts devserver.StartOptions, count int) ([]devserver.StartOptions, error) {
+	opts.EnableGlobalNamespace = true
+	// Only the "default" Namespace is local to each cluster, the others are
+	// registered as global Namespaces once clusters are connected
+	opts.Namespaces = []string{"default"}
+	for k, v := range multiClusterDynamicConfigValues {
+		if _, ok := opts.DynamicConfigValues[k]; !ok {
+			opts.DynamicConfigValues[k] = v
+		}
+	}
+
+	clusterOpts := make([]devserver.StartOptions, count)
+	for i := range clusterOpts {
+		c := opts
+		c.CurrentClusterName = clusterName(i)
+		// The master cluster has to be in the static config which only has the
+		// current cluster, so every cluster is its own master. This only means
+		// global Namespaces can be registered in any of them.
+		c.MasterClusterName = c.CurrentClusterName
+		c.InitialFailoverVersion = i + 1
+		if i == 0 {
+			clusterOpts[i] = c
+			continue
+		}
+		c.ClusterID = uuid.NewString()
+		c.FrontendPort = opts.FrontendPort + i
+		if err := devserver.CheckPortFree(c.FrontendIP, c.FrontendPort); err != nil {
+			return nil, fmt.Errorf("can't set frontend port %d for %v: %w", c.FrontendPort, c.CurrentClusterName, err)
+		}
+		if opts.FrontendHTTPPort > 0 {
+			c.FrontendHTTPPort = opts.FrontendHTTPPort + i
+			if err := devserver.CheckPortFree(c.FrontendIP, c.FrontendHTTPPort); err != nil {
+				return nil, fmt.Errorf("can't set frontend HTTP port %d for %v: %w", c.FrontendHTTPPort, c.CurrentClusterName, err)
+			}
+		}
+		if opts.UIIP != "" {
+			c.UIPort = opts.UIPort + i
+			if err := devserver.CheckPortFree(c.UIIP, c.UIPort); err != nil {
+				return nil, fmt.Errorf("can't set UI port %d for %v: %w", c.UIPort, c.CurrentClusterName, err)
+			}
+		}
+		if opts.DynamicConfigPort > 0 {
+			c.DynamicConfigPort = opts.DynamicConfigPort + i
+			if err := devserver.CheckPortFree("127.0.0.1", c.DynamicConfigPort); err != nil {
+				return nil, fmt.Errorf("can't set dynamic config port %d for %v: %w", c.DynamicConfigPort, c.CurrentClusterName, err)
+			}
+		}
+		c.MetricsPort = devserver.MustGetFreePort(c.FrontendIP)
+		if opts.DatabaseFile != "" {
+			ext := filepath.Ext(opts.DatabaseFile)
+			c.DatabaseFile = strings.TrimSuffix(opts.DatabaseFile, ext) + "-" + c.CurrentClusterName + ext
+		}
+		clusterOpts[i] = c
+	}
+	return clusterOpts, nil
+}
+
+func clusterName(index int) string {
+	return fmt.Sprintf("cluster-%v", index+1)
+}
+
+func clusterAddress(opts devserver.StartOptions) string {
+	return fmt.Sprintf("%v:%v", devserver.MaybeEscapeIPv6(opts.FrontendIP), opts.FrontendPort)
+}
+
+// connectClusters adds every cluster as a remote cluster of every other one,
+// then registers the given Namespaces as global Namespaces active in the first
+// cluster.
+func connectClusters(cctx *CommandContext, clusterOpts []devserver.StartOptions, namespaces []string) error {
+	clients := make([]client.Client, len(clusterOpts))
+	for i, opts := range clusterOpts {
+		cl, err := client.DialContext(cctx, client.Options{
+			HostPort: clusterAddress(opts),
+			Logger:   log.NewStructuredLogger(cctx.Logger),
+		})
+		if err != nil {
+			return fmt.Errorf("failed connecting to %v: %w", opts.CurrentClusterName, err)
+		}
+		defer cl.Close()
+		clients[i] = cl
+	}
+	for i, cl := range clients {
+		for j, remote := range clusterOpts {
+			if i == j {
+				continue
+			}
+			req := &operatorservice.AddOrUpdateRemoteClusterRequest{
+				FrontendAddress:               clusterAddress(remote),
+				EnableRemoteClusterConnection: true,
+				EnableReplication:             true,
+			}
+			if remote.FrontendHTTPPort > 0 {
+				req.FrontendHttpAddress = fmt.Sprintf("%v:%v",
+					devserver.MaybeEscapeIPv6(remote.FrontendIP), remote.FrontendHTTPPort)
+			}
+			if _, err := cl.OperatorService().AddOrUpdateRemoteCluster(cctx, req); err != nil {
+				return fmt.Errorf("failed adding %v to %v: %w",
+					remote.CurrentClusterName, clusterOpts[i].CurrentClusterName, err)
+			}
+		}
+	}
+
+	clusters := make([]*replication.ClusterReplicationConfig, len(clusterOpts))
+	for i, opts := range clusterOpts {
+		clusters[i] = &replication.ClusterReplicationConfig{ClusterName: opts.CurrentClusterName}
+	}
+	for _, ns := range namespaces {
+		retention, ok := clusterOpts[0].NamespaceRetention[ns]
+		if !ok {
+			retention = 24 * time.Hour
+		}
+		req := &workflowservice.RegisterNamespaceRequest{
+			Namespace:                        ns,
+			WorkflowExecutionRetentionPeriod: durationpb.New(retention),
+			Clusters:                         clusters,
+			ActiveClusterName:                clusterOpts[0].CurrentClusterName,
+			IsGlobalNamespace:                true,
+		}
+		if err := registerGlobalNamespace(cctx, clients[0], req); err != nil {
+			return fmt.Errorf("failed registering namespace %v: %w", ns, err)
+		}
+	}
+	return nil
+}
+
+// registerGlobalNamespace registers the Namespace, retrying while the cluster
+// has not yet refreshed its metadata with the remote clusters.
+func registerGlobalNamespace(
+	cctx *CommandContext,
+	cl client.Client,
+	req *workflowservice.RegisterNamespaceRequest,
+) error {
+	deadline := time.Now().Add(clusterReadyTimeout)
+	for {
+		_, err := cl.WorkflowService().RegisterNamespace(cctx, req)
+		var alreadyExists *serviceerror.NamespaceAlreadyExists
+		var invalidArg *serviceerror.InvalidArgument
+		if err == nil || errors.As(err, &alreadyExists) {
+			return nil
+		} else if !errors.As(err, &invalidArg) || time.Now().After(deadline) {
+			return err
+		}
+		select {
+		case <-cctx.Done():
+			return cctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package temporalcli
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/temporalio/cli/cliext"
+)
+
+// devServerConfig is the start-dev configuration file. It has a key for every
+// option, named in camel case, plus settings that have no option.
+type devServerConfig struct {
+	Ip                 string `yaml:"ip,omitempty" json:"ip,omitempty"`
+	Port               int    `yaml:"port,omitempty" json:"port,omitempty"`
+	HttpPort           int    `yaml:"httpPort,omitempty" json:"httpPort,omitempty"`
+	MetricsPort        int    `yaml:"metricsPort,omitempty" json:"metricsPort,omitempty"`
+	PprofPort          int    `yaml:"pprofPort,omitempty" json:"pprofPort,omitempty"`
+	DbFilename         string `yaml:"dbFilename,omitempty" json:"dbFilename,omitempty"`
+	FromSnapshot       string `yaml:"fromSnapshot,omitempty" json:"fromSnapshot,omitempty"`
+	SnapshotDir        string `yaml:"snapshotDir,omitempty" json:"snapshotDir,omitempty"`
+	Headless           bool   `yaml:"headless,omitempty" json:"headless,omitempty"`
+	UiIp               string `yaml:"uiIp,omitempty" json:"uiIp,omitempty"`
+	UiPort             int    `yaml:"uiPort,omitempty" json:"uiPort,omitempty"`
+	UiPublicPath       string `yaml:"uiPublicPath,omitempty" json:"uiPublicPath,omitempty"`
+	UiAssetPath        string `yaml:"uiAssetPath,omitempty" json:"uiAssetPath,omitempty"`
+	UiCodecEndpoint    string `yaml:"uiCodecEndpoint,omitempty" json:"uiCodecEndpoint,omitempty"`
+	UiDisableNewsFetch bool   `yaml:"uiDisableNewsFetch,omitempty" json:"uiDisableNewsFetch,omitempty"`
+	LogLevel           string `yaml:"logLevel,omitempty" json:"logLevel,omitempty"`
+	LogConfig          bool   `yaml:"logConfig,omitempty" json:"logConfig,omitempty"`
+	DynamicConfigFile  string `yaml:"dynamicConfigFile,omitempty" json:"dynamicConfigFile,omitempty"`
+	DynamicConfigPort  int    `yaml:"dynamicConfigPort,omitempty" json:"dynamicConfigPort,omitempty"`
+	Seed               string `yaml:"seed,omitempty" json:"seed,omitempty"`
+	TlsAuto            bool   `yaml:"tlsAuto,omitempty" json:"tlsAuto,omitempty"`
+	TlsCert            string `yaml:"tlsCert,omitempty" json:"tlsCert,omitempty"`
+	TlsKey             string `yaml:"tlsKey,omitempty" json:"tlsKey,omitempty"`
+	TlsCa              string `yaml:"tlsCa,omitempty" json:"tlsCa,omitempty"`
+	ApiKey             bool   `yaml:"apiKey,omitempty" json:"apiKey,omitempty"`
+	Clusters           int    `yaml:"clusters,omitempty" json:"clusters,omitempty"`
+	// Only for a single cluster
+	ClusterId              string `yaml:"clusterId,omitempty" json:"clusterId,omitempty"`
+	ClusterName            string `yaml:"clusterName,omitempty" json:"clusterName,omitempty"`
+	InitialFailoverVersion int    `yaml:"initialFailoverVersion,omitempty" json:"initialFailoverVersion,omitempty"`
+	EnableGlobalNamespace  bool   `yaml:"enableGlobalNamespace,omitempty" json:"enableGlobalNamespace,omitempty"`
+
+	Namespaces       []*devServerNamespaceConfig `yaml:"namespaces,omitempty" json:"namespaces,omitempty"`
+	SearchAttributes map[string]string           `yaml:"searchAttributes,omitempty" json:"searchAttributes,omitempty"`
+	DynamicConfig    map[string]any              `yaml:"dynamicConfig,omitempty" json:"dynamicConfig,omitempty"`
+	SqlitePragmas    map[string]string           `yaml:"sqlitePragmas,omitempty" json:"sqlitePragmas,omitempty"`
+	AuthJwks         []string                    `yaml:"authJwks,omitempty" json:"authJwks,omitempty"`
+}
+
+type devServerNamespaceConfig struct {
+	Name      string `yaml:"name" json:"name"`
+	Retention string `yaml:"retention,omitempty" json:"retention,omitempty"`
+}
+
+// loadConfig reads the configuration file if any and overrides its values with
+// the options that are set. Options that are not set are only used when the
+// file has no value.
+func (t *TemporalServerStartDevCommand) loadConfig() (*devServerConfig, error) {
+	var cfg devServerConfig
+	if t.Config != "" {
+		if err := readManifest(t.Config, &cfg); err != nil {
+			return nil, err
+		}
+	}
+	flags := t.Command.Flags()
+	overrideConfig(flags, "ip", &cfg.Ip, t.Ip)
+	overrideConfig(flags, "port", &cfg.Port, t.Port)
+	overrideConfig(flags, "http-port", &cfg.HttpPort, t.HttpPort)
+	overrideConfig(flags, "metrics-port", &cfg.MetricsPort, t.MetricsPort)
+	overrideConfig(flags, "db-filename", &cfg.DbFilename, t.DbFilename)
+	overrideConfig(flags, "from-snapshot", &cfg.FromSnapshot, t.FromSnapshot)
+	overrideConfig(flags, "snapshot-dir", &cfg.SnapshotDir, t.SnapshotDir)
+	overrideConfig(flags, "headless", &cfg.Headless, t.Headless)
+	overrideConfig(flags, "ui-ip", &cfg.UiIp, t.UiIp)
+	overrideConfig(flags, "ui-port", &cfg.UiPort, t.UiPort)
+	overrideConfig(flags, "ui-public-path", &cfg.UiPublicPath, t.UiPublicPath)
+	overrideConfig(flags, "ui-asset-path", &cfg.UiAssetPath, t.UiAssetPath)
+	overrideConfig(flags, "ui-codec-endpoint", &cfg.UiCodecEndpoint, t.UiCodecEndpoint)
+	overrideConfig(flags, "ui-disable-news-fetch", &cfg.UiDisableNewsFetch, t.UiDisableNewsFetch)
+	overrideConfig(flags, "log-config", &cfg.LogConfig, t.LogConfig)
+	overrideConfig(flags, "dynamic-config-file", &cfg.DynamicConfigFile, t.DynamicConfigFile)
+	overrideConfig(flags, "dynamic-config-port", &cfg.DynamicConfigPort, t.DynamicConfigPort)
+	overrideConfig(flags, "seed", &cfg.Seed, t.Seed)
+	overrideConfig(flags, "tls-auto", &cfg.TlsAuto, t.TlsAuto)
+	overrideConfig(flags, "tls-cert", &cfg.TlsCert, t.TlsCert)
+	overrideConfig(flags, "tls-key", &cfg.TlsKey, t.TlsKey)
+	overrideConfig(flags, "tls-ca", &cfg.TlsCa, t.TlsCa)
+	overrideConfig(flags, "api-key", &cfg.ApiKey, t.ApiKey)
+	if flags.Changed("auth-jwks") {
+		cfg.AuthJwks = t.AuthJwks
+	}
+	overrideConfig(flags, "clusters", &cfg.Clusters, t.Clusters)
+	// The log level is a global option, so the file value is only used if the
+	// option is unset
+	if logLevel := t.Parent.Parent.LogLevel; logLevel.ChangedFromDefault || cfg.LogLevel == "" {
+		cfg.LogLevel = logLevel.Value
+		// Never changed uses "warn" instead of the CLI default of "info" since
+		// the server is noisier
+		if !logLevel.ChangedFromDefault {
+			cfg.LogLevel = "warn"
+		}
+	}
+
+	// Namespaces, and values with keys, from options are added to the file ones
+	for _, name := range t.Namespace {
+		if cfg.namespace(name) == nil {
+			cfg.Namespaces = append(cfg.Namespaces, &devServerNamespaceConfig{Name: name})
+		}
+	}
+	for _, ns := range cfg.Namespaces {
+		if ns.Name == "" {
+			return nil, fmt.Errorf("namespace missing name")
+		} else if _, err := ns.retention(); err != nil {
+			return nil, err
+		}
+	}
+	if searchAttrs, err := stringKeysValues(t.SearchAttribute); err != nil {
+		return nil, fmt.Errorf("invalid search attributes: %w", err)
+	} else if len(searchAttrs) > 0 {
+		cfg.SearchAttributes = mergeConfigValues(cfg.SearchAttributes, searchAttrs)
+	}
+	if pragmas, err := stringKeysValues(t.SqlitePragma); err != nil {
+		return nil, fmt.Errorf("invalid pragma: %w", err)
+	} else if len(pragmas) > 0 {
+		cfg.SqlitePragmas = mergeConfigValues(cfg.SqlitePragmas, pragmas)
+	}
+	dynConfig, err := dynamicConfigJSONValues(t.DynamicConfigValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dynamic config values: %w", err)
+	}
+	if len(dynConfig) > 0 {
+		cfg.DynamicConfig = mergeConfigValues(cfg.DynamicConfig, dynConfig)
+	}
+
+	if cfg.Clusters > 1 && (cfg.ClusterId != "" || cfg.ClusterName != "" || cfg.InitialFailoverVersion != 0) {
+		return nil, fmt.Errorf("cluster ID, name, and initial failover version cannot be set with multiple clusters")
+	}
+	return &cfg, nil
+}
+
+// overrideConfig sets the config value to the option value if the option is
+// set or the config has no value.
+func overrideConfig[T comparable](flags *pflag.FlagSet, name string, cfgValue *T, flagValue T) {
+	var zero T
+	if flags.Changed(name) || *cfgValue == zero {
+		*cfgValue = flagValue
+	}
+}
+
+func mergeConfigValues[V any](cfgValues, flagValues map[string]V) map[string]V {
+	if cfgValues == nil {
+		cfgValues = make(map[string]V, len(flagValues))
+	}
+	maps.Copy(cfgValues, flagValues)
+	return cfgValues
+}
+
+// dynamicConfigJSONValues parses `KEY=VALUE` pairs with JSON values for dynamic
+// config.
+func dynamicConfigJSONValues(s []string) (map[string]any, error) {
+	values, err := stringKeysJSONValues(s, true)
+	if err != nil {
+		return nil, err
+	}
+	// We have to convert all dynamic config values that JSON number to int if we
+	// can because server dynamic config expecting int won't work with the default
+	// float JSON unmarshal uses
+	for k, v := range values {
+		if num, ok := v.(json.Number); ok {
+			if newV, err := num.Int64(); err == nil {
+				// Dynamic config only accepts int type, not int32 nor int64
+				values[k] = int(newV)
+			} else if newV, err := num.Float64(); err == nil {
+				values[k] = newV
+			} else {
+				return nil, fmt.Errorf("invalid JSON value for key %q", k)
+			}
+		}
+	}
+	return values, nil
+}
+
+func (c *devServerConfig) namespace(name string) *devServerNamespaceConfig {
+	for _, ns := range c.Namespaces {
+		if ns.Name == name {
+			return ns
+		}
+	}
+	return nil
+}
+
+// namespaceRetention returns the retention of the Namespaces that set one.
+func (c *devServerConfig) namespaceRetention() map[string]time.Duration {
+	retention := map[string]time.Duration{}
+	for _, ns := range c.Namespaces {
+		if d, _ := ns.retention(); d > 0 {
+			retention[ns.Name] = d
+		}
+	}
+	return retention
+}
+
+func (n *devServerNamespaceConfig) retention() (time.Duration, error) {
+	if n.Retention == "" {
+		return 0, nil
+	}
+	d, err := cliext.ParseFlagDuration(n.Retention)
+	if err != nil {
+		return 0, fmt.Errorf("invalid retention for namespace %v: %w", n.Name, err)
+	}
+	return d, nil
+}
@@ -0,0 +1,97 @@
+package temporalcli
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/temporalio/cli/internal/devserver"
+	"github.com/temporalio/cli/internal/printer"
+)
+
+func (c *TemporalServerDynamicConfigGetCommand) run(cctx *CommandContext, args []string) error {
+	entries, err := devserver.GetDynamicConfig(cctx, c.Address, c.Key)
+	if err != nil {
+		return err
+	}
+	if cctx.JSONOutput {
+		return cctx.Printer.PrintStructured(entries, printer.StructuredOptions{})
+	}
+	if len(entries) == 0 && c.Key != "" {
+		cctx.Printer.Printlnf("%v has no value, the server uses its default", c.Key)
+		return nil
+	}
+	if err := printDynamicConfigEntries(cctx, entries); err != nil {
+		return err
+	}
+	if c.Key == "" {
+		cctx.Printer.Println()
+		cctx.Printer.Println("Keys not shown use the server default.")
+	}
+	return nil
+}
+
+func (c *TemporalServerDynamicConfigSetCommand) run(cctx *CommandContext, args []string) error {
+	parsed, err := dynamicConfigJSONValues([]string{c.Key + "=" + c.Value})
+	if err != nil {
+		return fmt.Errorf("invalid value: %w", err)
+	}
+	// Constraint values are JSON if they parse as such, like shard IDs, and
+	// strings otherwise, like most names
+	constraints := map[string]any{}
+	for _, constraint := range c.Constraint {
+		if v, err := dynamicConfigJSONValues([]string{constraint}); err == nil {
+			maps.Copy(constraints, v)
+		} else if pair, err := stringKeysValues([]string{constraint}); err == nil {
+			for k, v := range pair {
+				constraints[k] = v
+			}
+		} else {
+			return fmt.Errorf("invalid constraint: %w", err)
+		}
+	}
+	entries, err := devserver.SetDynamicConfig(cctx, c.Address, devserver.DynamicConfigUpdate{
+		Key:         c.Key,
+		Value:       parsed[c.Key],
+		Constraints: constraints,
+	})
+	if err != nil {
+		return err
+	}
+	if cctx.JSONOutput {
+		return cctx.Printer.PrintStructured(entries, printer.StructuredOptions{})
+	}
+	cctx.Printer.Printlnf("Set %v to %v, the server now uses:", c.Key, formatDynamicConfigJSON(parsed[c.Key]))
+	cctx.Printer.Println()
+	return printDynamicConfigEntries(cctx, entries)
+}
+
+func printDynamicConfigEntries(cctx *CommandContext, entries []devserver.DynamicConfigEntry) error {
+	type row struct {
+		Key         string
+		Value       string
+		Constraints string
+		Source      string
+	}
+	rows := make([]row, len(entries))
+	for i, entry := range entries {
+		rows[i] = row{Key: entry.Key, Value: formatDynamicConfigJSON(entry.Value), Source: entry.Source}
+		if len(entry.Constraints) > 0 {
+			rows[i].Constraints = formatDynamicConfigJSON(entry.Constraints)
+		}
+	}
+	return cctx.Printer.PrintStructured(rows, printer.StructuredOptions{Table: &printer.TableOptions{}})
+}
+
+// formatDynamicConfigJSON formats the value as JSON, with empty maps formatted
+// the same as nil.
+func formatDynamicConfigJSON(v any) string {
+	if m, ok := v.(map[string]any); ok && len(m) == 0 {
+		v = nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
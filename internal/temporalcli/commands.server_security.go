@@ -0,0 +1,148 @@
+package temporalcli
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"go.temporal.io/sdk/contrib/envconfig"
+
+	"github.com/temporalio/cli/internal/devserver"
+)
+
+// devServerSecurity is the TLS and auth of the frontend along with generated
+// files and keys that clients need to connect.
+type devServerSecurity struct {
+	tls  *devserver.TLSOptions
+	auth *devserver.AuthOptions
+	// Only set with --tls-auto
+	generatedTLS *devserver.GeneratedTLSFiles
+	generatedDir string
+	// Only set with --api-key
+	apiKey string
+}
+
+// buildSecurity validates the TLS and auth config, generating certificates and
+// keys as needed. The returned security must be cleaned up.
+func (c *devServerConfig) buildSecurity() (*devServerSecurity, error) {
+	var sec devServerSecurity
+	if c.TlsAuto && (c.TlsCert != "" || c.TlsKey != "" || c.TlsCa != "") {
+		return nil, fmt.Errorf("cannot use TLS auto with TLS certificate, key, or CA")
+	} else if (c.TlsCert == "") != (c.TlsKey == "") {
+		return nil, fmt.Errorf("TLS certificate and key must both be set")
+	} else if c.TlsCa != "" && c.TlsCert == "" {
+		return nil, fmt.Errorf("TLS CA requires TLS certificate and key")
+	} else if c.Clusters > 1 && (c.TlsAuto || c.TlsCert != "" || c.ApiKey || len(c.AuthJwks) > 0) {
+		return nil, fmt.Errorf("TLS and auth are not supported with multiple clusters")
+	}
+
+	if c.TlsAuto {
+		dir, err := os.MkdirTemp("", "temporal-dev-tls-")
+		if err != nil {
+			return nil, fmt.Errorf("failed creating TLS dir: %w", err)
+		}
+		sec.generatedDir = dir
+		hosts := []string{"localhost", "127.0.0.1", "::1"}
+		if c.Ip != "" && !slices.Contains(hosts, c.Ip) {
+			hosts = append(hosts, c.Ip)
+		}
+		if hostname, err := os.Hostname(); err == nil && !slices.Contains(hosts, hostname) {
+			hosts = append(hosts, hostname)
+		}
+		if sec.generatedTLS, err = devserver.GenerateTLSFiles(dir, hosts); err != nil {
+			sec.cleanup()
+			return nil, fmt.Errorf("failed generating TLS certificates: %w", err)
+		}
+		sec.tls = &devserver.TLSOptions{
+			CertFile:     sec.generatedTLS.ServerCertFile,
+			KeyFile:      sec.generatedTLS.ServerKeyFile,
+			ClientCAFile: sec.generatedTLS.CAFile,
+		}
+	} else if c.TlsCert != "" {
+		sec.tls = &devserver.TLSOptions{CertFile: c.TlsCert, KeyFile: c.TlsKey, ClientCAFile: c.TlsCa}
+	}
+
+	if c.ApiKey || len(c.AuthJwks) > 0 {
+		sec.auth = &devserver.AuthOptions{KeySourceURIs: c.AuthJwks}
+	}
+	if c.ApiKey {
+		key, err := devserver.GenerateSigningKey()
+		if err != nil {
+			sec.cleanup()
+			return nil, err
+		}
+		sec.auth.SigningKey = key
+		if sec.apiKey, err = key.APIKey("temporal-dev-server", "temporal-system:admin"); err != nil {
+			sec.cleanup()
+			return nil, fmt.Errorf("failed creating API key: %w", err)
+		}
+	}
+	return &sec, nil
+}
+
+func (s *devServerSecurity) cleanup() {
+	if s.generatedDir != "" {
+		_ = os.RemoveAll(s.generatedDir)
+	}
+}
+
+// printBanner prints the TLS and auth lines of the banner.
+func (s *devServerSecurity) printBanner(cctx *CommandContext) {
+	if s.tls != nil {
+		mode := "TLS"
+		if s.tls.ClientCAFile != "" {
+			mode = "mTLS"
+		}
+		if s.generatedTLS != nil {
+			mode += " (certificates in " + s.generatedDir + ")"
+		}
+		cctx.Printer.Printlnf("%-21s %v", "Temporal TLS:", mode)
+	}
+	if s.auth != nil {
+		cctx.Printer.Printlnf("%-21s %v", "Temporal Auth:", "JWT")
+	}
+	if s.apiKey != "" {
+		cctx.Printer.Printlnf("%-21s %v", "Temporal API Key:", s.apiKey)
+	}
+}
+
+// printClientConfig prints the client options and profile to connect to the
+// frontend, if anything was generated for clients.
+func (s *devServerSecurity) printClientConfig(cctx *CommandContext, address string) error {
+	if s.generatedTLS == nil && s.apiKey == "" {
+		return nil
+	}
+	profile := &envconfig.ClientConfigProfile{Address: address, Namespace: "default", APIKey: s.apiKey}
+	flags := []string{"--address", address}
+	switch {
+	case s.generatedTLS != nil:
+		profile.TLS = &envconfig.ClientConfigTLS{
+			ServerCACertPath: s.generatedTLS.CAFile,
+			ClientCertPath:   s.generatedTLS.ClientCertFile,
+			ClientKeyPath:    s.generatedTLS.ClientKeyFile,
+		}
+		flags = append(flags,
+			"--tls-ca-path", s.generatedTLS.CAFile,
+			"--tls-cert-path", s.generatedTLS.ClientCertFile,
+			"--tls-key-path", s.generatedTLS.ClientKeyFile)
+	case s.tls != nil:
+		profile.TLS = &envconfig.ClientConfigTLS{}
+		flags = append(flags, "--tls")
+	default:
+		// API keys enable TLS unless disabled
+		profile.TLS = &envconfig.ClientConfigTLS{Disabled: true}
+		flags = append(flags, "--tls=false")
+	}
+	if s.apiKey != "" {
+		flags = append(flags, "--api-key", s.apiKey)
+	}
+	conf := envconfig.ClientConfig{Profiles: map[string]*envconfig.ClientConfigProfile{"dev-server": profile}}
+	b, err := conf.ToTOML(envconfig.ClientConfigToTOMLOptions{})
+	if err != nil {
+		return fmt.Errorf("failed building client config profile: %w", err)
+	}
+	cctx.Printer.Printlnf("\nConnect with these client options:\n  %v", strings.Join(flags, " "))
+	cctx.Printer.Printlnf("\nOr with this client config profile:\n%s", b)
+	return nil
+}
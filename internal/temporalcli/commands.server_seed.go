@@ -0,0 +1,242 @@
+package temporalcli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/temporalio/cli/cliext"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+)
+
+// devServerSeed is the start-dev seed file, applied once the server is ready.
+type devServerSeed struct {
+	Namespaces     []*devServerSeedNamespace        `yaml:"namespaces,omitempty" json:"namespaces,omitempty"`
+	NexusEndpoints []*operatorManifestNexusEndpoint `yaml:"nexusEndpoints,omitempty" json:"nexusEndpoints,omitempty"`
+}
+
+// devServerSeedNamespace has the same namespace fields as operator manifests
+// plus the resources created in the namespace.
+type devServerSeedNamespace struct {
+	operatorManifestNamespace `yaml:",inline"`
+	SearchAttributes          map[string]string        `yaml:"searchAttributes,omitempty" json:"searchAttributes,omitempty"`
+	Schedules                 []*scheduleManifestEntry `yaml:"schedules,omitempty" json:"schedules,omitempty"`
+	Workflows                 []*manifestWorkflow      `yaml:"workflows,omitempty" json:"workflows,omitempty"`
+}
+
+// readDevServerSeed reads and validates the seed file so invalid entries fail
+// before the server starts.
+func readDevServerSeed(file string) (*devServerSeed, error) {
+	var seed devServerSeed
+	if err := readManifest(file, &seed); err != nil {
+		return nil, err
+	}
+	seenNamespaces := map[string]bool{}
+	for i, ns := range seed.Namespaces {
+		if ns == nil || ns.Name == "" {
+			return nil, fmt.Errorf("namespace at index %v missing name", i)
+		} else if seenNamespaces[ns.Name] {
+			return nil, fmt.Errorf("namespace %q defined more than once", ns.Name)
+		}
+		seenNamespaces[ns.Name] = true
+		seenSchedules := map[string]bool{}
+		for i, entry := range ns.Schedules {
+			if entry == nil || entry.ID == "" {
+				return nil, fmt.Errorf("schedule at index %v in namespace %v missing id", i, ns.Name)
+			} else if seenSchedules[entry.ID] {
+				return nil, fmt.Errorf("schedule %q in namespace %v defined more than once", entry.ID, ns.Name)
+			}
+			seenSchedules[entry.ID] = true
+			if _, err := entry.scheduleOptions(); err != nil {
+				return nil, fmt.Errorf("invalid schedule %q in namespace %v: %w", entry.ID, ns.Name, err)
+			}
+		}
+		for i, w := range ns.Workflows {
+			if w == nil {
+				return nil, fmt.Errorf("workflow at index %v in namespace %v is empty", i, ns.Name)
+			} else if _, _, err := w.startWorkflowOptions(); err != nil {
+				return nil, fmt.Errorf("invalid workflow at index %v in namespace %v: %w", i, ns.Name, err)
+			}
+		}
+	}
+	seenEndpoints := map[string]bool{}
+	for i, ep := range seed.NexusEndpoints {
+		if ep == nil || ep.Name == "" {
+			return nil, fmt.Errorf("nexus endpoint at index %v missing name", i)
+		} else if seenEndpoints[ep.Name] {
+			return nil, fmt.Errorf("nexus endpoint %q defined more than once", ep.Name)
+		}
+		seenEndpoints[ep.Name] = true
+	}
+	return &seed, nil
+}
+
+// devServerSeeder applies a seed file to a started server, reporting every
+// failure instead of stopping at the first one.
+type devServerSeeder struct {
+	cctx    *CommandContext
+	address string
+
+	failures int
+}
+
+// applyDevServerSeed applies the seed to the server at the address and fails
+// if any resource could not be created.
+func applyDevServerSeed(cctx *CommandContext, address string, seed *devServerSeed, file string) error {
+	s := &devServerSeeder{cctx: cctx, address: address}
+	for _, ns := range seed.Namespaces {
+		s.seedNamespace(ns)
+	}
+	// Endpoints are last since they usually target the namespaces above
+	if len(seed.NexusEndpoints) > 0 {
+		s.seedNexusEndpoints(seed.NexusEndpoints)
+	}
+	if s.failures > 0 {
+		return fmt.Errorf("failed seeding %v resource(s) from %v", s.failures, file)
+	}
+	return nil
+}
+
+func (s *devServerSeeder) fail(resource string, err error) {
+	s.failures++
+	s.cctx.Printer.Printlnf("Failed seeding %v: %v", resource, err)
+}
+
+// dial connects to the server, ignoring client config profiles which are for
+// other servers.
+func (s *devServerSeeder) dial(namespace string) (client.Client, error) {
+	return client.DialContext(s.cctx, client.Options{
+		HostPort:  s.address,
+		Namespace: namespace,
+		Logger:    log.NewStructuredLogger(s.cctx.Logger),
+		// Same as dialClient, so workflow input can be raw values
+		DataConverter: DataConverterWithRawValue,
+	})
+}
+
+func (s *devServerSeeder) seedNamespace(ns *devServerSeedNamespace) {
+	resource := "namespace/" + ns.Name
+	cl, err := s.dial(ns.Name)
+	if err != nil {
+		s.fail(resource, err)
+		return
+	}
+	defer cl.Close()
+
+	p := &operatorApplyPlanner{cctx: s.cctx, cl: cl, clientOptions: cliext.ClientOptions{Namespace: ns.Name}}
+	p.plan.Namespace = ns.Name
+	if err := p.planNamespace(&ns.operatorManifestNamespace); err != nil {
+		s.fail(resource, err)
+		return
+	} else if err := p.planSearchAttributes(ns.SearchAttributes); err != nil {
+		s.fail(resource, err)
+		return
+	}
+	for i, step := range p.steps {
+		if err := p.applyStep(step); err != nil {
+			s.fail(resource, err)
+			// Nothing else can be created without the namespace
+			if i == 0 && p.createNamespace {
+				return
+			}
+			continue
+		}
+		s.cctx.Printer.Println(step.done)
+	}
+
+	// Also retried while a created namespace is not yet known everywhere
+	for _, entry := range ns.Schedules {
+		resource := "schedule/" + ns.Name + "/" + entry.ID
+		if err := p.applyStep(&operatorApplyStep{apply: func() error { return s.seedSchedule(cl, entry) }}); err != nil {
+			s.fail(resource, err)
+		}
+	}
+	for _, w := range ns.Workflows {
+		resource := "workflow/" + ns.Name + "/" + w.ID
+		if err := p.applyStep(&operatorApplyStep{apply: func() error { return s.seedWorkflow(cl, ns.Name, w) }}); err != nil {
+			s.fail(resource, err)
+		}
+	}
+}
+
+// seedSchedule creates the schedule if it does not exist. Existing schedules
+// are left as-is since they may have been changed on purpose.
+func (s *devServerSeeder) seedSchedule(cl client.Client, entry *scheduleManifestEntry) error {
+	opts, err := entry.scheduleOptions()
+	if err != nil {
+		return err
+	}
+	_, err = cl.ScheduleClient().Create(s.cctx, opts)
+	if errors.Is(err, temporal.ErrScheduleAlreadyRunning) {
+		s.cctx.Printer.Printlnf("Schedule %v already exists", entry.ID)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	s.cctx.Printer.Printlnf("Created schedule %v", entry.ID)
+	return nil
+}
+
+// seedWorkflow starts the workflow. One already running with the same ID is
+// not started again.
+func (s *devServerSeeder) seedWorkflow(cl client.Client, namespace string, w *manifestWorkflow) error {
+	opts, input, err := w.startWorkflowOptions()
+	if err != nil {
+		return err
+	}
+	run, err := cl.ExecuteWorkflow(s.cctx, opts, w.Type, input...)
+	if err != nil {
+		return err
+	}
+	s.cctx.Printer.Printlnf("Started workflow %v in namespace %v (run ID %v)", run.GetID(), namespace, run.GetRunID())
+	return nil
+}
+
+func (s *devServerSeeder) seedNexusEndpoints(endpoints []*operatorManifestNexusEndpoint) {
+	cl, err := s.dial("default")
+	if err != nil {
+		s.fail("nexus-endpoints", err)
+		return
+	}
+	defer cl.Close()
+	p := &operatorApplyPlanner{cctx: s.cctx, cl: cl, clientOptions: cliext.ClientOptions{Namespace: "default"}}
+	if err := p.planNexusEndpoints(endpoints); err != nil {
+		s.fail("nexus-endpoints", err)
+		return
+	}
+	for _, step := range p.steps {
+		if err := step.apply(); err != nil {
+			s.fail("nexus-endpoints", err)
+			continue
+		}
+		s.cctx.Printer.Println(step.done)
+	}
+}
+
+// scheduleOptions converts the entry the same way schedule create does.
+func (e *scheduleManifestEntry) scheduleOptions() (client.ScheduleOptions, error) {
+	create, err := e.toCreateCommand()
+	if err != nil {
+		return client.ScheduleOptions{}, err
+	}
+	return create.scheduleOptions()
+}
+
+// startWorkflowOptions converts the workflow the same way workflow start does.
+func (w *manifestWorkflow) startWorkflowOptions() (client.StartWorkflowOptions, []any, error) {
+	var sw SharedWorkflowStartOptions
+	var inputOpts PayloadInputOptions
+	if err := w.setStartOptions(&sw, &inputOpts, ""); err != nil {
+		return client.StartWorkflowOptions{}, nil, err
+	}
+	opts, err := buildStartOptions(&sw, &WorkflowStartOptions{})
+	if err != nil {
+		return opts, nil, err
+	}
+	input, err := inputOpts.buildRawInput()
+	if err != nil {
+		return opts, nil, err
+	}
+	return opts, input, nil
+}
@@ -0,0 +1,114 @@
+package temporalcli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/temporalio/cli/internal/devserver"
+	"github.com/temporalio/cli/internal/printer"
+)
+
+const snapshotFileExt = ".db"
+
+type serverSnapshot struct {
+	Name string    `json:"name"`
+	Size int64     `json:"size"`
+	Time time.Time `json:"time"`
+}
+
+func (c *TemporalServerSnapshotSaveCommand) run(cctx *CommandContext, args []string) error {
+	file, err := c.snapshotFile(c.Name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(file); err == nil && !c.Overwrite {
+		return fmt.Errorf("snapshot %q already exists, use --overwrite to replace it", c.Name)
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return fmt.Errorf("failed creating snapshot dir: %w", err)
+	}
+	if err := devserver.BackupDatabase(cctx, c.DbFilename, file); err != nil {
+		return fmt.Errorf("failed saving snapshot: %w", err)
+	}
+	cctx.Printer.Printlnf("Saved snapshot %v of %v", c.Name, c.DbFilename)
+	return nil
+}
+
+func (c *TemporalServerSnapshotRestoreCommand) run(cctx *CommandContext, args []string) error {
+	file, err := c.existingSnapshotFile(c.Name)
+	if err != nil {
+		return err
+	}
+	if err := devserver.RestoreDatabase(cctx, file, c.DbFilename); err != nil {
+		return fmt.Errorf("failed restoring snapshot: %w", err)
+	}
+	cctx.Printer.Printlnf("Restored snapshot %v to %v", c.Name, c.DbFilename)
+	return nil
+}
+
+func (c *TemporalServerSnapshotListCommand) run(cctx *CommandContext, args []string) error {
+	dir, err := c.snapshotDir()
+	if err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed reading snapshot dir: %w", err)
+	}
+	snapshots := []*serverSnapshot{}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), snapshotFileExt)
+		if !ok || entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("failed reading snapshot %q: %w", name, err)
+		}
+		snapshots = append(snapshots, &serverSnapshot{Name: name, Size: info.Size(), Time: info.ModTime()})
+	}
+	slices.SortFunc(snapshots, func(a, b *serverSnapshot) int { return strings.Compare(a.Name, b.Name) })
+	if len(snapshots) == 0 && !cctx.JSONOutput {
+		cctx.Printer.Printlnf("No snapshots in %v", dir)
+		return nil
+	}
+	return cctx.Printer.PrintStructured(snapshots, printer.StructuredOptions{Table: &printer.TableOptions{}})
+}
+
+func (o *ServerSnapshotOptions) snapshotDir() (string, error) {
+	if o.SnapshotDir != "" {
+		return o.SnapshotDir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed getting home dir for snapshots, set --snapshot-dir instead: %w", err)
+	}
+	return filepath.Join(home, ".config", "temporalio", "snapshots"), nil
+}
+
+func (o *ServerSnapshotOptions) snapshotFile(name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid snapshot name %q", name)
+	}
+	dir, err := o.snapshotDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+snapshotFileExt), nil
+}
+
+func (o *ServerSnapshotOptions) existingSnapshotFile(name string) (string, error) {
+	file, err := o.snapshotFile(name)
+	if err != nil {
+		return "", err
+	} else if _, err := os.Stat(file); os.IsNotExist(err) {
+		return "", fmt.Errorf("snapshot %q not found", name)
+	} else if err != nil {
+		return "", fmt.Errorf("failed checking snapshot %q: %w", name, err)
+	}
+	return file, nil
+}
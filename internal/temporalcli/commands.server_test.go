@@ -2,21 +2,31 @@ package temporalcli_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/temporalio/cli/internal/devserver"
 	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/temporal"
 )
@@ -409,6 +419,101 @@ func TestServer_StartDev_BannerPersistenceFile(t *testing.T) {
 	h.Contains(out, dbFilename)
 }
 
+func TestServer_Snapshot(t *testing.T) {
+	h := NewCommandHarness(t)
+	defer h.Close()
+	snapshotDir := t.TempDir()
+	dbFilename := filepath.Join(os.TempDir(), "devserver-snapshot-"+uuid.NewString()+".sqlite")
+	t.Cleanup(func() {
+		_ = os.Remove(dbFilename)
+		_ = os.Remove(dbFilename + "-shm")
+		_ = os.Remove(dbFilename + "-wal")
+	})
+
+	// Runs the server until the callback returns
+	runServer := func(callback func(cl client.Client), args ...string) *CommandResult {
+		port := strconv.Itoa(devserver.MustGetFreePort("127.0.0.1"))
+		httpPort := strconv.Itoa(devserver.MustGetFreePort("127.0.0.1"))
+		ctx, cancel := context.WithCancel(context.Background())
+		resCh := make(chan *CommandResult, 1)
+		go func() {
+			resCh <- h.ExecuteWithContext(ctx, append([]string{"server", "start-dev", "-p", port,
+				"--http-port", httpPort, "--headless", "--snapshot-dir", snapshotDir}, args...)...)
+		}()
+		var cl client.Client
+		h.EventuallyWithT(func(t *assert.CollectT) {
+			select {
+			case res := <-resCh:
+				require.NoError(t, res.Err)
+				require.Fail(t, "got early server result")
+			default:
+			}
+			var err error
+			cl, err = client.Dial(client.Options{HostPort: "127.0.0.1:" + port})
+			assert.NoError(t, err)
+		}, 30*time.Second, 200*time.Millisecond)
+		callback(cl)
+		cl.Close()
+		cancel()
+		select {
+		case <-time.After(20 * time.Second):
+			h.FailNow("didn't cleanup after 20 seconds")
+		case res := <-resCh:
+			h.NoError(res.Err)
+			return res
+		}
+		return nil
+	}
+	startWorkflow := func(cl client.Client, id string) {
+		_, err := cl.ExecuteWorkflow(context.Background(),
+			client.StartWorkflowOptions{ID: id, TaskQueue: "my-task-queue"}, "MyWorkflow")
+		h.NoError(err)
+	}
+	workflowExists := func(cl client.Client, id string) bool {
+		_, err := cl.DescribeWorkflowExecution(context.Background(), id, "")
+		return err == nil
+	}
+
+	// Save while the server is running, then make more changes
+	runServer(func(cl client.Client) {
+		startWorkflow(cl, "before-snapshot")
+		res := h.Execute("server", "snapshot", "save", "--snapshot-dir", snapshotDir,
+			"--db-filename", dbFilename, "--name", "fixtures")
+		h.NoError(res.Err)
+		h.Contains(res.Stdout.String(), "Saved snapshot fixtures")
+		startWorkflow(cl, "after-snapshot")
+	}, "--db-filename", dbFilename)
+
+	// Saving again requires overwrite
+	res := h.Execute("server", "snapshot", "save", "--snapshot-dir", snapshotDir,
+		"--db-filename", dbFilename, "--name", "fixtures")
+	h.ErrorContains(res.Err, "already exists")
+
+	res = h.Execute("server", "snapshot", "list", "--snapshot-dir", snapshotDir, "-o", "json")
+	h.NoError(res.Err)
+	h.Contains(res.Stdout.String(), `"name": "fixtures"`)
+
+	// Start from the snapshot without a DB file
+	res = runServer(func(cl client.Client) {
+		h.True(workflowExists(cl, "before-snapshot"))
+		h.False(workflowExists(cl, "after-snapshot"))
+	}, "--from-snapshot", "fixtures")
+	h.Contains(res.Stdout.String(), "snapshot fixtures (not saved)")
+
+	// Restore into the DB file
+	res = h.Execute("server", "snapshot", "restore", "--snapshot-dir", snapshotDir,
+		"--db-filename", dbFilename, "--name", "fixtures")
+	h.NoError(res.Err)
+	runServer(func(cl client.Client) {
+		h.True(workflowExists(cl, "before-snapshot"))
+		h.False(workflowExists(cl, "after-snapshot"))
+	}, "--db-filename", dbFilename)
+
+	res = h.Execute("server", "snapshot", "restore", "--snapshot-dir", snapshotDir,
+		"--db-filename", dbFilename, "--name", "missing")
+	h.ErrorContains(res.Err, `snapshot "missing" not found`)
+}
+
 type testLogger struct {
 	t *testing.T
 }
@@ -428,3 +533,501 @@ func (l testLogger) Warn(msg string, keysAndValues ...interface{}) {
 func (l testLogger) Error(msg string, keysAndValues ...interface{}) {
 	l.t.Logf("ERROR: "+msg, keysAndValues...)
 }
+
+func TestServer_StartDev_MultiCluster(t *testing.T) {
+	h := NewCommandHarness(t)
+	defer h.Close()
+
+	// Clusters use consecutive ports
+	var port int
+	h.EventuallyWithT(func(t *assert.CollectT) {
+		port = devserver.MustGetFreePort("127.0.0.1")
+		assert.NoError(t, devserver.CheckPortFree("127.0.0.1", port+1))
+	}, 5*time.Second, 10*time.Millisecond)
+	address1 := "127.0.0.1:" + strconv.Itoa(port)
+	address2 := "127.0.0.1:" + strconv.Itoa(port+1)
+	resCh := make(chan *CommandResult, 1)
+	go func() {
+		resCh <- h.Execute("server", "start-dev", "-p", strconv.Itoa(port), "--headless",
+			"--clusters", "2", "-n", "replicated")
+	}()
+
+	// Wait until the global namespace is replicated to the second cluster
+	var cl2 client.Client
+	h.EventuallyWithT(func(t *assert.CollectT) {
+		select {
+		case res := <-resCh:
+			require.NoError(t, res.Err)
+			require.Fail(t, "got early server result")
+		default:
+		}
+		if cl2 == nil {
+			var err error
+			if cl2, err = client.Dial(client.Options{HostPort: address2}); !assert.NoError(t, err) {
+				return
+			}
+		}
+		resp, err := cl2.WorkflowService().DescribeNamespace(context.Background(),
+			&workflowservice.DescribeNamespaceRequest{Namespace: "replicated"})
+		if assert.NoError(t, err) {
+			assert.True(t, resp.IsGlobalNamespace)
+			assert.Equal(t, "cluster-1", resp.ReplicationConfig.ActiveClusterName)
+			assert.Len(t, resp.ReplicationConfig.Clusters, 2)
+		}
+	}, 60*time.Second, 200*time.Millisecond)
+	defer cl2.Close()
+
+	// Clusters are connected to each other
+	res := h.Execute("operator", "cluster", "list", "--address", address1, "-o", "json")
+	h.NoError(res.Err)
+	h.Contains(res.Stdout.String(), "cluster-2")
+
+	// Failover replicates to the second cluster
+	res = h.Execute("operator", "namespace", "update", "--address", address1,
+		"-n", "replicated", "--active-cluster", "cluster-2")
+	h.NoError(res.Err)
+	h.EventuallyWithT(func(t *assert.CollectT) {
+		resp, err := cl2.WorkflowService().DescribeNamespace(context.Background(),
+			&workflowservice.DescribeNamespaceRequest{Namespace: "replicated"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "cluster-2", resp.ReplicationConfig.ActiveClusterName)
+		}
+	}, 30*time.Second, 200*time.Millisecond)
+
+	h.CancelContext()
+	select {
+	case <-time.After(20 * time.Second):
+		h.Fail("didn't cleanup after 20 seconds")
+	case res = <-resCh:
+		h.NoError(res.Err)
+	}
+	h.Contains(res.Stdout.String(), "Temporal Cluster:     cluster-2")
+	h.Contains(res.Stdout.String(), address2[len("127.0.0.1"):])
+
+	res = h.Execute("server", "start-dev", "--clusters", "10")
+	h.ErrorContains(res.Err, "clusters must be between 1 and 9")
+}
+
+func TestServer_StartDev_Config(t *testing.T) {
+	h := NewCommandHarness(t)
+	defer h.Close()
+
+	port := strconv.Itoa(devserver.MustGetFreePort("127.0.0.1"))
+	configFile := filepath.Join(t.TempDir(), "dev-server.yaml")
+	require.NoError(t, os.WriteFile(configFile, []byte(`
+port: 1234
+headless: true
+logLevel: error
+namespaces:
+  - name: orders
+    retention: 7d
+searchAttributes:
+  ConfigKeyword: Keyword
+dynamicConfig:
+  limit.maxIDLength: 500
+sqlitePragmas:
+  journal_mode: wal
+`), 0644))
+
+	// Options override file values
+	res := h.Execute("server", "start-dev", "--config", configFile, "-p", port,
+		"-n", "payments", "--dynamic-config-value", "limit.maxIDLength=400", "--print-config", "-o", "json")
+	require.NoError(t, res.Err)
+	var cfg struct {
+		Port       int    `json:"port"`
+		Headless   bool   `json:"headless"`
+		LogLevel   string `json:"logLevel"`
+		Namespaces []struct {
+			Name      string `json:"name"`
+			Retention string `json:"retention"`
+		} `json:"namespaces"`
+		DynamicConfig map[string]any    `json:"dynamicConfig"`
+		SqlitePragmas map[string]string `json:"sqlitePragmas"`
+	}
+	require.NoError(t, json.Unmarshal(res.Stdout.Bytes(), &cfg))
+	require.Equal(t, port, strconv.Itoa(cfg.Port))
+	require.True(t, cfg.Headless)
+	require.Equal(t, "error", cfg.LogLevel)
+	require.Len(t, cfg.Namespaces, 2)
+	require.Equal(t, "7d", cfg.Namespaces[0].Retention)
+	require.Equal(t, "payments", cfg.Namespaces[1].Name)
+	require.Equal(t, float64(400), cfg.DynamicConfig["limit.maxIDLength"])
+	require.Equal(t, "wal", cfg.SqlitePragmas["journal_mode"])
+
+	// Start from the file
+	resCh := make(chan *CommandResult, 1)
+	go func() {
+		resCh <- h.Execute("server", "start-dev", "--config", configFile, "-p", port)
+	}()
+	var cl client.Client
+	h.EventuallyWithT(func(t *assert.CollectT) {
+		select {
+		case res := <-resCh:
+			require.NoError(t, res.Err)
+			require.Fail(t, "got early server result")
+		default:
+		}
+		var err error
+		cl, err = client.Dial(client.Options{HostPort: "127.0.0.1:" + port})
+		assert.NoError(t, err)
+	}, 3*time.Second, 200*time.Millisecond)
+	defer cl.Close()
+
+	nsResp, err := cl.WorkflowService().DescribeNamespace(context.Background(),
+		&workflowservice.DescribeNamespaceRequest{Namespace: "orders"})
+	require.NoError(t, err)
+	require.Equal(t, 7*24*time.Hour, nsResp.Config.WorkflowExecutionRetentionTtl.AsDuration())
+	saResp, err := cl.OperatorService().ListSearchAttributes(context.Background(),
+		&operatorservice.ListSearchAttributesRequest{Namespace: "orders"})
+	require.NoError(t, err)
+	require.Contains(t, saResp.CustomAttributes, "ConfigKeyword")
+
+	h.CancelContext()
+	select {
+	case <-time.After(20 * time.Second):
+		h.Fail("didn't cleanup after 20 seconds")
+	case res = <-resCh:
+		h.NoError(res.Err)
+	}
+	h.NotContains(res.Stdout.String(), "Temporal UI:")
+
+	// Unknown keys are rejected
+	require.NoError(t, os.WriteFile(configFile, []byte("unknown: true\n"), 0644))
+	res = h.Execute("server", "start-dev", "--config", configFile, "--print-config")
+	require.ErrorContains(t, res.Err, "field unknown not found")
+}
+
+func TestServer_StartDev_Seed(t *testing.T) {
+	h := NewCommandHarness(t)
+	defer h.Close()
+
+	port := strconv.Itoa(devserver.MustGetFreePort("127.0.0.1"))
+	seedFile := filepath.Join(t.TempDir(), "seed.yaml")
+	require.NoError(t, os.WriteFile(seedFile, []byte(`
+namespaces:
+  - name: orders
+    description: Order processing
+    retention: 3d
+    searchAttributes:
+      CustomerId: Keyword
+    schedules:
+      - id: nightly-report
+        cron: ["0 0 * * *"]
+        workflow:
+          type: ReportWorkflow
+          taskQueue: reports
+    workflows:
+      - id: order-1
+        type: OrderWorkflow
+        taskQueue: orders
+        input: [{"customerId": "c-1"}]
+        searchAttributes:
+          CustomerId: c-1
+nexusEndpoints:
+  - name: orders-endpoint
+    targetNamespace: orders
+    targetTaskQueue: orders-nexus
+`), 0644))
+
+	resCh := make(chan *CommandResult, 1)
+	go func() {
+		resCh <- h.Execute("server", "start-dev", "-p", port, "--headless", "--seed", seedFile)
+	}()
+	var cl client.Client
+	h.EventuallyWithT(func(t *assert.CollectT) {
+		select {
+		case res := <-resCh:
+			require.NoError(t, res.Err)
+			require.Fail(t, "got early server result")
+		default:
+		}
+		var err error
+		cl, err = client.Dial(client.Options{HostPort: "127.0.0.1:" + port, Namespace: "orders"})
+		assert.NoError(t, err)
+	}, 3*time.Second, 200*time.Millisecond)
+	defer cl.Close()
+
+	// Seeding may still be running once the server accepts connections
+	h.EventuallyWithT(func(t *assert.CollectT) {
+		resp, err := cl.OperatorService().ListNexusEndpoints(context.Background(),
+			&operatorservice.ListNexusEndpointsRequest{Name: "orders-endpoint"})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetEndpoints(), 1)
+	}, 10*time.Second, 200*time.Millisecond)
+
+	nsResp, err := cl.WorkflowService().DescribeNamespace(context.Background(),
+		&workflowservice.DescribeNamespaceRequest{Namespace: "orders"})
+	require.NoError(t, err)
+	require.Equal(t, "Order processing", nsResp.NamespaceInfo.Description)
+	require.Equal(t, 3*24*time.Hour, nsResp.Config.WorkflowExecutionRetentionTtl.AsDuration())
+	saResp, err := cl.OperatorService().ListSearchAttributes(context.Background(),
+		&operatorservice.ListSearchAttributesRequest{Namespace: "orders"})
+	require.NoError(t, err)
+	require.Contains(t, saResp.CustomAttributes, "CustomerId")
+	desc, err := cl.ScheduleClient().GetHandle(context.Background(), "nightly-report").Describe(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "ReportWorkflow", desc.Schedule.Action.(*client.ScheduleWorkflowAction).Workflow)
+	wfDesc, err := cl.DescribeWorkflowExecution(context.Background(), "order-1", "")
+	require.NoError(t, err)
+	require.Equal(t, "OrderWorkflow", wfDesc.WorkflowExecutionInfo.Type.Name)
+	require.Contains(t, wfDesc.WorkflowExecutionInfo.SearchAttributes.IndexedFields, "CustomerId")
+
+	h.CancelContext()
+	var res *CommandResult
+	select {
+	case <-time.After(20 * time.Second):
+		h.Fail("didn't cleanup after 20 seconds")
+	case res = <-resCh:
+		h.NoError(res.Err)
+	}
+	h.Contains(res.Stdout.String(), "Created namespace/orders")
+	h.Contains(res.Stdout.String(), "Created schedule nightly-report")
+	h.Contains(res.Stdout.String(), "Started workflow order-1 in namespace orders")
+
+	// Invalid seed files fail before start
+	require.NoError(t, os.WriteFile(seedFile, []byte(`
+namespaces:
+  - name: orders
+    workflows:
+      - type: OrderWorkflow
+`), 0644))
+	res = h.Execute("server", "start-dev", "-p", port, "--headless", "--seed", seedFile)
+	require.ErrorContains(t, res.Err, "invalid workflow at index 0 in namespace orders: missing taskQueue")
+
+	// Failures are reported and stop the server
+	require.NoError(t, os.WriteFile(seedFile, []byte(`
+namespaces:
+  - name: orders
+    workflows:
+      - id: order-1
+        type: OrderWorkflow
+        taskQueue: orders
+        searchAttributes:
+          NotRegistered: value
+`), 0644))
+	// The first context is canceled
+	h2 := NewCommandHarness(t)
+	defer h2.Close()
+	res = h2.Execute("server", "start-dev", "-p", port, "--headless", "--seed", seedFile)
+	require.ErrorContains(t, res.Err, "failed seeding 1 resource(s)")
+	require.Contains(t, res.Stdout.String(), "Failed seeding workflow/orders/order-1")
+}
+
+func TestServer_StartDev_TLSAuth(t *testing.T) {
+	h := NewCommandHarness(t)
+	defer h.Close()
+
+	// Certificates and a JWKS with a key to sign tokens with
+	dir := t.TempDir()
+	files, err := devserver.GenerateTLSFiles(dir, []string{"127.0.0.1"})
+	require.NoError(t, err)
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]any{{
+			"kty": "EC", "crv": "P-256", "kid": "test", "alg": "ES256", "use": "sig",
+			"x": base64.RawURLEncoding.EncodeToString(signingKey.X.FillBytes(make([]byte, 32))),
+			"y": base64.RawURLEncoding.EncodeToString(signingKey.Y.FillBytes(make([]byte, 32))),
+		}}})
+	}))
+	defer jwks.Close()
+	token := func(permissions ...string) string {
+		tok := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"sub": "test", "permissions": permissions})
+		tok.Header["kid"] = "test"
+		s, err := tok.SignedString(signingKey)
+		require.NoError(t, err)
+		return s
+	}
+
+	// Seeding skips both, and the seeded namespace shows it is done
+	seedFile := filepath.Join(dir, "seed.yaml")
+	require.NoError(t, os.WriteFile(seedFile, []byte("namespaces:\n  - name: seeded\n"), 0644))
+
+	port := strconv.Itoa(devserver.MustGetFreePort("127.0.0.1"))
+	address := "127.0.0.1:" + port
+	resCh := make(chan *CommandResult, 1)
+	go func() {
+		resCh <- h.Execute("server", "start-dev", "-p", port, "--headless",
+			"--tls-cert", files.ServerCertFile, "--tls-key", files.ServerKeyFile, "--tls-ca", files.CAFile,
+			"--auth-jwks", jwks.URL, "--seed", seedFile)
+	}()
+	clientArgs := func(apiKey string) []string {
+		return []string{"operator", "namespace", "describe", "-n", "seeded", "--address", address,
+			"--tls-ca-path", files.CAFile, "--tls-cert-path", files.ClientCertFile,
+			"--tls-key-path", files.ClientKeyFile, "--api-key", apiKey}
+	}
+	h.EventuallyWithT(func(t *assert.CollectT) {
+		select {
+		case res := <-resCh:
+			require.NoError(t, res.Err)
+			require.Fail(t, "got early server result")
+		default:
+		}
+		assert.NoError(t, h.Execute(clientArgs(token("temporal-system:admin"))...).Err)
+	}, 5*time.Second, 200*time.Millisecond)
+
+	// Tokens need permissions, and clients need certificates
+	res := h.Execute(clientArgs(token())...)
+	require.ErrorContains(t, res.Err, "Request unauthorized")
+	res = h.Execute("operator", "namespace", "describe", "-n", "default", "--address", address,
+		"--tls-ca-path", files.CAFile, "--api-key", token("temporal-system:admin"))
+	require.Error(t, res.Err)
+
+	h.CancelContext()
+	select {
+	case <-time.After(20 * time.Second):
+		h.Fail("didn't cleanup after 20 seconds")
+	case res = <-resCh:
+		h.NoError(res.Err)
+	}
+	h.Contains(res.Stdout.String(), "Created namespace/seeded")
+	h.Contains(res.Stdout.String(), "Temporal TLS:         mTLS")
+	h.Contains(res.Stdout.String(), "Temporal Auth:        JWT")
+
+	// Generated certificates and API key
+	h2 := NewCommandHarness(t)
+	defer h2.Close()
+	go func() {
+		resCh <- h2.Execute("server", "start-dev", "-p", port, "--headless", "--tls-auto", "--api-key")
+	}()
+	h2.EventuallyWithT(func(t *assert.CollectT) {
+		select {
+		case res := <-resCh:
+			require.NoError(t, res.Err)
+			require.Fail(t, "got early server result")
+		default:
+		}
+		conn, err := net.Dial("tcp", address)
+		if assert.NoError(t, err) {
+			conn.Close()
+		}
+	}, 5*time.Second, 200*time.Millisecond)
+	// Plaintext is rejected
+	res = h2.Execute("operator", "namespace", "describe", "-n", "default", "--address", address,
+		"--client-connect-timeout", "2s")
+	require.Error(t, res.Err)
+	h2.CancelContext()
+	select {
+	case <-time.After(20 * time.Second):
+		h.Fail("didn't cleanup after 20 seconds")
+	case res = <-resCh:
+		h.NoError(res.Err)
+	}
+	out := res.Stdout.String()
+	h.Contains(out, "Temporal API Key:")
+	h.Contains(out, "--tls-cert-path")
+	h.Contains(out, "[profile.dev-server]")
+	h.Contains(out, "api_key = ")
+	// Certificates are removed on exit
+	h.Regexp(`certificates in (\S+)\)`, out)
+	_, err = os.Stat(regexp.MustCompile(`certificates in (\S+)\)`).FindStringSubmatch(out)[1])
+	h.True(os.IsNotExist(err))
+
+	// Invalid combinations
+	res = h.Execute("server", "start-dev", "--tls-auto", "--tls-ca", files.CAFile)
+	h.ErrorContains(res.Err, "cannot use TLS auto with TLS certificate, key, or CA")
+	res = h.Execute("server", "start-dev", "--tls-cert", files.ServerCertFile)
+	h.ErrorContains(res.Err, "TLS certificate and key must both be set")
+	res = h.Execute("server", "start-dev", "--api-key", "--clusters", "2")
+	h.ErrorContains(res.Err, "TLS and auth are not supported with multiple clusters")
+}
+
+func TestServer_DynamicConfig(t *testing.T) {
+	h := NewCommandHarness(t)
+	defer h.Close()
+
+	dynConfigFile := filepath.Join(t.TempDir(), "dynamic-config.yaml")
+	require.NoError(t, os.WriteFile(dynConfigFile, []byte("limit.maxIDLength:\n  - value: 20\n"), 0644))
+
+	port := strconv.Itoa(devserver.MustGetFreePort("127.0.0.1"))
+	dynConfigPort := strconv.Itoa(devserver.MustGetFreePort("127.0.0.1"))
+	dynConfigAddress := "127.0.0.1:" + dynConfigPort
+	resCh := make(chan *CommandResult, 1)
+	go func() {
+		resCh <- h.Execute("server", "start-dev", "-p", port, "--headless",
+			"--dynamic-config-file", dynConfigFile,
+			"--dynamic-config-port", dynConfigPort,
+			"--dynamic-config-value", "frontend.namespaceRPS=77")
+	}()
+	var cl client.Client
+	h.EventuallyWithT(func(t *assert.CollectT) {
+		select {
+		case res := <-resCh:
+			require.NoError(t, res.Err)
+			require.Fail(t, "got early server result")
+		default:
+		}
+		var err error
+		cl, err = client.Dial(client.Options{HostPort: "127.0.0.1:" + port})
+		assert.NoError(t, err)
+	}, 3*time.Second, 200*time.Millisecond)
+	defer cl.Close()
+
+	// The server reports the values it uses and where they come from
+	res := h.Execute("server", "dynamic-config", "get", "--address", dynConfigAddress)
+	require.NoError(t, res.Err)
+	require.Regexp(t, `limit\.maxIDLength\s+20\s+file`, res.Stdout.String())
+	require.Regexp(t, `frontend\.namespacerps\s+77\s+start`, res.Stdout.String())
+	require.Contains(t, res.Stdout.String(), "Keys not shown use the server default.")
+
+	// Workflow ID is too long for the value from the file until it is changed
+	startWorkflow := func() error {
+		_, err := cl.ExecuteWorkflow(context.Background(), client.StartWorkflowOptions{
+			ID:        "dynamic-config-workflow-" + uuid.NewString(),
+			TaskQueue: "my-task-queue",
+		}, "MyWorkflow")
+		return err
+	}
+	require.ErrorContains(t, startWorkflow(), "exceeds maximum allowed length")
+	res = h.Execute("server", "dynamic-config", "set", "--address", dynConfigAddress,
+		"--key", "LIMIT.maxIDLength", "--value", "1000")
+	require.NoError(t, res.Err)
+	require.Contains(t, res.Stdout.String(), "Set LIMIT.maxIDLength to 1000, the server now uses:")
+	require.Regexp(t, `limit\.maxIDLength\s+1000\s+file`, res.Stdout.String())
+	h.EventuallyWithT(func(t *assert.CollectT) {
+		assert.NoError(t, startWorkflow())
+	}, 10*time.Second, 200*time.Millisecond)
+	b, err := os.ReadFile(dynConfigFile)
+	require.NoError(t, err)
+	require.Contains(t, string(b), "value: 1000")
+
+	// Constrained values are kept beside unconstrained ones
+	res = h.Execute("server", "dynamic-config", "set", "--address", dynConfigAddress,
+		"--key", "limit.maxIDLength", "--value", "500", "--constraint", "namespace=default")
+	require.NoError(t, res.Err)
+	res = h.Execute("server", "dynamic-config", "get", "--address", dynConfigAddress, "--key", "limit.maxIDLength")
+	require.NoError(t, res.Err)
+	require.Contains(t, res.Stdout.String(), "1000")
+	require.Contains(t, res.Stdout.String(), `{"namespace":"default"}`)
+	res = h.Execute("server", "dynamic-config", "get", "--address", dynConfigAddress,
+		"--key", "limit.maxIDLength", "-o", "json")
+	require.NoError(t, res.Err)
+	var entries []devserver.DynamicConfigEntry
+	require.NoError(t, json.Unmarshal(res.Stdout.Bytes(), &entries))
+	require.Len(t, entries, 2)
+
+	// Values given at start are replaced by set ones
+	res = h.Execute("server", "dynamic-config", "set", "--address", dynConfigAddress,
+		"--key", "frontend.namespaceRPS", "--value", "88")
+	require.NoError(t, res.Err)
+	require.Regexp(t, `frontend\.namespaceRPS\s+88\s+file`, res.Stdout.String())
+	require.NotContains(t, res.Stdout.String(), "77")
+
+	res = h.Execute("server", "dynamic-config", "set", "--address", dynConfigAddress,
+		"--key", "limit.maxIDLength", "--value", "abc")
+	require.ErrorContains(t, res.Err, "invalid value")
+	res = h.Execute("server", "dynamic-config", "set", "--address", dynConfigAddress,
+		"--key", "limit.maxIDLength", "--value", "1", "--constraint", "unknown=x")
+	require.ErrorContains(t, res.Err, "unknown constraint type")
+	res = h.Execute("server", "dynamic-config", "get", "--address", dynConfigAddress, "--key", "missing.key")
+	require.NoError(t, res.Err)
+	require.Contains(t, res.Stdout.String(), "missing.key has no value, the server uses its default")
+
+	h.CancelContext()
+	select {
+	case <-time.After(20 * time.Second):
+		h.Fail("didn't cleanup after 20 seconds")
+	case res = <-resCh:
+		h.NoError(res.Err)
+	}
+}
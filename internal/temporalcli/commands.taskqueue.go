@@ -262,17 +262,44 @@ func (c *TemporalTaskQueueDescribeCommand) run(cctx *CommandContext, args []stri
 		return err
 	}
 
+	selection, taskQueueTypes, err := taskQueueVersionsAndTypes(
+		c.SelectBuildId, c.SelectUnversioned, c.SelectAllActive, c.TaskQueueType.Values)
+	if err != nil {
+		return err
+	}
+	resp, err := cl.DescribeTaskQueueEnhanced(cctx, client.DescribeTaskQueueEnhancedOptions{
+		TaskQueue:              c.TaskQueue,
+		Versions:               selection,
+		TaskQueueTypes:         taskQueueTypes,
+		ReportPollers:          true,
+		ReportTaskReachability: c.ReportReachability,
+		ReportStats:            !c.DisableStats,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe task queue: %w", err)
+	}
+	return printTaskQueueDescription(cctx, resp, c.ReportReachability, c.DisableStats)
+}
+
+// taskQueueVersionsAndTypes converts the version selection and task queue
+// type flags of describe and check.
+func taskQueueVersionsAndTypes(
+	buildIDs []string,
+	unversioned bool,
+	allActive bool,
+	types []string,
+) (*client.TaskQueueVersionSelection, []client.TaskQueueType, error) {
 	var selection *client.TaskQueueVersionSelection
-	if len(c.SelectBuildId) > 0 || c.SelectUnversioned || c.SelectAllActive {
+	if len(buildIDs) > 0 || unversioned || allActive {
 		selection = &client.TaskQueueVersionSelection{
-			BuildIDs:    c.SelectBuildId,
-			Unversioned: c.SelectUnversioned,
-			AllActive:   c.SelectAllActive,
+			BuildIDs:    buildIDs,
+			Unversioned: unversioned,
+			AllActive:   allActive,
 		}
 	}
 
 	var taskQueueTypes []client.TaskQueueType
-	for _, t := range c.TaskQueueType.Values {
+	for _, t := range types {
 		var taskQueueType client.TaskQueueType
 		switch t {
 		case "workflow":
@@ -282,23 +309,11 @@ func (c *TemporalTaskQueueDescribeCommand) run(cctx *CommandContext, args []stri
 		case "nexus":
 			taskQueueType = client.TaskQueueTypeNexus
 		default:
-			return fmt.Errorf("unrecognized task queue type: %s", t)
+			return nil, nil, fmt.Errorf("unrecognized task queue type: %s", t)
 		}
 		taskQueueTypes = append(taskQueueTypes, taskQueueType)
 	}
-
-	resp, err := cl.DescribeTaskQueueEnhanced(cctx, client.DescribeTaskQueueEnhancedOptions{
-		TaskQueue:              c.TaskQueue,
-		Versions:               selection,
-		TaskQueueTypes:         taskQueueTypes,
-		ReportPollers:          true,
-		ReportTaskReachability: c.ReportReachability,
-		ReportStats:            !c.DisableStats,
-	})
-	if err != nil {
-		return fmt.Errorf("unable to describe task queue: %w", err)
-	}
-	return printTaskQueueDescription(cctx, resp, c.ReportReachability, c.DisableStats)
+	return selection, taskQueueTypes, nil
 }
 
 func (c *TemporalTaskQueueDescribeCommand) runLegacy(cctx *CommandContext, args []string) error {
@@ -0,0 +1,144 @@
+package temporalcli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/temporalio/cli/internal/printer"
+	"go.temporal.io/sdk/client"
+)
+
+// Nagios-style check statuses, whose values are the exit codes
+const (
+	taskQueueCheckOK = iota
+	taskQueueCheckWarning
+	taskQueueCheckCritical
+	taskQueueCheckUnknown
+)
+
+var taskQueueCheckStatuses = []string{"OK", "WARNING", "CRITICAL", "UNKNOWN"}
+
+type taskQueueCheckType struct {
+	Status   string          `json:"status"`
+	Problems []string        `json:"problems"`
+	Pollers  []pollerRowType `json:"pollers"`
+	Stats    []statsRowType  `json:"stats"`
+}
+
+func (c *TemporalTaskQueueCheckCommand) run(cctx *CommandContext, args []string) error {
+	if c.MaxBacklog < 0 || c.WarnMaxBacklog < 0 || c.MinPollers < 0 || c.WarnMinPollers < 0 ||
+		c.MaxBacklogAge.Duration() < 0 || c.WarnMaxBacklogAge.Duration() < 0 {
+		return fmt.Errorf("thresholds cannot be negative")
+	}
+	status, check, err := c.check(cctx)
+	if err != nil {
+		status, check = taskQueueCheckUnknown, &taskQueueCheckType{Problems: []string{err.Error()}}
+	}
+	check.Status = taskQueueCheckStatuses[status]
+
+	if cctx.JSONOutput {
+		if err := cctx.Printer.PrintStructured(check, printer.StructuredOptions{}); err != nil {
+			return err
+		}
+	} else if len(check.Problems) == 0 {
+		cctx.Printer.Printlnf("%v - task queue %v: no thresholds crossed", check.Status, c.TaskQueue)
+	} else {
+		cctx.Printer.Printlnf("%v - task queue %v: %v", check.Status, c.TaskQueue, strings.Join(check.Problems, "; "))
+	}
+	if status == taskQueueCheckOK {
+		return nil
+	}
+	return ExitCodeError{Code: status, Err: fmt.Errorf("task queue %v is %v", c.TaskQueue, check.Status)}
+}
+
+// check describes the task queue and returns the worst status of any
+// threshold crossed.
+func (c *TemporalTaskQueueCheckCommand) check(cctx *CommandContext) (int, *taskQueueCheckType, error) {
+	selection, taskQueueTypes, err := taskQueueVersionsAndTypes(
+		c.SelectBuildId, c.SelectUnversioned, c.SelectAllActive, c.TaskQueueType.Values)
+	if err != nil {
+		return 0, nil, err
+	}
+	cl, err := dialClient(cctx, &c.Parent.ClientOptions)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer cl.Close()
+	desc, err := cl.DescribeTaskQueueEnhanced(cctx, client.DescribeTaskQueueEnhancedOptions{
+		TaskQueue:      c.TaskQueue,
+		Versions:       selection,
+		TaskQueueTypes: taskQueueTypes,
+		ReportPollers:  true,
+		ReportStats:    true,
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("unable to describe task queue: %w", err)
+	}
+	rows, err := taskQueueDescriptionToRows(desc, false, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	check := &taskQueueCheckType{Problems: []string{}, Pollers: rows.Pollers, Stats: rows.Stats}
+
+	status := taskQueueCheckOK
+	crossed := func(s int, format string, a ...any) {
+		status = max(status, s)
+		check.Problems = append(check.Problems, fmt.Sprintf(format, a...))
+	}
+	// Unversioned queue first, then by build ID and type for stable output
+	buildIDs := make([]string, 0, len(desc.VersionsInfo))
+	for buildID := range desc.VersionsInfo {
+		buildIDs = append(buildIDs, buildID)
+	}
+	slices.Sort(buildIDs)
+	for _, buildID := range buildIDs {
+		name := buildID
+		if buildID == client.UnversionedBuildID {
+			name = taskQueueUnversioned
+		}
+		typesInfo := desc.VersionsInfo[buildID].TypesInfo
+		types := make([]client.TaskQueueType, 0, len(typesInfo))
+		for t := range typesInfo {
+			types = append(types, t)
+		}
+		slices.Sort(types)
+		for _, t := range types {
+			typeStr, err := taskQueueTypeToStr(t)
+			if err != nil {
+				return 0, nil, err
+			}
+			info := typesInfo[t]
+			label := name + " " + typeStr
+			var stats client.TaskQueueStats
+			if info.Stats != nil {
+				stats = *info.Stats
+			}
+
+			backlog := stats.ApproximateBacklogCount
+			switch {
+			case c.MaxBacklog > 0 && backlog > int64(c.MaxBacklog):
+				crossed(taskQueueCheckCritical, "%v backlog %v above %v", label, backlog, c.MaxBacklog)
+			case c.WarnMaxBacklog > 0 && backlog > int64(c.WarnMaxBacklog):
+				crossed(taskQueueCheckWarning, "%v backlog %v above %v", label, backlog, c.WarnMaxBacklog)
+			}
+			age := stats.ApproximateBacklogAge
+			switch {
+			case c.MaxBacklogAge.Duration() > 0 && age > c.MaxBacklogAge.Duration():
+				crossed(taskQueueCheckCritical, "%v backlog age %v above %v",
+					label, formatDuration(age), formatDuration(c.MaxBacklogAge.Duration()))
+			case c.WarnMaxBacklogAge.Duration() > 0 && age > c.WarnMaxBacklogAge.Duration():
+				crossed(taskQueueCheckWarning, "%v backlog age %v above %v",
+					label, formatDuration(age), formatDuration(c.WarnMaxBacklogAge.Duration()))
+			}
+			pollers := len(info.Pollers)
+			switch {
+			case pollers < c.MinPollers:
+				crossed(taskQueueCheckCritical, "%v pollers %v below %v", label, pollers, c.MinPollers)
+			case pollers < c.WarnMinPollers:
+				crossed(taskQueueCheckWarning, "%v pollers %v below %v", label, pollers, c.WarnMinPollers)
+			}
+		}
+	}
+	return status, check, nil
+}
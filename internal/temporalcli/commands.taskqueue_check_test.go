@@ -0,0 +1,113 @@
+package temporalcli_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/temporalio/cli/internal/temporalcli"
+	"go.temporal.io/sdk/client"
+)
+
+func checkExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr temporalcli.ExitCodeError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return -1
+}
+
+func (s *SharedServerSuite) TestTaskQueue_Check_Pollers() {
+	taskQueue := s.Worker().Options.TaskQueue
+	// Worker polls both types once it has started
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		res := s.Execute(
+			"task-queue", "check",
+			"--address", s.Address(),
+			"--task-queue", taskQueue,
+			"--min-pollers", "1",
+		)
+		assert.NoError(t, res.Err)
+		assert.Equal(t, "OK - task queue "+taskQueue+": no thresholds crossed\n", res.Stdout.String())
+	}, 10*time.Second, 100*time.Millisecond)
+
+	res := s.Execute(
+		"task-queue", "check",
+		"--address", s.Address(),
+		"--task-queue", taskQueue,
+		"--task-queue-type", "workflow",
+		"--warn-min-pollers", "100",
+	)
+	s.Equal(1, checkExitCode(res.Err))
+	s.Contains(res.Stdout.String(), "WARNING - task queue "+taskQueue+": UNVERSIONED workflow pollers 1 below 100")
+
+	// Critical wins over warning
+	res = s.Execute(
+		"task-queue", "check",
+		"--address", s.Address(),
+		"--task-queue", taskQueue,
+		"--min-pollers", "100",
+		"--warn-max-backlog-age", "1h",
+	)
+	s.Equal(2, checkExitCode(res.Err))
+	s.ContainsOnSameLine(res.Stdout.String(), "CRITICAL", "UNVERSIONED workflow pollers 1 below 100",
+		"UNVERSIONED activity pollers 1 below 100")
+
+	res = s.Execute(
+		"task-queue", "check",
+		"--address", s.Address(),
+		"--namespace", "does-not-exist",
+		"--task-queue", taskQueue,
+	)
+	s.Equal(3, checkExitCode(res.Err))
+	s.Contains(res.Stdout.String(), "UNKNOWN - task queue "+taskQueue+": unable to describe task queue")
+}
+
+func (s *SharedServerSuite) TestTaskQueue_Check_Backlog() {
+	// No worker polls this task queue, so workflow tasks back up
+	taskQueue := uuid.NewString()
+	for range 3 {
+		run, err := s.Client.ExecuteWorkflow(s.Context, client.StartWorkflowOptions{TaskQueue: taskQueue}, DevWorkflow, "ignored")
+		s.NoError(err)
+		defer s.Client.TerminateWorkflow(s.Context, run.GetID(), "", "test cleanup")
+	}
+
+	var check struct {
+		Status   string         `json:"status"`
+		Problems []string       `json:"problems"`
+		Stats    []statsRowType `json:"stats"`
+	}
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		res := s.Execute(
+			"task-queue", "check",
+			"--address", s.Address(),
+			"--task-queue", taskQueue,
+			"--task-queue-type", "workflow",
+			"--max-backlog", "10",
+			"--warn-max-backlog", "2",
+			"--max-backlog-age", "1h",
+			"-o", "json",
+		)
+		assert.Equal(t, 1, checkExitCode(res.Err))
+		assert.NoError(t, json.Unmarshal(res.Stdout.Bytes(), &check))
+		assert.Equal(t, "WARNING", check.Status)
+		assert.Equal(t, []string{"UNVERSIONED workflow backlog 3 above 2"}, check.Problems)
+	}, 10*time.Second, 200*time.Millisecond)
+	s.Len(check.Stats, 1)
+	s.Equal(int64(3), check.Stats[0].ApproximateBacklogCount)
+
+	res := s.Execute(
+		"task-queue", "check",
+		"--address", s.Address(),
+		"--task-queue", taskQueue,
+		"--task-queue-type", "workflow",
+		"--max-backlog-age", "1ms",
+	)
+	s.Equal(2, checkExitCode(res.Err))
+	s.Contains(res.Stdout.String(), "CRITICAL - task queue "+taskQueue+": UNVERSIONED workflow backlog age")
+}
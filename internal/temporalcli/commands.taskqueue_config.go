@@ -147,21 +147,80 @@ func parseFairnessKeyWeights(inputs []string) (setWeights map[string]float32, un
 // TaskQueueConfigSetCommand handles setting task queue configuration
 func (c *TemporalTaskQueueConfigSetCommand) run(cctx *CommandContext, args []string) error {
 	// Validate inputs before dialing client
+	request, err := c.updateConfigRequest(cctx)
+	if err != nil {
+		return err
+	}
+
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+	// Dialing may have derived the namespace from the config profile
+	request.Namespace = c.Parent.Parent.Namespace
+
+	// Handle clear all
+	if c.FairnessKeyWeightClearAll {
+		// Need to fetch current config to get all keys to unset
+		descResp, err := cl.WorkflowService().DescribeTaskQueue(cctx, &workflowservice.DescribeTaskQueueRequest{
+			Namespace: request.Namespace,
+			TaskQueue: &taskqueue.TaskQueue{
+				Name: request.TaskQueue,
+				Kind: enums.TASK_QUEUE_KIND_NORMAL,
+			},
+			TaskQueueType: request.TaskQueueType,
+			ReportConfig:  true,
+		})
+		if err != nil {
+			return fmt.Errorf("error fetching current config for clear-all: %w", err)
+		}
+		var overrides map[string]float32
+		if descResp.Config != nil {
+			overrides = descResp.Config.FairnessWeightOverrides
+		}
+		keys := maps.Keys(overrides)
+		if len(keys) > 0 {
+			request.UnsetFairnessWeightOverrides = keys
+			cctx.Printer.Printlnf("Unsetting %d fairness weight override(s)", len(keys))
+		} else {
+			cctx.Printer.Println("No fairness weight overrides found to unset")
+			// Don't return error, just proceed with no-op update
+		}
+	}
+
+	// Call the API
+	resp, err := cl.WorkflowService().UpdateTaskQueueConfig(cctx, request)
+	if err != nil {
+		// Provide more context in error message
+		return fmt.Errorf("failed to update task queue config for %s/%s: %w", request.Namespace, request.TaskQueue, err)
+	}
+
+	cctx.Printer.Println("Successfully updated task queue configuration")
+	return cctx.Printer.PrintStructured(resp, printer.StructuredOptions{})
+}
+
+// updateConfigRequest validates the flags and builds the update request. Keys
+// to unset for --fairness-key-weight-clear-all are not included since they
+// require the current configuration.
+func (c *TemporalTaskQueueConfigSetCommand) updateConfigRequest(
+	cctx *CommandContext,
+) (*workflowservice.UpdateTaskQueueConfigRequest, error) {
 	taskQueue := strings.TrimSpace(c.TaskQueue)
 	if taskQueue == "" {
-		return fmt.Errorf("task queue name is required and cannot be empty")
+		return nil, fmt.Errorf("task queue name is required and cannot be empty")
 	}
 
 	taskQueueType, err := parseTaskQueueType(c.TaskQueueType.Value)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check workflow task queue restrictions
 	if taskQueueType == enums.TASK_QUEUE_TYPE_WORKFLOW {
 		if c.Command.Flags().Changed("queue-rps-limit") ||
 			c.Command.Flags().Changed("queue-rps-limit-reason") {
-			return fmt.Errorf("setting rate limit on workflow task queues is not allowed")
+			return nil, fmt.Errorf("setting rate limit on workflow task queues is not allowed")
 		}
 	}
 
@@ -195,7 +254,7 @@ func (c *TemporalTaskQueueConfigSetCommand) run(cctx *CommandContext, args []str
 		var err error
 		queueRpsLimitParsed, queueRateLimitIsZero, err = parseRPS("queue-rps-limit")
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Warn about zero rate limit (stops all traffic)
@@ -211,7 +270,7 @@ func (c *TemporalTaskQueueConfigSetCommand) run(cctx *CommandContext, args []str
 		var err error
 		fairnessKeyRpsLimitDefaultParsed, fairnessRateLimitIsZero, err = parseRPS("fairness-key-rps-limit-default")
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Warn about zero rate limit
@@ -220,15 +279,9 @@ func (c *TemporalTaskQueueConfigSetCommand) run(cctx *CommandContext, args []str
 		}
 	}
 
-	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
-	if err != nil {
-		return err
-	}
-	defer cl.Close()
-
 	namespace := c.Parent.Parent.Namespace
 	if namespace == "" {
-		return fmt.Errorf("namespace is required")
+		return nil, fmt.Errorf("namespace is required")
 	}
 
 	request := &workflowservice.UpdateTaskQueueConfigRequest{
@@ -261,61 +314,23 @@ func (c *TemporalTaskQueueConfigSetCommand) run(cctx *CommandContext, args []str
 		c.FairnessKeyWeightClearAll
 
 	if !hasAnyUpdate {
-		return fmt.Errorf("at least one configuration update must be specified (use --help to see available options)")
+		return nil, fmt.Errorf("at least one configuration update must be specified (use --help to see available options)")
 	}
 
 	// Handle fairness weight overrides
 	// Validate mutual exclusivity of clear-all with other weight operations
 	if c.FairnessKeyWeightClearAll {
 		if len(c.FairnessKeyWeight) > 0 {
-			return fmt.Errorf("--fairness-key-weight-clear-all cannot be used with --fairness-key-weight")
+			return nil, fmt.Errorf("--fairness-key-weight-clear-all cannot be used with --fairness-key-weight")
 		}
 	}
 
 	// Parse fairness key weights (handles both set and unset operations)
 	setWeights, unsetKeys, err := parseFairnessKeyWeights(c.FairnessKeyWeight)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	request.SetFairnessWeightOverrides = setWeights
 	request.UnsetFairnessWeightOverrides = unsetKeys
-
-	// Handle clear all
-	if c.FairnessKeyWeightClearAll {
-		// Need to fetch current config to get all keys to unset
-		descResp, err := cl.WorkflowService().DescribeTaskQueue(cctx, &workflowservice.DescribeTaskQueueRequest{
-			Namespace: namespace,
-			TaskQueue: &taskqueue.TaskQueue{
-				Name: taskQueue,
-				Kind: enums.TASK_QUEUE_KIND_NORMAL,
-			},
-			TaskQueueType: taskQueueType,
-			ReportConfig:  true,
-		})
-		if err != nil {
-			return fmt.Errorf("error fetching current config for clear-all: %w", err)
-		}
-		var overrides map[string]float32
-		if descResp.Config != nil {
-			overrides = descResp.Config.FairnessWeightOverrides
-		}
-		keys := maps.Keys(overrides)
-		if len(keys) > 0 {
-			request.UnsetFairnessWeightOverrides = keys
-			cctx.Printer.Printlnf("Unsetting %d fairness weight override(s)", len(keys))
-		} else {
-			cctx.Printer.Println("No fairness weight overrides found to unset")
-			// Don't return error, just proceed with no-op update
-		}
-	}
-
-	// Call the API
-	resp, err := cl.WorkflowService().UpdateTaskQueueConfig(cctx, request)
-	if err != nil {
-		// Provide more context in error message
-		return fmt.Errorf("failed to update task queue config for %s/%s: %w", namespace, taskQueue, err)
-	}
-
-	cctx.Printer.Println("Successfully updated task queue configuration")
-	return cctx.Printer.PrintStructured(resp, printer.StructuredOptions{})
+	return request, nil
 }
@@ -2,6 +2,9 @@ package temporalcli_test
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -224,3 +227,115 @@ func (s *SharedServerSuite) TestTaskQueue_Rules_BuildId() {
 		},
 	}, jsonOut)
 }
+
+func (s *SharedServerSuite) TestTaskQueue_Rules_Simulate() {
+	type newWorkflowsRowType struct {
+		RuleIndex         int     `json:"ruleIndex"`
+		AssignedBuildID   string  `json:"assignedBuildID"`
+		RampPercentage    float32 `json:"rampPercentage"`
+		RedirectedBuildID string  `json:"redirectedBuildID"`
+		Workflows         int     `json:"workflows"`
+	}
+	type buildIDRowType struct {
+		BuildID           string `json:"buildID"`
+		RedirectedBuildID string `json:"redirectedBuildID"`
+		Redirects         string `json:"redirects"`
+		Cyclic            bool   `json:"cyclic"`
+	}
+	type simulationType struct {
+		NewWorkflows []newWorkflowsRowType `json:"newWorkflows"`
+		BuildIDs     []buildIDRowType      `json:"buildIDs"`
+		Problems     []string              `json:"problems"`
+	}
+
+	buildIdTaskQueue := uuid.NewString()
+	res := s.Execute(
+		"task-queue", "versioning", "insert-assignment-rule",
+		"--build-id", "id1",
+		"-y",
+		"--address", s.Address(),
+		"--task-queue", buildIdTaskQueue,
+	)
+	s.NoError(res.Err)
+
+	// Ramped rule in front of the current rules
+	res = s.Execute(
+		"task-queue", "versioning", "simulate",
+		"--insert-assignment-rule", "id2:25",
+		"--address", s.Address(),
+		"--task-queue", buildIdTaskQueue,
+		"--output", "json",
+	)
+	s.NoError(res.Err)
+	var sim simulationType
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &sim))
+	s.Equal([]newWorkflowsRowType{
+		{RuleIndex: 0, AssignedBuildID: "id2", RampPercentage: 25, RedirectedBuildID: "id2", Workflows: 25},
+		{RuleIndex: 1, AssignedBuildID: "id1", RampPercentage: 100, RedirectedBuildID: "id1", Workflows: 75},
+	}, sim.NewWorkflows)
+	s.Empty(sim.Problems)
+
+	// Committing and redirecting sends everything to the new build ID
+	res = s.Execute(
+		"task-queue", "versioning", "simulate",
+		"--insert-assignment-rule", "id2:25",
+		"--add-redirect-rule", "id1=id2",
+		"--commit-build-id", "id2",
+		"--sample", "10",
+		"--address", s.Address(),
+		"--task-queue", buildIdTaskQueue,
+		"--output", "json",
+	)
+	s.NoError(res.Err)
+	sim = simulationType{}
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &sim))
+	s.Equal([]newWorkflowsRowType{
+		{RuleIndex: 0, AssignedBuildID: "id2", RampPercentage: 100, RedirectedBuildID: "id2", Workflows: 10},
+	}, sim.NewWorkflows)
+	s.Equal([]buildIDRowType{
+		{BuildID: "id2", RedirectedBuildID: "id2", Redirects: "id2"},
+		{BuildID: "id1", RedirectedBuildID: "id2", Redirects: "id1 -> id2"},
+	}, sim.BuildIDs)
+	s.Empty(sim.Problems)
+
+	// Nothing was changed on the server
+	res = s.Execute(
+		"task-queue", "versioning", "get-rules",
+		"--address", s.Address(),
+		"--task-queue", buildIdTaskQueue,
+	)
+	s.NoError(res.Err)
+	s.NotContains(res.Stdout.String(), "id2")
+
+	// Problems in a rules file
+	rulesFile := filepath.Join(s.T().TempDir(), "rules.json")
+	s.NoError(os.WriteFile(rulesFile, []byte(`{
+		"assignmentRules": [
+			{"targetBuildID": "a", "rampPercentage": 50},
+			{"targetBuildID": "b", "rampPercentage": 20}
+		],
+		"redirectRules": [
+			{"sourceBuildID": "a", "targetBuildID": "b"},
+			{"sourceBuildID": "b", "targetBuildID": "c"},
+			{"sourceBuildID": "c", "targetBuildID": "b"},
+			{"sourceBuildID": "a", "targetBuildID": "d"}
+		]
+	}`), 0644))
+	res = s.Execute(
+		"task-queue", "versioning", "simulate",
+		"--rules-file", rulesFile,
+		"--build-id", "x",
+		"--address", s.Address(),
+		"--task-queue", buildIdTaskQueue,
+	)
+	s.NoError(res.Err)
+	out := res.Stdout.String()
+	s.ContainsOnSameLine(out, "0", "a", "50", "50", "50")
+	s.ContainsOnSameLine(out, "-1", "UNVERSIONED", "0", "UNVERSIONED", "50", "50")
+	s.ContainsOnSameLine(out, "a", "a -> b -> c -> b", "true")
+	s.ContainsOnSameLine(out, "x", "x", "x", "false")
+	s.Contains(out, "assignment rule 1 for build ID b is never reached")
+	s.Contains(out, "redirect rule from build ID a to d is never applied, an earlier rule redirects it to b")
+	s.Contains(out, "redirect rules form a cycle: b -> c -> b")
+	s.Equal(1, strings.Count(out, "form a cycle"))
+}
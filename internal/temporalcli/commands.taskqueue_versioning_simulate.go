@@ -0,0 +1,269 @@
+package temporalcli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/temporalio/cli/internal/printer"
+	"go.temporal.io/sdk/client"
+)
+
+type simulatedAssignmentRowType struct {
+	// -1 when no rule matches and workflows go to unversioned workers
+	RuleIndex         int     `json:"ruleIndex"`
+	AssignedBuildID   string  `json:"assignedBuildID"`
+	RampPercentage    float32 `json:"rampPercentage"`
+	RedirectedBuildID string  `json:"redirectedBuildID"`
+	Workflows         int     `json:"workflows"`
+	Percentage        float64 `json:"percentage"`
+}
+
+type simulatedBuildIDRowType struct {
+	BuildID           string `json:"buildID"`
+	RedirectedBuildID string `json:"redirectedBuildID"`
+	Redirects         string `json:"redirects"`
+	Cyclic            bool   `json:"cyclic"`
+}
+
+type versioningSimulationType struct {
+	Rules        *formattedRulesType          `json:"rules"`
+	NewWorkflows []simulatedAssignmentRowType `json:"newWorkflows"`
+	BuildIDs     []simulatedBuildIDRowType    `json:"buildIDs"`
+	Problems     []string                     `json:"problems"`
+}
+
+func (c *TemporalTaskQueueVersioningSimulateCommand) run(cctx *CommandContext, args []string) error {
+	if c.Sample < 1 {
+		return fmt.Errorf("sample must be at least 1")
+	}
+	rules, err := c.loadRules(cctx)
+	if err != nil {
+		return err
+	} else if err := c.applyEdits(rules); err != nil {
+		return err
+	}
+	sim := simulateVersioningRules(rules, c.BuildId, c.Sample)
+
+	if cctx.JSONOutput {
+		return cctx.Printer.PrintStructured(sim, printer.StructuredOptions{})
+	}
+	cctx.Printer.Println(color.MagentaString("New Workflows (%v simulated):", c.Sample))
+	err = cctx.Printer.PrintStructured(sim.NewWorkflows, printer.StructuredOptions{Table: &printer.TableOptions{}})
+	if err != nil {
+		return fmt.Errorf("displaying new workflows failed: %w", err)
+	}
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("Existing Build IDs:"))
+	err = cctx.Printer.PrintStructured(sim.BuildIDs, printer.StructuredOptions{Table: &printer.TableOptions{}})
+	if err != nil {
+		return fmt.Errorf("displaying build IDs failed: %w", err)
+	}
+	if len(sim.Problems) > 0 {
+		cctx.Printer.Println()
+		cctx.Printer.Println(color.MagentaString("Problems:"))
+		for _, problem := range sim.Problems {
+			cctx.Printer.Printlnf("  %v", problem)
+		}
+	}
+	return nil
+}
+
+// loadRules reads the rules file if set, or the current rules of the task
+// queue.
+func (c *TemporalTaskQueueVersioningSimulateCommand) loadRules(cctx *CommandContext) (*formattedRulesType, error) {
+	if c.RulesFile != "" {
+		b, err := os.ReadFile(c.RulesFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading rules file: %w", err)
+		}
+		var rules formattedRulesType
+		if err := json.Unmarshal(b, &rules); err != nil {
+			return nil, fmt.Errorf("failed parsing rules file: %w", err)
+		}
+		return &rules, nil
+	}
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cl.Close()
+	rules, err := cl.GetWorkerVersioningRules(cctx, client.GetWorkerVersioningOptions{
+		TaskQueue: c.Parent.TaskQueue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get task queue build ID rules: %w", err)
+	}
+	return versioningRulesToRows(rules), nil
+}
+
+func (c *TemporalTaskQueueVersioningSimulateCommand) applyEdits(rules *formattedRulesType) error {
+	var inserted []assignmentRowType
+	for _, s := range c.InsertAssignmentRule {
+		buildID, percentage := s, float32(100)
+		if i := strings.LastIndex(s, ":"); i >= 0 {
+			p, err := strconv.ParseFloat(s[i+1:], 32)
+			if err != nil {
+				return fmt.Errorf("invalid assignment rule %q: %w", s, err)
+			} else if p < 0 || p > 100 {
+				return fmt.Errorf("invalid assignment rule %q: percentage not in range [0,100]", s)
+			}
+			buildID, percentage = s[:i], float32(p)
+		}
+		if buildID == "" {
+			return fmt.Errorf("invalid assignment rule %q: missing build ID", s)
+		}
+		inserted = append(inserted, assignmentRowType{TargetBuildID: buildID, RampPercentage: percentage})
+	}
+	rules.AssignmentRules = append(inserted, rules.AssignmentRules...)
+
+	for _, s := range c.AddRedirectRule {
+		source, target, ok := strings.Cut(s, "=")
+		if !ok || source == "" || target == "" {
+			return fmt.Errorf("invalid redirect rule %q, expected SOURCE_BUILD_ID=TARGET_BUILD_ID", s)
+		}
+		rules.RedirectRules = append(rules.RedirectRules, redirectRowType{SourceBuildID: source, TargetBuildID: target})
+	}
+
+	if c.CommitBuildId != "" {
+		// Same as the server, an unconditional rule for the build ID replaces its
+		// other rules and every other unconditional rule
+		rules.AssignmentRules = slices.DeleteFunc(rules.AssignmentRules, func(r assignmentRowType) bool {
+			return r.TargetBuildID == c.CommitBuildId || r.RampPercentage >= 100
+		})
+		rules.AssignmentRules = append(rules.AssignmentRules,
+			assignmentRowType{TargetBuildID: c.CommitBuildId, RampPercentage: 100})
+	}
+
+	for i := range rules.AssignmentRules {
+		rules.AssignmentRules[i].Index = i
+	}
+	return nil
+}
+
+// simulateVersioningRules assigns sample new workflows, spread evenly across
+// ramp percentages, and follows the redirects of every build ID as the server
+// would.
+func simulateVersioningRules(rules *formattedRulesType, extraBuildIDs []string, sample int) *versioningSimulationType {
+	sim := &versioningSimulationType{Rules: rules, Problems: []string{}}
+
+	// A rule only gets the workflows under its ramp that earlier rules did not
+	var covered float32
+	for i, r := range rules.AssignmentRules {
+		if r.RampPercentage <= covered {
+			sim.Problems = append(sim.Problems, fmt.Sprintf(
+				"assignment rule %v for build ID %v is never reached", i, r.TargetBuildID))
+		}
+		covered = max(covered, r.RampPercentage)
+	}
+	counts := map[int]int{}
+	for i := range sample {
+		threshold := (float64(i) + 0.5) * 100 / float64(sample)
+		index := slices.IndexFunc(rules.AssignmentRules, func(r assignmentRowType) bool {
+			return r.RampPercentage >= 100 || float64(r.RampPercentage) > threshold
+		})
+		counts[index]++
+	}
+	for index, r := range rules.AssignmentRules {
+		if counts[index] > 0 {
+			path, cyclic := followRedirects(r.TargetBuildID, rules.RedirectRules)
+			sim.NewWorkflows = append(sim.NewWorkflows, simulatedAssignmentRowType{
+				RuleIndex:         index,
+				AssignedBuildID:   r.TargetBuildID,
+				RampPercentage:    r.RampPercentage,
+				RedirectedBuildID: redirectedBuildID(path, cyclic),
+				Workflows:         counts[index],
+				Percentage:        float64(counts[index]) * 100 / float64(sample),
+			})
+		}
+	}
+	if counts[-1] > 0 {
+		sim.NewWorkflows = append(sim.NewWorkflows, simulatedAssignmentRowType{
+			RuleIndex:         -1,
+			AssignedBuildID:   taskQueueUnversioned,
+			RedirectedBuildID: taskQueueUnversioned,
+			Workflows:         counts[-1],
+			Percentage:        float64(counts[-1]) * 100 / float64(sample),
+		})
+	}
+
+	// The server only applies the first rule for a source
+	redirectedBy := map[string]string{}
+	for _, r := range rules.RedirectRules {
+		if target, ok := redirectedBy[r.SourceBuildID]; ok {
+			sim.Problems = append(sim.Problems, fmt.Sprintf(
+				"redirect rule from build ID %v to %v is never applied, an earlier rule redirects it to %v",
+				r.SourceBuildID, r.TargetBuildID, target))
+			continue
+		}
+		redirectedBy[r.SourceBuildID] = r.TargetBuildID
+	}
+
+	var buildIDs []string
+	for _, r := range rules.AssignmentRules {
+		buildIDs = append(buildIDs, r.TargetBuildID)
+	}
+	for _, r := range rules.RedirectRules {
+		buildIDs = append(buildIDs, r.SourceBuildID, r.TargetBuildID)
+	}
+	buildIDs = append(buildIDs, extraBuildIDs...)
+	seen := map[string]bool{}
+	reportedCycles := map[string]bool{}
+	for _, buildID := range buildIDs {
+		if seen[buildID] {
+			continue
+		}
+		seen[buildID] = true
+		path, cyclic := followRedirects(buildID, rules.RedirectRules)
+		row := simulatedBuildIDRowType{
+			BuildID:           buildID,
+			RedirectedBuildID: redirectedBuildID(path, cyclic),
+			Redirects:         strings.Join(path, " -> "),
+			Cyclic:            cyclic,
+		}
+		sim.BuildIDs = append(sim.BuildIDs, row)
+		if cyclic {
+			// Report each cycle once, however many build IDs lead into it
+			cycle := path[slices.Index(path, path[len(path)-1]) : len(path)-1]
+			key := slices.Clone(cycle)
+			slices.Sort(key)
+			if k := strings.Join(key, "\x00"); !reportedCycles[k] {
+				reportedCycles[k] = true
+				sim.Problems = append(sim.Problems, fmt.Sprintf(
+					"redirect rules form a cycle: %v -> %v", strings.Join(cycle, " -> "), cycle[0]))
+			}
+		}
+	}
+	return sim
+}
+
+// redirectedBuildID returns where a redirect path ends, or nothing for a
+// cycle.
+func redirectedBuildID(path []string, cyclic bool) string {
+	if cyclic {
+		return ""
+	}
+	return path[len(path)-1]
+}
+
+// followRedirects returns the build IDs the first applicable redirect rules
+// lead through from the build ID, ending at the first repeated one if cyclic.
+func followRedirects(buildID string, redirects []redirectRowType) ([]string, bool) {
+	path := []string{buildID}
+	for {
+		i := slices.IndexFunc(redirects, func(r redirectRowType) bool { return r.SourceBuildID == buildID })
+		if i < 0 {
+			return path, false
+		}
+		buildID = redirects[i].TargetBuildID
+		cyclic := slices.Contains(path, buildID)
+		path = append(path, buildID)
+		if cyclic {
+			return path, true
+		}
+	}
+}
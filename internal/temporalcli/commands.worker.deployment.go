@@ -749,6 +749,8 @@ type getDeploymentConflictTokenOptions struct {
 	safeMode        bool
 	safeModeMessage string
 	deploymentName  string
+	// Called with the deployment before prompting, to reject unexpected state
+	validate func(info client.WorkerDeploymentInfo) error
 }
 
 func (c *TemporalWorkerDeploymentCommand) getConflictToken(cctx *CommandContext, options *getDeploymentConflictTokenOptions) ([]byte, error) {
@@ -764,6 +766,11 @@ func (c *TemporalWorkerDeploymentCommand) getConflictToken(cctx *CommandContext,
 	if err != nil {
 		return nil, fmt.Errorf("unable to get deployment conflict token: %w", err)
 	}
+	if options.validate != nil {
+		if err := options.validate(resp.Info); err != nil {
+			return nil, err
+		}
+	}
 
 	if options.safeMode {
 		// duplicate `cctx.promptYes` check to avoid printing deployment info with json
@@ -152,3 +152,18 @@ func TestFormatComputeConfigProto_ScalerBounds(t *testing.T) {
 	require.Nil(t, sg.Scaler.UtilizationTarget)
 	require.Equal(t, "gcp-cloud-run", computeConfigSummaryStr(ccNoBounds))
 }
+
+func TestParseRolloutSteps(t *testing.T) {
+	steps, err := parseRolloutSteps("5, 25,50.5,100")
+	require.NoError(t, err)
+	require.Equal(t, []float32{5, 25, 50.5, 100}, steps)
+
+	_, err = parseRolloutSteps("5,x")
+	require.ErrorContains(t, err, `invalid step "x"`)
+	_, err = parseRolloutSteps("0,100")
+	require.ErrorContains(t, err, "not in range")
+	_, err = parseRolloutSteps("50,150")
+	require.ErrorContains(t, err, "not in range")
+	_, err = parseRolloutSteps("50,25")
+	require.ErrorContains(t, err, "must be increasing")
+}
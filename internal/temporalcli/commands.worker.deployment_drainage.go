@@ -0,0 +1,203 @@
+package temporalcli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/temporalio/cli/internal/printer"
+	deploymentpb "go.temporal.io/api/deployment/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+)
+
+func (c *TemporalWorkerDeploymentWaitDrainedCommand) run(cctx *CommandContext, args []string) error {
+	if c.PollInterval.Duration() <= 0 {
+		return fmt.Errorf("poll interval must be positive")
+	} else if c.Timeout.Duration() < 0 {
+		return fmt.Errorf("timeout cannot be negative")
+	}
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	ctx := context.Context(cctx)
+	if c.Timeout.Duration() > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(cctx, c.Timeout.Duration())
+		defer cancel()
+	}
+	timedOut := func(err error) error {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("timed out after %v waiting for build ID %v to drain", c.Timeout.Duration(), c.BuildId)
+		}
+		return err
+	}
+
+	var lastChecked time.Time
+	for {
+		resp, err := cl.WorkflowService().DescribeWorkerDeploymentVersion(ctx, &workflowservice.DescribeWorkerDeploymentVersionRequest{
+			Namespace: c.Parent.Parent.Namespace,
+			DeploymentVersion: &deploymentpb.WorkerDeploymentVersion{
+				DeploymentName: c.DeploymentName,
+				BuildId:        c.BuildId,
+			},
+		})
+		if err != nil {
+			return timedOut(fmt.Errorf("error describing worker deployment version: %w", err))
+		}
+		info := resp.GetWorkerDeploymentVersionInfo()
+		drainage := info.GetDrainageInfo()
+		switch {
+		case info.GetStatus() == enumspb.WORKER_DEPLOYMENT_VERSION_STATUS_CURRENT:
+			return fmt.Errorf("build ID %v is the current version and will not drain", c.BuildId)
+		case info.GetStatus() == enumspb.WORKER_DEPLOYMENT_VERSION_STATUS_RAMPING:
+			return fmt.Errorf("build ID %v is the ramping version and will not drain", c.BuildId)
+		case drainage.GetStatus() == enumspb.VERSION_DRAINAGE_STATUS_DRAINED:
+			cctx.Printer.Printlnf("Build ID %v is drained", c.BuildId)
+			return nil
+		case drainage == nil:
+			cctx.Printer.Printlnf("Build ID %v was never current or ramping, nothing to drain", c.BuildId)
+			return nil
+		}
+		// Only report when the server has checked again
+		if checked := drainage.GetLastCheckedTime().AsTime(); !checked.Equal(lastChecked) {
+			lastChecked = checked
+			cctx.Printer.Printlnf("Build ID %v is draining (last checked %v)",
+				c.BuildId, cctx.Printer.FormatTime(checked))
+		}
+		select {
+		case <-ctx.Done():
+			return timedOut(ctx.Err())
+		case <-time.After(c.PollInterval.Duration()):
+		}
+	}
+}
+
+type prunedVersionRow struct {
+	BuildID    string    `json:"buildId"`
+	CreateTime time.Time `json:"createTime"`
+	Deleted    bool      `json:"deleted"`
+}
+
+func (c *TemporalWorkerDeploymentPruneCommand) run(cctx *CommandContext, args []string) error {
+	if c.KeepLast < 0 {
+		return fmt.Errorf("keep last cannot be negative")
+	}
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	dHandle := cl.WorkerDeploymentClient().GetHandle(c.DeploymentName)
+	resp, err := dHandle.Describe(cctx, client.WorkerDeploymentDescribeOptions{})
+	if err != nil {
+		return fmt.Errorf("error describing worker deployment: %w", err)
+	}
+	versions := slices.Clone(resp.Info.VersionSummaries)
+	slices.SortFunc(versions, func(a, b client.WorkerDeploymentVersionSummary) int {
+		return b.CreateTime.Compare(a.CreateTime)
+	})
+	rows := []*prunedVersionRow{}
+	for _, v := range versions[min(c.KeepLast, len(versions)):] {
+		if v.DrainageStatus != client.WorkerDeploymentVersionDrainageStatusDrained {
+			continue
+		}
+		hasPollers, err := c.versionHasPollers(cctx, cl, v.Version.BuildID)
+		if err != nil {
+			return err
+		} else if !hasPollers {
+			rows = append(rows, &prunedVersionRow{BuildID: v.Version.BuildID, CreateTime: v.CreateTime})
+		}
+	}
+
+	var failures int
+	switch {
+	case len(rows) == 0:
+		if !cctx.JSONOutput {
+			cctx.Printer.Println("No versions to prune")
+		}
+	case c.DryRun:
+		if !cctx.JSONOutput {
+			for _, row := range rows {
+				cctx.Printer.Printlnf("Would delete build ID %v", row.BuildID)
+			}
+		}
+	default:
+		yes, err := cctx.promptYes(fmt.Sprintf("Delete %v version(s) of deployment %v? y/N", len(rows), c.DeploymentName), c.Yes)
+		if err != nil {
+			return err
+		} else if !yes {
+			return fmt.Errorf("user denied confirmation")
+		}
+		for _, row := range rows {
+			_, err := dHandle.DeleteVersion(cctx, client.WorkerDeploymentDeleteVersionOptions{
+				BuildID:  row.BuildID,
+				Identity: c.Parent.Parent.Identity,
+			})
+			if err != nil {
+				failures++
+				if !cctx.JSONOutput {
+					cctx.Printer.Printlnf("Failed deleting build ID %v: %v", row.BuildID, err)
+				}
+				continue
+			}
+			row.Deleted = true
+			if !cctx.JSONOutput {
+				cctx.Printer.Printlnf("Deleted build ID %v", row.BuildID)
+			}
+		}
+	}
+	if cctx.JSONOutput {
+		if err := cctx.Printer.PrintStructured(rows, printer.StructuredOptions{}); err != nil {
+			return err
+		}
+	}
+	if failures > 0 {
+		return fmt.Errorf("failed deleting %v version(s)", failures)
+	}
+	return nil
+}
+
+// versionHasPollers returns whether any task queue of the version was recently
+// polled by its workers, which is also checked when deleting the version.
+func (c *TemporalWorkerDeploymentPruneCommand) versionHasPollers(
+	cctx *CommandContext,
+	cl client.Client,
+	buildID string,
+) (bool, error) {
+	resp, err := cl.WorkflowService().DescribeWorkerDeploymentVersion(cctx, &workflowservice.DescribeWorkerDeploymentVersionRequest{
+		Namespace: c.Parent.Parent.Namespace,
+		DeploymentVersion: &deploymentpb.WorkerDeploymentVersion{
+			DeploymentName: c.DeploymentName,
+			BuildId:        buildID,
+		},
+	})
+	if err != nil {
+		return false, fmt.Errorf("error describing worker deployment version: %w", err)
+	}
+	for _, tq := range resp.GetVersionTaskQueues() {
+		tqResp, err := cl.WorkflowService().DescribeTaskQueue(cctx, &workflowservice.DescribeTaskQueueRequest{
+			Namespace:     c.Parent.Parent.Namespace,
+			TaskQueue:     &taskqueue.TaskQueue{Name: tq.GetName(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			TaskQueueType: tq.GetType(),
+		})
+		if err != nil {
+			return false, fmt.Errorf("unable to describe task queue %v: %w", tq.GetName(), err)
+		}
+		for _, poller := range tqResp.GetPollers() {
+			opts := poller.GetDeploymentOptions()
+			if opts.GetDeploymentName() == c.DeploymentName && opts.GetBuildId() == buildID {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,118 @@
+package temporalcli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/batch/v1"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+)
+
+func (c *TemporalWorkerDeploymentMigratePinnedCommand) run(cctx *CommandContext, args []string) error {
+	if c.FromBuildId == c.ToBuildId {
+		return fmt.Errorf("from and to build IDs must differ")
+	} else if c.Rps < 0 {
+		return fmt.Errorf("rps cannot be negative")
+	}
+	loop := c.Mode.Value == "loop"
+	if loop && c.Wait {
+		return fmt.Errorf("cannot set wait in loop mode")
+	} else if !loop && (c.ReportFile != "" || c.Command.Flags().Changed("concurrency")) {
+		return fmt.Errorf("cannot set concurrency or report file in batch mode")
+	}
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	// Workflows pinned to a version that does not exist would not run again
+	_, err = cl.WorkflowService().DescribeWorkerDeploymentVersion(cctx, &workflowservice.DescribeWorkerDeploymentVersionRequest{
+		Namespace:         c.Parent.Parent.Namespace,
+		DeploymentVersion: workerDeploymentVersionToProto(c.DeploymentName, c.ToBuildId),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing worker deployment version %v: %w", c.ToBuildId, err)
+	}
+
+	options, mask, err := workflowExecutionOptionsForVersioningOverride(versioningOverrideToProto(
+		&client.PinnedVersioningOverride{
+			Version: worker.WorkerDeploymentVersion{DeploymentName: c.DeploymentName, BuildID: c.ToBuildId},
+		}))
+	if err != nil {
+		return fmt.Errorf("invalid field mask: %w", err)
+	}
+	req := &workflowservice.StartBatchOperationRequest{
+		MaxOperationsPerSecond: c.Rps,
+		Namespace:              c.Parent.Parent.Namespace,
+		JobId:                  uuid.NewString(),
+		VisibilityQuery:        pinnedWorkflowsQuery(c.DeploymentName, c.FromBuildId),
+		Reason:                 defaultReason(),
+		Operation: &workflowservice.StartBatchOperationRequest_UpdateWorkflowOptionsOperation{
+			UpdateWorkflowOptionsOperation: &batch.BatchOperationUpdateWorkflowExecutionOptions{
+				Identity:                 c.Parent.Parent.Identity,
+				WorkflowExecutionOptions: options,
+				UpdateMask:               mask,
+			},
+		},
+	}
+	if c.DryRun {
+		return previewBatchJob(cctx, cl, req, c.DryRunLimit)
+	}
+
+	if loop {
+		bulk := BulkExecutionOptions{
+			Query:       req.VisibilityQuery,
+			Concurrency: c.Concurrency,
+			Rps:         c.Rps,
+			ReportFile:  c.ReportFile,
+			Yes:         c.Yes,
+		}
+		return bulk.runBulk(cctx, cl, req.Namespace, "migrate-pinned",
+			func(ctx context.Context, item *bulkWorkflowItem) (json.RawMessage, error) {
+				_, err := cl.WorkflowService().UpdateWorkflowExecutionOptions(ctx, &workflowservice.UpdateWorkflowExecutionOptionsRequest{
+					Namespace:                req.Namespace,
+					WorkflowExecution:        &common.WorkflowExecution{WorkflowId: item.WorkflowId, RunId: item.RunId},
+					WorkflowExecutionOptions: options,
+					UpdateMask:               mask,
+					Identity:                 c.Parent.Parent.Identity,
+				})
+				return nil, err
+			})
+	}
+
+	// The count is only used in the confirmation prompt, as for other batches
+	promptMessage := fmt.Sprintf("Start batch moving workflows pinned to build ID %v to build ID %v? y/N",
+		c.FromBuildId, c.ToBuildId)
+	if !c.Yes {
+		count, err := cl.CountWorkflow(cctx, &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: req.Namespace,
+			Query:     req.VisibilityQuery,
+		})
+		if err != nil {
+			return fmt.Errorf("failed counting workflows from query: %w", err)
+		}
+		promptMessage = fmt.Sprintf("Start batch moving approximately %v workflow(s) pinned to build ID %v to build ID %v? y/N",
+			count.Count, c.FromBuildId, c.ToBuildId)
+	}
+	yes, err := cctx.promptYes(promptMessage, c.Yes)
+	if err != nil {
+		return err
+	} else if !yes {
+		return fmt.Errorf("user denied confirmation")
+	}
+	return startBatchJob(cctx, cl, req, BatchJobOptions{Wait: c.Wait})
+}
+
+// pinnedWorkflowsQuery matches the running workflows pinned to a version, as
+// the server does when computing drainage.
+func pinnedWorkflowsQuery(deploymentName, buildID string) string {
+	return fmt.Sprintf("TemporalWorkerDeploymentVersion = '%s:%s' AND "+
+		"TemporalWorkflowVersioningBehavior = 'Pinned' AND ExecutionStatus = 'Running'",
+		deploymentName, buildID)
+}
@@ -0,0 +1,307 @@
+package temporalcli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	deploymentpb "go.temporal.io/api/deployment/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// errRolloutComplete is returned when validating a deployment whose current
+// version is already the one being rolled out.
+var errRolloutComplete = errors.New("rollout already complete")
+
+// deploymentRollout is the state of a rollout, which is rebuilt from the
+// deployment's routing config when resumed.
+type deploymentRollout struct {
+	cctx  *CommandContext
+	cl    client.Client
+	c     *TemporalWorkerDeploymentRolloutCommand
+	steps []float32
+
+	// Ramp percentage of the version and when it was set, zero until ramped
+	percentage float32
+	rampedTime time.Time
+	// Token of the last update, so updates fail if anything else changed the
+	// deployment in between
+	conflictToken []byte
+
+	// Task counts of the version's workers since the ramp was set, and the
+	// time of the last heartbeat counted per worker so none is counted twice
+	processed, failed int64
+	heartbeatTimes    map[string]time.Time
+}
+
+// rolloutPollInterval is how often worker heartbeats are collected while
+// waiting, often enough not to miss any heartbeat of a live worker.
+const rolloutPollInterval = 10 * time.Second
+
+func (c *TemporalWorkerDeploymentRolloutCommand) run(cctx *CommandContext, args []string) error {
+	steps, err := parseRolloutSteps(c.Steps)
+	if err != nil {
+		return err
+	} else if c.Interval.Duration() < 0 {
+		return fmt.Errorf("interval cannot be negative")
+	} else if c.MaxBacklog < 0 {
+		return fmt.Errorf("max backlog cannot be negative")
+	}
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	r := &deploymentRollout{cctx: cctx, cl: cl, c: c, steps: steps, heartbeatTimes: map[string]time.Time{}}
+	r.conflictToken, err = c.Parent.getConflictToken(cctx, &getDeploymentConflictTokenOptions{
+		safeMode:        !c.Yes,
+		safeModeMessage: "Ramping",
+		deploymentName:  c.DeploymentName,
+		validate:        r.resume,
+	})
+	if errors.Is(err, errRolloutComplete) {
+		cctx.Printer.Printlnf("Build ID %v is already the current version", c.BuildId)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if r.percentage > 0 {
+		cctx.Printer.Printlnf("Resuming rollout of build ID %v at %v%% ramp", c.BuildId, r.percentage)
+	}
+
+	for {
+		if r.percentage > 0 {
+			if err := r.waitInterval(); err != nil {
+				return err
+			}
+			reason, err := r.checkGates()
+			if err != nil {
+				return err
+			} else if reason != "" {
+				return r.gateFailed(reason)
+			}
+		}
+		next := r.nextStep()
+		if next == 0 {
+			cctx.Printer.Printlnf("Rollout of build ID %v finished at %v%% ramp", c.BuildId, r.percentage)
+			return nil
+		} else if next == 100 {
+			return r.setCurrent()
+		} else if err := r.setRamping(next); err != nil {
+			return err
+		}
+	}
+}
+
+// parseRolloutSteps parses comma-separated percentages that must increase and
+// be in (0,100].
+func parseRolloutSteps(s string) ([]float32, error) {
+	var steps []float32
+	for _, str := range strings.Split(s, ",") {
+		step, err := strconv.ParseFloat(strings.TrimSpace(str), 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid step %q: %w", str, err)
+		} else if step <= 0 || step > 100 {
+			return nil, fmt.Errorf("step %v not in range (0,100]", step)
+		} else if len(steps) > 0 && float32(step) <= steps[len(steps)-1] {
+			return nil, fmt.Errorf("steps must be increasing")
+		}
+		steps = append(steps, float32(step))
+	}
+	return steps, nil
+}
+
+// resume checks that the deployment can be rolled out to the version and picks
+// up the ramp of a previous rollout.
+func (r *deploymentRollout) resume(info client.WorkerDeploymentInfo) error {
+	routing := info.RoutingConfig
+	if routing.CurrentVersion != nil && routing.CurrentVersion.BuildID == r.c.BuildId {
+		return errRolloutComplete
+	} else if routing.RampingVersion == nil || routing.RampingVersionPercentage == 0 {
+		return nil
+	} else if routing.RampingVersion.BuildID != r.c.BuildId {
+		return fmt.Errorf("deployment %v is already ramping build ID %q", r.c.DeploymentName, routing.RampingVersion.BuildID)
+	}
+	r.percentage = routing.RampingVersionPercentage
+	r.rampedTime = routing.RampingVersionPercentageChangedTime
+	return nil
+}
+
+// nextStep returns the first step above the current ramp, or 0 if none.
+func (r *deploymentRollout) nextStep() float32 {
+	for _, step := range r.steps {
+		if step > r.percentage {
+			return step
+		}
+	}
+	return 0
+}
+
+// waitInterval waits until the interval has passed since the ramp was set,
+// which may be less than a whole interval when resuming, collecting task counts
+// from worker heartbeats meanwhile.
+func (r *deploymentRollout) waitInterval() error {
+	deadline := r.rampedTime.Add(r.c.Interval.Duration())
+	if wait := time.Until(deadline); wait > 0 {
+		r.cctx.Printer.Printlnf("Waiting %v before checking gates", wait.Round(time.Second))
+	}
+	for {
+		if err := r.collectTaskCounts(); err != nil {
+			return err
+		}
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			return nil
+		}
+		select {
+		case <-r.cctx.Done():
+			return fmt.Errorf("rollout interrupted at %v%% ramp, run again to resume: %w", r.percentage, r.cctx.Err())
+		case <-time.After(min(wait, rolloutPollInterval)):
+		}
+	}
+}
+
+func (r *deploymentRollout) setRamping(percentage float32) error {
+	resp, err := r.cl.WorkerDeploymentClient().GetHandle(r.c.DeploymentName).SetRampingVersion(r.cctx,
+		client.WorkerDeploymentSetRampingVersionOptions{
+			BuildID:                 r.c.BuildId,
+			Percentage:              percentage,
+			ConflictToken:           r.conflictToken,
+			Identity:                r.c.Parent.Parent.Identity,
+			IgnoreMissingTaskQueues: r.c.IgnoreMissingTaskQueues,
+		})
+	if err != nil {
+		return fmt.Errorf("failed ramping build ID %v to %v%%: %w", r.c.BuildId, percentage, err)
+	}
+	r.conflictToken = resp.ConflictToken
+	r.percentage = percentage
+	r.rampedTime = time.Now()
+	r.processed, r.failed = 0, 0
+	r.cctx.Printer.Printlnf("Ramped build ID %v to %v%%", r.c.BuildId, percentage)
+	return nil
+}
+
+func (r *deploymentRollout) setCurrent() error {
+	_, err := r.cl.WorkerDeploymentClient().GetHandle(r.c.DeploymentName).SetCurrentVersion(r.cctx,
+		client.WorkerDeploymentSetCurrentVersionOptions{
+			BuildID:                 r.c.BuildId,
+			ConflictToken:           r.conflictToken,
+			Identity:                r.c.Parent.Parent.Identity,
+			IgnoreMissingTaskQueues: r.c.IgnoreMissingTaskQueues,
+		})
+	if err != nil {
+		return fmt.Errorf("failed setting build ID %v as current version: %w", r.c.BuildId, err)
+	}
+	r.cctx.Printer.Printlnf("Rollout complete, build ID %v is the current version", r.c.BuildId)
+	return nil
+}
+
+// checkGates returns why the version is unhealthy at its current ramp, or an
+// empty string if all gates pass.
+func (r *deploymentRollout) checkGates() (string, error) {
+	var failureRate float64
+	if r.processed > 0 {
+		failureRate = float64(r.failed) / float64(r.processed) * 100
+	}
+	summary := fmt.Sprintf("task failure rate %.1f%% of %v task(s)", failureRate, r.processed)
+	var backlog int64
+	if r.c.MaxBacklog > 0 {
+		var err error
+		if backlog, err = r.backlogCount(); err != nil {
+			return "", err
+		}
+		summary += fmt.Sprintf(", backlog %v", backlog)
+	}
+	r.cctx.Printer.Printlnf("Gates at %v%% ramp: %v", r.percentage, summary)
+
+	if failureRate > float64(r.c.MaxFailureRate) {
+		return fmt.Sprintf("task failure rate %.1f%% exceeds %v%%", failureRate, r.c.MaxFailureRate), nil
+	} else if r.c.MaxBacklog > 0 && backlog > int64(r.c.MaxBacklog) {
+		return fmt.Sprintf("backlog %v exceeds %v", backlog, r.c.MaxBacklog), nil
+	}
+	return "", nil
+}
+
+// collectTaskCounts adds the last interval task counts of heartbeats from the
+// version's workers that were not counted yet. Heartbeats from before the
+// current ramp, and of workers that stopped, are skipped.
+func (r *deploymentRollout) collectTaskCounts() error {
+	now := time.Now()
+	var token []byte
+	for {
+		resp, err := r.cl.WorkflowService().ListWorkers(r.cctx, &workflowservice.ListWorkersRequest{
+			Namespace:     r.c.Parent.Parent.Namespace,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return fmt.Errorf("failed listing workers: %w", err)
+		}
+		for _, info := range resp.GetWorkersInfo() {
+			hb := info.GetWorkerHeartbeat()
+			if hb.GetDeploymentVersion().GetDeploymentName() != r.c.DeploymentName ||
+				hb.GetDeploymentVersion().GetBuildId() != r.c.BuildId {
+				continue
+			}
+			heartbeatTime := timestampToTime(hb.GetHeartbeatTime())
+			if now.Sub(heartbeatTime) > defaultWorkerStaleAfter ||
+				!heartbeatTime.After(r.rampedTime) ||
+				!heartbeatTime.After(r.heartbeatTimes[hb.GetWorkerInstanceKey()]) {
+				continue
+			}
+			r.heartbeatTimes[hb.GetWorkerInstanceKey()] = heartbeatTime
+			p, f := workerHeartbeatTaskCounts(hb)
+			r.processed += int64(p)
+			r.failed += int64(f)
+		}
+		if token = resp.GetNextPageToken(); len(token) == 0 {
+			return nil
+		}
+	}
+}
+
+// backlogCount sums the approximate backlog of the version's task queues.
+func (r *deploymentRollout) backlogCount() (int64, error) {
+	resp, err := r.cl.WorkflowService().DescribeWorkerDeploymentVersion(r.cctx, &workflowservice.DescribeWorkerDeploymentVersionRequest{
+		Namespace: r.c.Parent.Parent.Namespace,
+		DeploymentVersion: &deploymentpb.WorkerDeploymentVersion{
+			DeploymentName: r.c.DeploymentName,
+			BuildId:        r.c.BuildId,
+		},
+		ReportTaskQueueStats: true,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error describing worker deployment version: %w", err)
+	}
+	var backlog int64
+	for _, tq := range resp.GetVersionTaskQueues() {
+		backlog += tq.GetStats().GetApproximateBacklogCount()
+	}
+	return backlog, nil
+}
+
+// gateFailed pauses the rollout at its current ramp or rolls it back, and
+// returns the error the command fails with.
+func (r *deploymentRollout) gateFailed(reason string) error {
+	if r.c.OnFailure.Value != "rollback" {
+		return fmt.Errorf("rollout paused at %v%% ramp, run again to resume: %v", r.percentage, reason)
+	}
+	// Unsetting the ramping version sends all tasks back to the current
+	// version, which the rollout never changed
+	_, err := r.cl.WorkerDeploymentClient().GetHandle(r.c.DeploymentName).SetRampingVersion(r.cctx,
+		client.WorkerDeploymentSetRampingVersionOptions{
+			BuildID:                 "",
+			Percentage:              0,
+			ConflictToken:           r.conflictToken,
+			Identity:                r.c.Parent.Parent.Identity,
+			IgnoreMissingTaskQueues: r.c.IgnoreMissingTaskQueues,
+		})
+	if err != nil {
+		return fmt.Errorf("failed rolling back after %v: %w", reason, err)
+	}
+	r.cctx.Printer.Printlnf("Removed ramp of build ID %v", r.c.BuildId)
+	return fmt.Errorf("rollout rolled back: %v", reason)
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
@@ -12,9 +14,11 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/temporalio/cli/internal/devserver"
 	"go.temporal.io/api/common/v1"
 	deploymentpb "go.temporal.io/api/deployment/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	workerpb "go.temporal.io/api/worker/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/converter"
@@ -22,6 +26,7 @@ import (
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type jsonVersionSummariesRowType struct {
@@ -740,6 +745,396 @@ func (s *SharedServerSuite) TestDeployment_Ramping() {
 	s.Equal(version2.BuildID, jsonOut.RoutingConfig.CurrentVersionBuildID)
 }
 
+func (s *SharedServerSuite) TestDeployment_Rollout() {
+	deploymentName := uuid.NewString()
+	version1 := worker.WorkerDeploymentVersion{DeploymentName: deploymentName, BuildID: "a" + uuid.NewString()}
+	version2 := worker.WorkerDeploymentVersion{DeploymentName: deploymentName, BuildID: "b" + uuid.NewString()}
+	for _, version := range []worker.WorkerDeploymentVersion{version1, version2} {
+		w := s.DevServer.StartDevWorker(s.Suite.T(), DevWorkerOptions{
+			Worker: worker.Options{
+				DeploymentOptions: worker.DeploymentOptions{
+					UseVersioning:             true,
+					Version:                   version,
+					DefaultVersioningBehavior: workflow.VersioningBehaviorPinned,
+				},
+			},
+		})
+		defer w.Stop()
+	}
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		for _, version := range []worker.WorkerDeploymentVersion{version1, version2} {
+			res := s.Execute(
+				"worker", "deployment", "describe-version",
+				"--address", s.Address(),
+				"--deployment-name", version.DeploymentName, "--build-id", version.BuildID,
+			)
+			assert.NoError(t, res.Err)
+		}
+	}, 30*time.Second, 100*time.Millisecond)
+	res := s.Execute(
+		"worker", "deployment", "set-current-version",
+		"--address", s.Address(),
+		"--deployment-name", version1.DeploymentName, "--build-id", version1.BuildID,
+		"--yes",
+	)
+	s.NoError(res.Err)
+	describe := func() jsonRoutingConfigType {
+		res := s.Execute(
+			"worker", "deployment", "describe",
+			"--address", s.Address(),
+			"--name", deploymentName,
+			"--output", "json",
+		)
+		s.NoError(res.Err)
+		var jsonOut jsonDeploymentInfoType
+		s.NoError(json.Unmarshal(res.Stdout.Bytes(), &jsonOut))
+		return jsonOut.RoutingConfig
+	}
+
+	// Healthy rollout ramps then makes the version current
+	res = s.Execute(
+		"worker", "deployment", "rollout",
+		"--address", s.Address(),
+		"--deployment-name", version2.DeploymentName, "--build-id", version2.BuildID,
+		"--steps", "50,100", "--interval", "0s",
+		"--yes",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "Ramped build ID "+version2.BuildID+" to 50%")
+	s.Contains(res.Stdout.String(), "Rollout complete")
+	routing := describe()
+	s.Equal(version2.BuildID, routing.CurrentVersionBuildID)
+	s.Empty(routing.RampingVersionBuildID)
+
+	// Failing tasks of version 1 fail the gate after its first step. Only the
+	// heartbeat sent after the ramp is counted, so neither the one from before
+	// it nor the stale one of a worker that stopped long ago dilute failures.
+	// The rollout checks right after ramping, so the counted heartbeat is
+	// recorded ahead with a later time.
+	taskQueue := "rollout-tq-" + uuid.NewString()
+	heartbeat := func(heartbeatTime time.Time, processed, failed int32) *workerpb.WorkerHeartbeat {
+		return &workerpb.WorkerHeartbeat{
+			WorkerInstanceKey: uuid.NewString(),
+			TaskQueue:         taskQueue,
+			DeploymentVersion: &deploymentpb.WorkerDeploymentVersion{
+				DeploymentName: version1.DeploymentName,
+				BuildId:        version1.BuildID,
+			},
+			HeartbeatTime: timestamppb.New(heartbeatTime),
+			ActivityTaskSlotsInfo: &workerpb.WorkerSlotsInfo{
+				LastIntervalProcessedTasks: processed,
+				LastIntervalFailureTasks:   failed,
+			},
+		}
+	}
+	_, err := s.Client.WorkflowService().RecordWorkerHeartbeat(s.Context, &workflowservice.RecordWorkerHeartbeatRequest{
+		Namespace: s.Namespace(),
+		WorkerHeartbeat: []*workerpb.WorkerHeartbeat{
+			heartbeat(time.Now().Add(time.Minute), 10, 5),
+			heartbeat(time.Now().Add(-time.Second), 100, 0),
+			heartbeat(time.Now().Add(-time.Hour), 100, 100),
+		},
+	})
+	s.NoError(err)
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		resp, err := s.Client.WorkflowService().ListWorkers(s.Context, &workflowservice.ListWorkersRequest{
+			Namespace: s.Namespace(),
+			Query:     fmt.Sprintf("TaskQueue=%q", taskQueue),
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetWorkersInfo(), 3)
+	}, 30*time.Second, 100*time.Millisecond)
+
+	res = s.Execute(
+		"worker", "deployment", "rollout",
+		"--address", s.Address(),
+		"--deployment-name", version1.DeploymentName, "--build-id", version1.BuildID,
+		"--steps", "25,100", "--interval", "0s",
+		"--yes",
+	)
+	s.ErrorContains(res.Err, "rollout paused at 25% ramp")
+	s.ErrorContains(res.Err, "task failure rate 50.0% exceeds 5%")
+	routing = describe()
+	s.Equal(version2.BuildID, routing.CurrentVersionBuildID)
+	s.Equal(version1.BuildID, routing.RampingVersionBuildID)
+	s.Equal(float32(25), routing.RampingVersionPercentage)
+
+	// Resuming checks the gate again, this time rolling back
+	res = s.Execute(
+		"worker", "deployment", "rollout",
+		"--address", s.Address(),
+		"--deployment-name", version1.DeploymentName, "--build-id", version1.BuildID,
+		"--steps", "25,100", "--interval", "0s",
+		"--on-failure", "rollback",
+		"--yes",
+	)
+	s.ErrorContains(res.Err, "rollout rolled back")
+	s.Contains(res.Stdout.String(), "Resuming rollout of build ID "+version1.BuildID+" at 25% ramp")
+	routing = describe()
+	s.Equal(version2.BuildID, routing.CurrentVersionBuildID)
+	s.Empty(routing.RampingVersionBuildID)
+	s.Equal(float32(0), routing.RampingVersionPercentage)
+}
+
+func (s *SharedServerSuite) TestDeployment_WaitDrained_Prune() {
+	// Separate server so versions drain, and stopped pollers are forgotten,
+	// quickly
+	server := StartDevServer(s.Suite.T(), DevServerOptions{
+		StartOptions: devserver.StartOptions{
+			DynamicConfigValues: map[string]any{
+				"matching.wv.VersionDrainageStatusVisibilityGracePeriod": time.Second,
+				"matching.wv.VersionDrainageStatusRefreshInterval":       time.Second,
+				// Pollers poll again before they are forgotten
+				"matching.longPollExpirationInterval": time.Second,
+				"matching.PollerHistoryTTL":           3 * time.Second,
+			},
+		},
+	})
+	defer server.Stop()
+
+	deploymentName := uuid.NewString()
+	var buildIDs []string
+	var workers []*DevWorker
+	for _, prefix := range []string{"a", "b", "c"} {
+		version := worker.WorkerDeploymentVersion{DeploymentName: deploymentName, BuildID: prefix + uuid.NewString()}
+		w := server.StartDevWorker(s.Suite.T(), DevWorkerOptions{
+			Worker: worker.Options{
+				DeploymentOptions: worker.DeploymentOptions{
+					UseVersioning:             true,
+					Version:                   version,
+					DefaultVersioningBehavior: workflow.VersioningBehaviorPinned,
+				},
+			},
+		})
+		defer w.Stop()
+		// Wait for each so versions are created in order
+		s.EventuallyWithT(func(t *assert.CollectT) {
+			res := s.Execute(
+				"worker", "deployment", "describe-version",
+				"--address", server.Address(),
+				"--deployment-name", deploymentName, "--build-id", version.BuildID,
+			)
+			assert.NoError(t, res.Err)
+		}, 30*time.Second, 100*time.Millisecond)
+		buildIDs = append(buildIDs, version.BuildID)
+		workers = append(workers, w)
+	}
+	for _, buildID := range buildIDs {
+		res := s.Execute(
+			"worker", "deployment", "set-current-version",
+			"--address", server.Address(),
+			"--deployment-name", deploymentName, "--build-id", buildID,
+			"--yes",
+		)
+		s.NoError(res.Err)
+	}
+
+	res := s.Execute(
+		"worker", "deployment", "wait-drained",
+		"--address", server.Address(),
+		"--deployment-name", deploymentName, "--build-id", buildIDs[2],
+	)
+	s.ErrorContains(res.Err, "is the current version and will not drain")
+	for _, buildID := range buildIDs[:2] {
+		res = s.Execute(
+			"worker", "deployment", "wait-drained",
+			"--address", server.Address(),
+			"--deployment-name", deploymentName, "--build-id", buildID,
+			"--timeout", "1m", "--poll-interval", "200ms",
+		)
+		s.NoError(res.Err)
+		s.Contains(res.Stdout.String(), "Build ID "+buildID+" is drained")
+	}
+
+	// Drained versions are not pruned while polled
+	res = s.Execute(
+		"worker", "deployment", "prune",
+		"--address", server.Address(),
+		"--deployment-name", deploymentName,
+		"--dry-run",
+	)
+	s.NoError(res.Err)
+	s.Equal("No versions to prune\n", res.Stdout.String())
+
+	workers[0].Stop()
+	workers[1].Stop()
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		res := s.Execute(
+			"worker", "deployment", "prune",
+			"--address", server.Address(),
+			"--deployment-name", deploymentName,
+			"--keep-last", "2",
+			"--dry-run",
+		)
+		assert.NoError(t, res.Err)
+		assert.Equal(t, "Would delete build ID "+buildIDs[0]+"\n", res.Stdout.String())
+	}, 30*time.Second, 500*time.Millisecond)
+
+	res = s.Execute(
+		"worker", "deployment", "prune",
+		"--address", server.Address(),
+		"--deployment-name", deploymentName,
+		"--keep-last", "2",
+		"--yes",
+		"--output", "json",
+	)
+	s.NoError(res.Err)
+	var pruned []struct {
+		BuildID string `json:"buildId"`
+		Deleted bool   `json:"deleted"`
+	}
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &pruned))
+	s.Len(pruned, 1)
+	s.Equal(buildIDs[0], pruned[0].BuildID)
+	s.True(pruned[0].Deleted)
+
+	res = s.Execute(
+		"worker", "deployment", "describe",
+		"--address", server.Address(),
+		"--name", deploymentName,
+		"--output", "json",
+	)
+	s.NoError(res.Err)
+	var jsonOut jsonDeploymentInfoType
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &jsonOut))
+	var remaining []string
+	for _, summary := range jsonOut.VersionSummaries {
+		remaining = append(remaining, summary.BuildID)
+	}
+	s.ElementsMatch(buildIDs[1:], remaining)
+}
+
+func (s *SharedServerSuite) TestDeployment_MigratePinned() {
+	deploymentName := uuid.NewString()
+	taskQueue := uuid.NewString()
+	version1 := worker.WorkerDeploymentVersion{DeploymentName: deploymentName, BuildID: "a" + uuid.NewString()}
+	version2 := worker.WorkerDeploymentVersion{DeploymentName: deploymentName, BuildID: "b" + uuid.NewString()}
+	for _, version := range []worker.WorkerDeploymentVersion{version1, version2} {
+		w := s.DevServer.StartDevWorker(s.Suite.T(), DevWorkerOptions{
+			TaskQueue: taskQueue,
+			Worker: worker.Options{
+				DeploymentOptions: worker.DeploymentOptions{
+					UseVersioning:             true,
+					Version:                   version,
+					DefaultVersioningBehavior: workflow.VersioningBehaviorPinned,
+				},
+			},
+		})
+		defer w.Stop()
+		w.OnDevWorkflow(func(ctx workflow.Context, a any) (any, error) {
+			return nil, workflow.Await(ctx, func() bool { return false })
+		})
+	}
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		for _, version := range []worker.WorkerDeploymentVersion{version1, version2} {
+			res := s.Execute(
+				"worker", "deployment", "describe-version",
+				"--address", s.Address(),
+				"--deployment-name", version.DeploymentName, "--build-id", version.BuildID,
+			)
+			assert.NoError(t, res.Err)
+		}
+	}, 30*time.Second, 100*time.Millisecond)
+	res := s.Execute(
+		"worker", "deployment", "set-current-version",
+		"--address", s.Address(),
+		"--deployment-name", version1.DeploymentName, "--build-id", version1.BuildID,
+		"--yes",
+	)
+	s.NoError(res.Err)
+
+	var workflowIDs []string
+	for range 3 {
+		run, err := s.Client.ExecuteWorkflow(s.Context, client.StartWorkflowOptions{TaskQueue: taskQueue}, DevWorkflow, "ignored")
+		s.NoError(err)
+		defer s.Client.TerminateWorkflow(s.Context, run.GetID(), "", "test cleanup")
+		workflowIDs = append(workflowIDs, run.GetID())
+	}
+	pinnedTo := func(t *assert.CollectT, buildID string) {
+		for _, id := range workflowIDs {
+			desc, err := s.Client.DescribeWorkflowExecution(s.Context, id, "")
+			if !assert.NoError(t, err) {
+				return
+			}
+			pinned := desc.GetWorkflowExecutionInfo().GetVersioningInfo().GetVersioningOverride().GetPinned().GetVersion()
+			assert.Equal(t, buildID, pinned.GetBuildId())
+		}
+	}
+
+	// Target version must exist and differ
+	res = s.Execute(
+		"worker", "deployment", "migrate-pinned",
+		"--address", s.Address(),
+		"--deployment-name", deploymentName,
+		"--from-build-id", version1.BuildID, "--to-build-id", version1.BuildID,
+	)
+	s.ErrorContains(res.Err, "from and to build IDs must differ")
+	res = s.Execute(
+		"worker", "deployment", "migrate-pinned",
+		"--address", s.Address(),
+		"--deployment-name", deploymentName,
+		"--from-build-id", version1.BuildID, "--to-build-id", "missing",
+	)
+	s.ErrorContains(res.Err, "error describing worker deployment version missing")
+
+	// Dry run previews the workflows pinned to the version once visible
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		res := s.Execute(
+			"worker", "deployment", "migrate-pinned",
+			"--address", s.Address(),
+			"--deployment-name", deploymentName,
+			"--from-build-id", version1.BuildID, "--to-build-id", version2.BuildID,
+			"--dry-run",
+		)
+		assert.NoError(t, res.Err)
+		assert.Contains(t, res.Stdout.String(), "Listing 3 of approximately 3 match(es)")
+	}, 30*time.Second, 200*time.Millisecond)
+
+	// Loop mode moves them one at a time and reports each
+	reportFile := filepath.Join(s.T().TempDir(), "report.jsonl")
+	res = s.Execute(
+		"worker", "deployment", "migrate-pinned",
+		"--address", s.Address(),
+		"--deployment-name", deploymentName,
+		"--from-build-id", version1.BuildID, "--to-build-id", version2.BuildID,
+		"--mode", "loop", "--report-file", reportFile,
+		"--yes", "--output", "json",
+	)
+	s.NoError(res.Err)
+	var summary struct {
+		Operation string `json:"operation"`
+		Succeeded int    `json:"succeeded"`
+	}
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &summary))
+	s.Equal("migrate-pinned", summary.Operation)
+	s.Equal(3, summary.Succeeded)
+	report, err := os.ReadFile(reportFile)
+	s.NoError(err)
+	for _, id := range workflowIDs {
+		s.Contains(string(report), `"workflowId":"`+id+`"`)
+	}
+	s.EventuallyWithT(func(t *assert.CollectT) { pinnedTo(t, version2.BuildID) }, 10*time.Second, 100*time.Millisecond)
+
+	// Batch mode moves them back once visibility has them on the new version
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		count, err := s.Client.CountWorkflow(s.Context, &workflowservice.CountWorkflowExecutionsRequest{
+			Query: fmt.Sprintf("TemporalWorkerDeploymentVersion = '%s:%s'", deploymentName, version2.BuildID),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), count.GetCount())
+	}, 30*time.Second, 200*time.Millisecond)
+	res = s.Execute(
+		"worker", "deployment", "migrate-pinned",
+		"--address", s.Address(),
+		"--deployment-name", deploymentName,
+		"--from-build-id", version2.BuildID, "--to-build-id", version1.BuildID,
+		"--wait", "--yes",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "Started batch for job ID")
+	s.EventuallyWithT(func(t *assert.CollectT) { pinnedTo(t, version1.BuildID) }, 10*time.Second, 100*time.Millisecond)
+}
+
 func (s *SharedServerSuite) TestDeployment_Set_Manager_Identity() {
 	deploymentName := uuid.NewString()
 	BuildID := uuid.NewString()
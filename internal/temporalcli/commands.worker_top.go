@@ -0,0 +1,225 @@
+package temporalcli
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/temporalio/cli/internal/printer"
+	"github.com/temporalio/cli/internal/tracer"
+	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+)
+
+type workerTopRow struct {
+	WorkerInstanceKey string    `json:"workerInstanceKey"`
+	WorkerIdentity    string    `json:"workerIdentity"`
+	TaskQueue         string    `json:"taskQueue"`
+	Status            string    `json:"status"`
+	SlotsUsed         int32     `json:"slotsUsed"`
+	SlotsAvailable    int32     `json:"slotsAvailable"`
+	TasksPerSec       float64   `json:"tasksPerSec"`
+	FailureRate       string    `json:"failureRate"`
+	HeartbeatAge      string    `json:"heartbeatAge"`
+	Overdue           bool      `json:"overdue"`
+	HeartbeatTime     time.Time `json:"heartbeatTime" cli:",omit"`
+
+	failureRate  float64
+	heartbeatAge time.Duration
+}
+
+type workerTopRefresh struct {
+	Time    time.Time       `json:"time"`
+	Workers []*workerTopRow `json:"workers"`
+}
+
+func (c *TemporalWorkerTopCommand) run(cctx *CommandContext, args []string) error {
+	if c.Interval.Duration() <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+	cl, err := dialClient(cctx, &c.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	var writer *tracer.TermWriter
+	if cctx.JSONOutput {
+		// Each refresh is a list item, so a line each in JSONL mode
+		cctx.Printer.StartList()
+		defer cctx.Printer.EndList()
+	} else {
+		writer = tracer.NewTermWriter(cctx.Printer.Output)
+	}
+	for i := 1; ; i++ {
+		rows, err := c.fetchRows(cctx, cl)
+		if err != nil {
+			return err
+		}
+		refresh := workerTopRefresh{Time: time.Now(), Workers: rows}
+		if cctx.JSONOutput {
+			if err := cctx.Printer.PrintStructured(refresh, printer.StructuredOptions{}); err != nil {
+				return err
+			}
+		} else if err := c.render(cctx, writer, &refresh); err != nil {
+			return err
+		}
+
+		if c.Iterations > 0 && i >= c.Iterations {
+			return nil
+		}
+		select {
+		case <-cctx.Done():
+			return nil
+		case <-time.After(c.Interval.Duration()):
+		}
+	}
+}
+
+func (c *TemporalWorkerTopCommand) fetchRows(cctx *CommandContext, cl client.Client) ([]*workerTopRow, error) {
+	now := time.Now()
+	var rows []*workerTopRow
+	var token []byte
+	for {
+		resp, err := cl.WorkflowService().ListWorkers(cctx, &workflowservice.ListWorkersRequest{
+			Namespace:            c.Parent.Namespace,
+			NextPageToken:        token,
+			Query:                c.Query,
+			IncludeSystemWorkers: c.IncludeSystemWorkers,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range resp.GetWorkersInfo() {
+			if hb := info.GetWorkerHeartbeat(); hb != nil {
+				rows = append(rows, formatWorkerTopRow(hb, now, c.StaleAfter.Duration()))
+			}
+		}
+		if token = resp.GetNextPageToken(); len(token) == 0 {
+			break
+		}
+	}
+	sortWorkerTopRows(rows, c.Sort.Value)
+	return rows, nil
+}
+
+func (c *TemporalWorkerTopCommand) render(
+	cctx *CommandContext,
+	writer *tracer.TermWriter,
+	refresh *workerTopRefresh,
+) error {
+	overdue := 0
+	for _, row := range refresh.Workers {
+		if row.Overdue {
+			overdue++
+		}
+	}
+	summary := fmt.Sprintf("%v worker(s), %v overdue", len(refresh.Workers), overdue)
+	if overdue > 0 {
+		summary = color.RedString(summary)
+	}
+	_, _ = writer.WriteLine(fmt.Sprintf("%v  sorted by %v  updated %v",
+		summary, c.Sort.Value, refresh.Time.Format(time.TimeOnly)))
+	_, _ = writer.WriteLine("")
+	p := &printer.Printer{
+		Output:             writer,
+		FormatTime:         cctx.Printer.FormatTime,
+		TableHeaderColorer: cctx.Printer.TableHeaderColorer,
+	}
+	if err := p.PrintStructured(refresh.Workers, printer.StructuredOptions{Table: &printer.TableOptions{}}); err != nil {
+		return err
+	}
+	return writer.Flush(true)
+}
+
+// defaultWorkerStaleAfter is the default of worker top's --stale-after, the
+// heartbeat age after which a worker is considered gone.
+const defaultWorkerStaleAfter = 90 * time.Second
+
+func formatWorkerTopRow(hb *workerpb.WorkerHeartbeat, now time.Time, staleAfter time.Duration) *workerTopRow {
+	row := &workerTopRow{
+		WorkerInstanceKey: hb.GetWorkerInstanceKey(),
+		WorkerIdentity:    hb.GetWorkerIdentity(),
+		TaskQueue:         hb.GetTaskQueue(),
+		Status:            workerStatusToString(hb.GetStatus()),
+		HeartbeatTime:     timestampToTime(hb.GetHeartbeatTime()),
+	}
+	for _, slots := range workerHeartbeatSlots(hb) {
+		row.SlotsUsed += slots.GetCurrentUsedSlots()
+		row.SlotsAvailable += slots.GetCurrentAvailableSlots()
+	}
+	processed, failed := workerHeartbeatTaskCounts(hb)
+	// Interval counts cover the time since the previous heartbeat
+	if interval := hb.GetElapsedSinceLastHeartbeat().AsDuration(); interval > 0 {
+		row.TasksPerSec = math.Round(float64(processed)/interval.Seconds()*100) / 100
+	}
+	if processed > 0 {
+		row.failureRate = float64(failed) / float64(processed)
+	}
+	row.FailureRate = fmt.Sprintf("%.1f%%", row.failureRate*100)
+	if !row.HeartbeatTime.IsZero() {
+		row.heartbeatAge = max(now.Sub(row.HeartbeatTime), 0)
+		row.Overdue = row.heartbeatAge > staleAfter
+	}
+	row.HeartbeatAge = row.heartbeatAge.Truncate(time.Second).String()
+	return row
+}
+
+func workerHeartbeatSlots(hb *workerpb.WorkerHeartbeat) []*workerpb.WorkerSlotsInfo {
+	return []*workerpb.WorkerSlotsInfo{
+		hb.GetWorkflowTaskSlotsInfo(),
+		hb.GetActivityTaskSlotsInfo(),
+		hb.GetNexusTaskSlotsInfo(),
+		hb.GetLocalActivitySlotsInfo(),
+	}
+}
+
+// workerHeartbeatTaskCounts returns the tasks processed and failed across all
+// slot kinds in the interval before the heartbeat.
+func workerHeartbeatTaskCounts(hb *workerpb.WorkerHeartbeat) (processed, failed int32) {
+	for _, slots := range workerHeartbeatSlots(hb) {
+		processed += slots.GetLastIntervalProcessedTasks()
+		failed += slots.GetLastIntervalFailureTasks()
+	}
+	return processed, failed
+}
+
+// sortWorkerTopRows sorts rows by the given column, largest first for numeric
+// columns, falling back to the instance key for a stable display.
+func sortWorkerTopRows(rows []*workerTopRow, by string) {
+	slices.SortFunc(rows, func(a, b *workerTopRow) int {
+		var cmp int
+		switch by {
+		case "tasks-per-sec":
+			cmp = compareDesc(a.TasksPerSec, b.TasksPerSec)
+		case "failure-rate":
+			cmp = compareDesc(a.failureRate, b.failureRate)
+		case "slots-used":
+			cmp = compareDesc(a.SlotsUsed, b.SlotsUsed)
+		case "heartbeat-age":
+			cmp = compareDesc(a.heartbeatAge, b.heartbeatAge)
+		case "task-queue":
+			cmp = strings.Compare(a.TaskQueue, b.TaskQueue)
+		case "identity":
+			cmp = strings.Compare(a.WorkerIdentity, b.WorkerIdentity)
+		}
+		if cmp != 0 {
+			return cmp
+		}
+		return strings.Compare(a.WorkerInstanceKey, b.WorkerInstanceKey)
+	})
+}
+
+func compareDesc[T int32 | float64 | time.Duration](a, b T) int {
+	switch {
+	case a > b:
+		return -1
+	case a < b:
+		return 1
+	}
+	return 0
+}
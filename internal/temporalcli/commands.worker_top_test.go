@@ -0,0 +1,119 @@
+package temporalcli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *SharedServerSuite) TestWorkerTop() {
+	taskQueue := "top-tq-" + uuid.NewString()
+	busy := &workerpb.WorkerHeartbeat{
+		WorkerInstanceKey: "top-busy-" + uuid.NewString(),
+		WorkerIdentity:    "top-busy",
+		TaskQueue:         taskQueue,
+		Status:            enumspb.WORKER_STATUS_RUNNING,
+		StartTime:         timestamppb.New(time.Now().Add(-time.Minute)),
+		HeartbeatTime:     timestamppb.Now(),
+		// 40 tasks over 10s with 4 failures
+		ElapsedSinceLastHeartbeat: durationpb.New(10 * time.Second),
+		WorkflowTaskSlotsInfo: &workerpb.WorkerSlotsInfo{
+			CurrentUsedSlots:           3,
+			CurrentAvailableSlots:      7,
+			LastIntervalProcessedTasks: 30,
+			LastIntervalFailureTasks:   4,
+		},
+		ActivityTaskSlotsInfo: &workerpb.WorkerSlotsInfo{
+			CurrentUsedSlots:           2,
+			CurrentAvailableSlots:      8,
+			LastIntervalProcessedTasks: 10,
+		},
+	}
+	stale := &workerpb.WorkerHeartbeat{
+		WorkerInstanceKey:         "top-stale-" + uuid.NewString(),
+		WorkerIdentity:            "top-stale",
+		TaskQueue:                 taskQueue,
+		Status:                    enumspb.WORKER_STATUS_RUNNING,
+		StartTime:                 timestamppb.New(time.Now().Add(-time.Hour)),
+		HeartbeatTime:             timestamppb.New(time.Now().Add(-10 * time.Minute)),
+		ElapsedSinceLastHeartbeat: durationpb.New(10 * time.Second),
+	}
+	_, err := s.Client.WorkflowService().RecordWorkerHeartbeat(s.Context, &workflowservice.RecordWorkerHeartbeatRequest{
+		Namespace:       s.Namespace(),
+		Identity:        identity,
+		WorkerHeartbeat: []*workerpb.WorkerHeartbeat{busy, stale},
+	})
+	s.NoError(err)
+	s.waitForWorkerListJSON(taskQueue, busy.WorkerInstanceKey)
+	s.waitForWorkerListJSON(taskQueue, stale.WorkerInstanceKey)
+
+	// JSONL has a line per refresh, sorted by heartbeat age
+	res := s.Execute(
+		"worker", "top",
+		"--address", s.Address(),
+		"--query", fmt.Sprintf("TaskQueue=\"%s\"", taskQueue),
+		"--sort", "heartbeat-age",
+		"--interval", "10ms",
+		"--iterations", "2",
+		"-o", "jsonl",
+	)
+	s.NoError(res.Err)
+	dec := json.NewDecoder(&res.Stdout)
+	for range 2 {
+		var refresh struct {
+			Workers []struct {
+				WorkerInstanceKey string
+				SlotsUsed         int
+				SlotsAvailable    int
+				TasksPerSec       float64
+				FailureRate       string
+				Overdue           bool
+			}
+		}
+		s.NoError(dec.Decode(&refresh))
+		s.Len(refresh.Workers, 2)
+		s.Equal(stale.WorkerInstanceKey, refresh.Workers[0].WorkerInstanceKey)
+		s.True(refresh.Workers[0].Overdue)
+		s.Equal(busy.WorkerInstanceKey, refresh.Workers[1].WorkerInstanceKey)
+		s.False(refresh.Workers[1].Overdue)
+		s.Equal(5, refresh.Workers[1].SlotsUsed)
+		s.Equal(15, refresh.Workers[1].SlotsAvailable)
+		s.Equal(4.0, refresh.Workers[1].TasksPerSec)
+		s.Equal("10.0%", refresh.Workers[1].FailureRate)
+	}
+	s.False(dec.More())
+
+	// YAML is a list of refreshes
+	res = s.Execute(
+		"worker", "top",
+		"--address", s.Address(),
+		"--query", fmt.Sprintf("TaskQueue=\"%s\"", taskQueue),
+		"--iterations", "1",
+		"-o", "yaml",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "- time: ")
+	s.Contains(res.Stdout.String(), "workerInstanceKey: "+busy.WorkerInstanceKey)
+
+	// Text is a table with a summary
+	res = s.Execute(
+		"worker", "top",
+		"--address", s.Address(),
+		"--query", fmt.Sprintf("TaskQueue=\"%s\"", taskQueue),
+		"--iterations", "1",
+	)
+	s.NoError(res.Err)
+	out := res.Stdout.String()
+	s.Contains(out, "2 worker(s), 1 overdue")
+	s.ContainsOnSameLine(out, busy.WorkerInstanceKey, "top-busy", taskQueue, "Running", "5", "15", "4", "10.0%", "false")
+	s.ContainsOnSameLine(out, stale.WorkerInstanceKey, "top-stale", "10m", "true")
+	s.Less(strings.Index(out, busy.WorkerInstanceKey), strings.Index(out, stale.WorkerInstanceKey))
+}
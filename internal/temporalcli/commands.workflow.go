@@ -39,7 +39,7 @@ func (c *TemporalWorkflowCancelCommand) run(cctx *CommandContext, args []string)
 	}
 	defer cl.Close()
 
-	exec, batchReq, err := c.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{})
+	exec, batchReq, err := c.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{DryRun: c.DryRun, Wait: c.Wait})
 
 	// Run single or batch
 	if err != nil {
@@ -56,7 +56,7 @@ func (c *TemporalWorkflowCancelCommand) run(cctx *CommandContext, args []string)
 				Identity: c.Parent.Identity,
 			},
 		}
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -81,6 +81,8 @@ func (c *TemporalWorkflowDeleteCommand) run(cctx *CommandContext, args []string)
 
 	exec, batchReq, err := c.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{
 		AllowYesWithWorkflowID: true,
+		DryRun:                 c.DryRun,
+		Wait:                   c.Wait,
 	})
 
 	// Run single or batch
@@ -107,7 +109,7 @@ func (c *TemporalWorkflowDeleteCommand) run(cctx *CommandContext, args []string)
 				Identity: c.Parent.Identity,
 			},
 		}
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -144,7 +146,7 @@ func (c *TemporalWorkflowUpdateOptionsCommand) run(cctx *CommandContext, args []
 		}
 	}
 
-	exec, batchReq, err := c.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{})
+	exec, batchReq, err := c.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{DryRun: c.DryRun, Wait: c.Wait})
 
 	var overrideChange *client.VersioningOverrideChange
 	switch c.VersioningOverrideBehavior.Value {
@@ -213,7 +215,7 @@ func (c *TemporalWorkflowUpdateOptionsCommand) run(cctx *CommandContext, args []
 				UpdateMask:               protoMask,
 			},
 		}
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -284,7 +286,7 @@ func (c *TemporalWorkflowSignalCommand) run(cctx *CommandContext, args []string)
 		return err
 	}
 
-	exec, batchReq, err := c.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{})
+	exec, batchReq, err := c.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{DryRun: c.DryRun, Wait: c.Wait})
 
 	// Run single or batch
 	if err != nil {
@@ -311,7 +313,7 @@ func (c *TemporalWorkflowSignalCommand) run(cctx *CommandContext, args []string)
 				Identity: c.Parent.Identity,
 			},
 		}
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -343,6 +345,8 @@ func (c *TemporalWorkflowTerminateCommand) run(cctx *CommandContext, _ []string)
 	exec, batchReq, err := opts.workflowExecOrBatch(cctx, c.Parent.Namespace, cl, singleOrBatchOverrides{
 		// You're allowed to specify a reason when terminating a workflow
 		AllowReasonWithWorkflowID: true,
+		DryRun:                    c.DryRun,
+		Wait:                      c.Wait,
 	})
 
 	// Run single or batch
@@ -364,7 +368,7 @@ func (c *TemporalWorkflowTerminateCommand) run(cctx *CommandContext, _ []string)
 				Identity: c.Parent.Identity,
 			},
 		}
-		if err := startBatchJob(cctx, cl, batchReq); err != nil {
+		if err := startBatchJob(cctx, cl, batchReq, c.BatchJobOptions); err != nil {
 			return err
 		}
 	}
@@ -581,6 +585,11 @@ type singleOrBatchOverrides struct {
 	AllowReasonWithWorkflowID bool
 	AllowYesWithWorkflowID    bool
 	AllowYesWithActivityID    bool
+	// Skips confirmation since the batch is only previewed, and disallows
+	// single executions
+	DryRun bool
+	// Disallows single executions, which have no batch job to wait for
+	Wait bool
 }
 
 func (s *SingleWorkflowOrBatchOptions) workflowExecOrBatch(
@@ -599,6 +608,10 @@ func (s *SingleWorkflowOrBatchOptions) workflowExecOrBatch(
 			return nil, nil, fmt.Errorf("cannot set 'yes' when workflow ID is set")
 		} else if s.Rps != 0 {
 			return nil, nil, fmt.Errorf("cannot set rps when workflow ID is set")
+		} else if overrides.DryRun {
+			return nil, nil, fmt.Errorf("cannot set dry run when workflow ID is set")
+		} else if overrides.Wait {
+			return nil, nil, fmt.Errorf("cannot set wait when workflow ID is set")
 		}
 		return &common.WorkflowExecution{WorkflowId: s.WorkflowId, RunId: s.RunId}, nil, nil
 	}
@@ -612,24 +625,26 @@ func (s *SingleWorkflowOrBatchOptions) workflowExecOrBatch(
 		return nil, nil, fmt.Errorf("cannot set run ID when query is set")
 	}
 
-	// The count is only used in the confirmation prompt; skip the request when --yes
-	// bypasses it, so batch jobs can still proceed if the visibility API is timing out.
-	var promptMessage string
-	if s.Yes {
-		promptMessage = fmt.Sprintf("Start batch against workflows matching query %q? y/N", s.Query)
-	} else {
-		count, err := cl.CountWorkflow(cctx, &workflowservice.CountWorkflowExecutionsRequest{Query: s.Query})
+	if !overrides.DryRun {
+		// The count is only used in the confirmation prompt; skip the request when --yes
+		// bypasses it, so batch jobs can still proceed if the visibility API is timing out.
+		var promptMessage string
+		if s.Yes {
+			promptMessage = fmt.Sprintf("Start batch against workflows matching query %q? y/N", s.Query)
+		} else {
+			count, err := cl.CountWorkflow(cctx, &workflowservice.CountWorkflowExecutionsRequest{Query: s.Query})
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed counting workflows from query: %w", err)
+			}
+			promptMessage = fmt.Sprintf("Start batch against approximately %v workflow(s)? y/N", count.Count)
+		}
+		yes, err := cctx.promptYes(promptMessage, s.Yes)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed counting workflows from query: %w", err)
+			return nil, nil, err
+		} else if !yes {
+			// We consider this a command failure
+			return nil, nil, fmt.Errorf("user denied confirmation")
 		}
-		promptMessage = fmt.Sprintf("Start batch against approximately %v workflow(s)? y/N", count.Count)
-	}
-	yes, err := cctx.promptYes(promptMessage, s.Yes)
-	if err != nil {
-		return nil, nil, err
-	} else if !yes {
-		// We consider this a command failure
-		return nil, nil, fmt.Errorf("user denied confirmation")
 	}
 
 	// Default the reason if not set
@@ -647,11 +662,21 @@ func (s *SingleWorkflowOrBatchOptions) workflowExecOrBatch(
 	}, nil
 }
 
-func startBatchJob(cctx *CommandContext, cl client.Client, req *workflowservice.StartBatchOperationRequest) error {
+func startBatchJob(cctx *CommandContext, cl client.Client, req *workflowservice.StartBatchOperationRequest, opts BatchJobOptions) error {
+	if opts.DryRun {
+		return previewBatchJob(cctx, cl, req, opts.DryRunLimit)
+	}
 	_, err := cl.WorkflowService().StartBatchOperation(cctx, req)
 	if err != nil {
 		return fmt.Errorf("failed starting batch operation: %w", err)
 	}
+	if opts.Wait {
+		// Progress events carry the job ID, so JSON output is only the events
+		if !cctx.JSONOutput {
+			cctx.Printer.Printlnf("Started batch for job ID: %v", req.JobId)
+		}
+		return followBatchJob(cctx, cl, req.Namespace, req.JobId)
+	}
 	if cctx.JSONOutput {
 		return cctx.Printer.PrintStructured(
 			struct {
@@ -682,15 +707,9 @@ func queryHelper(cctx *CommandContext,
 		return err
 	}
 
-	queryRejectCond := enums.QUERY_REJECT_CONDITION_UNSPECIFIED
-	switch rejectCondition.Value {
-	case "":
-	case "not_open":
-		queryRejectCond = enums.QUERY_REJECT_CONDITION_NOT_OPEN
-	case "not_completed_cleanly":
-		queryRejectCond = enums.QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY
-	default:
-		return fmt.Errorf("invalid query reject condition: %v, valid values are: 'not_open', 'not_completed_cleanly'", rejectCondition)
+	queryRejectCond, err := queryRejectConditionFromFlag(rejectCondition)
+	if err != nil {
+		return err
 	}
 
 	cctx.Context, err = contextWithHeaders(cctx.Context, headers)
@@ -781,6 +800,18 @@ func queryHelper(cctx *CommandContext,
 	}
 }
 
+func queryRejectConditionFromFlag(rejectCondition cliext.FlagStringEnum) (enums.QueryRejectCondition, error) {
+	switch rejectCondition.Value {
+	case "":
+		return enums.QUERY_REJECT_CONDITION_UNSPECIFIED, nil
+	case "not_open":
+		return enums.QUERY_REJECT_CONDITION_NOT_OPEN, nil
+	case "not_completed_cleanly":
+		return enums.QUERY_REJECT_CONDITION_NOT_COMPLETED_CLEANLY, nil
+	}
+	return 0, fmt.Errorf("invalid query reject condition: %v, valid values are: 'not_open', 'not_completed_cleanly'", rejectCondition)
+}
+
 // This is (mostly) copy-pasted from the SDK since it's not exposed. Most of this will go away once
 // the deprecated fields are no longer supported.
 func versioningOverrideToProto(versioningOverride client.VersioningOverride) *workflowpb.VersioningOverride {
@@ -0,0 +1,359 @@
+package temporalcli
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/temporalio/cli/internal/printer"
+	"github.com/temporalio/cli/internal/tracer"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/failure/v1"
+	"go.temporal.io/api/history/v1"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// readHistoryFile loads an Event History JSON file as written by
+// "workflow show --output json" (or repaired by "workflow fix-history-json").
+func readHistoryFile(path string) (*history.History, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	hist, err := client.HistoryFromJSON(bytes.NewReader(raw), client.HistoryJSONOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing history file %v: %w", path, err)
+	} else if len(hist.Events) == 0 {
+		return nil, fmt.Errorf("history file %v has no events", path)
+	}
+	return hist, nil
+}
+
+type workflowAnalysis struct {
+	Summary       workflowAnalysisSummary        `json:"summary"`
+	Activities    []workflowAnalysisActivity     `json:"activities"`
+	Timers        []workflowAnalysisTimer        `json:"timers"`
+	Children      []workflowAnalysisChild        `json:"childWorkflows"`
+	TaskGaps      []workflowAnalysisTaskGap      `json:"longestWorkflowTaskGaps"`
+	PayloadEvents []workflowAnalysisPayloadEvent `json:"largestPayloadEvents"`
+	Failures      []workflowAnalysisFailureChain `json:"failureChains"`
+}
+
+type workflowAnalysisSummary struct {
+	RunId                string    `json:"runId"`
+	Type                 string    `json:"type"`
+	Status               string    `json:"status"`
+	StartTime            time.Time `json:"startTime"`
+	CloseTime            time.Time `json:"closeTime,omitzero" cli:",cardOmitEmpty"`
+	Duration             string    `json:"duration,omitempty" cli:",cardOmitEmpty"`
+	Events               int       `json:"events"`
+	WorkflowTasks        int       `json:"workflowTasks"`
+	WorkflowTaskFailures int       `json:"workflowTaskFailures"`
+	PayloadBytes         int       `json:"payloadBytes"`
+}
+
+type workflowAnalysisActivity struct {
+	ActivityId string `json:"activityId"`
+	Type       string `json:"type"`
+	Status     string `json:"status"`
+	Attempt    int32  `json:"attempt"`
+	Retries    int32  `json:"retries"`
+	Duration   string `json:"duration"`
+}
+
+type workflowAnalysisTimer struct {
+	TimerId  string `json:"timerId"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Duration string `json:"duration"`
+}
+
+type workflowAnalysisChild struct {
+	WorkflowId string `json:"workflowId"`
+	RunId      string `json:"runId"`
+	Type       string `json:"type"`
+	Status     string `json:"status"`
+	Duration   string `json:"duration"`
+}
+
+type workflowAnalysisTaskGap struct {
+	FromEventId int64  `json:"fromEventId"`
+	ToEventId   int64  `json:"toEventId"`
+	Gap         string `json:"gap"`
+
+	gap time.Duration
+}
+
+type workflowAnalysisPayloadEvent struct {
+	EventId  int64  `json:"eventId"`
+	Type     string `json:"type"`
+	Payloads int    `json:"payloads"`
+	Bytes    int    `json:"bytes"`
+}
+
+type workflowAnalysisFailureChain struct {
+	EventId int64    `json:"eventId"`
+	Type    string   `json:"type"`
+	Chain   []string `json:"chain"`
+}
+
+func (c *TemporalWorkflowAnalyzeCommand) run(cctx *CommandContext, _ []string) error {
+	if c.Top < 0 {
+		return fmt.Errorf("top cannot be negative")
+	}
+	hist, err := readHistoryFile(c.HistoryFile)
+	if err != nil {
+		return err
+	}
+	analysis := analyzeWorkflowHistory(hist, c.Top)
+
+	if cctx.JSONOutput {
+		return cctx.Printer.PrintStructured(analysis, printer.StructuredOptions{})
+	}
+
+	cctx.Printer.Println(color.MagentaString("Summary:"))
+	_ = cctx.Printer.PrintStructured(analysis.Summary, printer.StructuredOptions{})
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("Activities: %v", len(analysis.Activities)))
+	if len(analysis.Activities) > 0 {
+		_ = cctx.Printer.PrintStructured(analysis.Activities, printer.StructuredOptions{Table: &printer.TableOptions{}})
+	}
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("Timers: %v", len(analysis.Timers)))
+	if len(analysis.Timers) > 0 {
+		_ = cctx.Printer.PrintStructured(analysis.Timers, printer.StructuredOptions{Table: &printer.TableOptions{}})
+	}
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("Child Workflows: %v", len(analysis.Children)))
+	if len(analysis.Children) > 0 {
+		_ = cctx.Printer.PrintStructured(analysis.Children, printer.StructuredOptions{Table: &printer.TableOptions{}})
+	}
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("Longest Workflow Task Gaps:"))
+	if len(analysis.TaskGaps) > 0 {
+		_ = cctx.Printer.PrintStructured(analysis.TaskGaps, printer.StructuredOptions{Table: &printer.TableOptions{}})
+	}
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("Largest Event Payloads:"))
+	if len(analysis.PayloadEvents) > 0 {
+		_ = cctx.Printer.PrintStructured(analysis.PayloadEvents, printer.StructuredOptions{Table: &printer.TableOptions{}})
+	}
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("Failure Chains: %v", len(analysis.Failures)))
+	for _, chain := range analysis.Failures {
+		cctx.Printer.Printlnf("  [%v] %v", chain.EventId, chain.Type)
+		for i, msg := range chain.Chain {
+			if i > 0 {
+				msg = strings.Repeat("  ", i-1) + "caused by: " + msg
+			}
+			cctx.Printer.Printlnf("    %v", msg)
+		}
+	}
+	return nil
+}
+
+// analyzeWorkflowHistory builds the execution state from the events the same
+// way the tracer does and then collects the per-execution and per-event
+// statistics. Only the top entries are kept for gaps and payloads.
+func analyzeWorkflowHistory(hist *history.History, top int) *workflowAnalysis {
+	state := tracer.NewWorkflowExecutionState("", "")
+	var analysis workflowAnalysis
+	analysis.Summary.Events = len(hist.Events)
+
+	var lastTaskCompleted *history.HistoryEvent
+	for _, event := range hist.Events {
+		state.Update(event)
+
+		switch event.EventType {
+		case enums.EVENT_TYPE_WORKFLOW_TASK_STARTED:
+			analysis.Summary.WorkflowTasks++
+		case enums.EVENT_TYPE_WORKFLOW_TASK_FAILED, enums.EVENT_TYPE_WORKFLOW_TASK_TIMED_OUT:
+			analysis.Summary.WorkflowTaskFailures++
+		case enums.EVENT_TYPE_WORKFLOW_TASK_COMPLETED:
+			lastTaskCompleted = event
+		case enums.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED:
+			// The gap is the time the workflow was idle waiting for something to
+			// happen between one task completing and the next being scheduled
+			if lastTaskCompleted != nil {
+				gap := event.EventTime.AsTime().Sub(lastTaskCompleted.EventTime.AsTime())
+				analysis.TaskGaps = append(analysis.TaskGaps, workflowAnalysisTaskGap{
+					FromEventId: lastTaskCompleted.EventId,
+					ToEventId:   event.EventId,
+					Gap:         formatDurationShort(gap),
+					gap:         gap,
+				})
+				lastTaskCompleted = nil
+			}
+		}
+
+		count, size := eventPayloadSize(event.ProtoReflect())
+		analysis.Summary.PayloadBytes += size
+		if count > 0 {
+			analysis.PayloadEvents = append(analysis.PayloadEvents, workflowAnalysisPayloadEvent{
+				EventId:  event.EventId,
+				Type:     event.EventType.String(),
+				Payloads: count,
+				Bytes:    size,
+			})
+		}
+
+		if chain := eventFailureChain(event); len(chain) > 0 {
+			analysis.Failures = append(analysis.Failures, workflowAnalysisFailureChain{
+				EventId: event.EventId,
+				Type:    event.EventType.String(),
+				Chain:   chain,
+			})
+		}
+	}
+
+	analysis.Summary.RunId = state.Execution.GetRunId()
+	analysis.Summary.Type = state.Type.GetName()
+	analysis.Summary.Status = state.Status.String()
+	analysis.Summary.StartTime = timestampToTime(state.StartTime)
+	analysis.Summary.CloseTime = timestampToTime(state.CloseTime)
+	if state.CloseTime != nil {
+		analysis.Summary.Duration = formatDurationShort(state.GetDuration())
+	}
+
+	for _, child := range state.ChildStates {
+		switch child := child.(type) {
+		case *tracer.ActivityExecutionState:
+			analysis.Activities = append(analysis.Activities, workflowAnalysisActivity{
+				ActivityId: child.ActivityId,
+				Type:       child.Type.GetName(),
+				Status:     activityExecutionStatusString(child.Status),
+				Attempt:    child.Attempt,
+				Retries:    max(child.Attempt-1, 0),
+				Duration:   formatDurationShort(child.GetDuration()),
+			})
+		case *tracer.TimerExecutionState:
+			analysis.Timers = append(analysis.Timers, workflowAnalysisTimer{
+				TimerId:  child.TimerId,
+				Name:     child.Name,
+				Status:   timerExecutionStatusString(child.Status),
+				Duration: formatDurationShort(child.GetDuration()),
+			})
+		case *tracer.WorkflowExecutionState:
+			analysis.Children = append(analysis.Children, workflowAnalysisChild{
+				WorkflowId: child.Execution.GetWorkflowId(),
+				RunId:      child.Execution.GetRunId(),
+				Type:       child.Type.GetName(),
+				Status:     child.Status.String(),
+				Duration:   formatDurationShort(child.GetDuration()),
+			})
+		}
+	}
+
+	slices.SortStableFunc(analysis.TaskGaps, func(a, b workflowAnalysisTaskGap) int {
+		return cmp.Compare(b.gap, a.gap)
+	})
+	analysis.TaskGaps = analysis.TaskGaps[:min(top, len(analysis.TaskGaps))]
+	slices.SortStableFunc(analysis.PayloadEvents, func(a, b workflowAnalysisPayloadEvent) int {
+		return cmp.Compare(b.Bytes, a.Bytes)
+	})
+	analysis.PayloadEvents = analysis.PayloadEvents[:min(top, len(analysis.PayloadEvents))]
+	return &analysis
+}
+
+func activityExecutionStatusString(status tracer.ActivityExecutionStatus) string {
+	switch status {
+	case tracer.ACTIVITY_EXECUTION_STATUS_SCHEDULED:
+		return "Scheduled"
+	case tracer.ACTIVITY_EXECUTION_STATUS_RUNNING:
+		return "Running"
+	case tracer.ACTIVITY_EXECUTION_STATUS_COMPLETED:
+		return "Completed"
+	case tracer.ACTIVITY_EXECUTION_STATUS_FAILED:
+		return "Failed"
+	case tracer.ACTIVITY_EXECUTION_STATUS_TIMED_OUT:
+		return "TimedOut"
+	case tracer.ACTIVITY_EXECUTION_STATUS_CANCEL_REQUESTED:
+		return "CancelRequested"
+	case tracer.ACTIVITY_EXECUTION_STATUS_CANCELED:
+		return "Canceled"
+	}
+	return "Unspecified"
+}
+
+func timerExecutionStatusString(status tracer.TimerExecutionStatus) string {
+	switch status {
+	case tracer.TIMER_STATUS_FIRED:
+		return "Fired"
+	case tracer.TIMER_STATUS_CANCELED:
+		return "Canceled"
+	}
+	return "Waiting"
+}
+
+var payloadFullName = (&common.Payload{}).ProtoReflect().Descriptor().FullName()
+
+// eventPayloadSize returns the number of payloads nested anywhere in the
+// message and the sum of their encoded sizes.
+func eventPayloadSize(m protoreflect.Message) (count, size int) {
+	if m.Descriptor().FullName() == payloadFullName {
+		return 1, proto.Size(m.Interface())
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil {
+			return true
+		}
+		add := func(v protoreflect.Value) {
+			c, s := eventPayloadSize(v.Message())
+			count, size = count+c, size+s
+		}
+		switch {
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				add(v.List().Get(i))
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					add(v)
+					return true
+				})
+			}
+		default:
+			add(v)
+		}
+		return true
+	})
+	return
+}
+
+// eventFailureChain returns the messages of the failure on the event and
+// every cause under it, outermost first. Nil if the event has no failure.
+func eventFailureChain(event *history.HistoryEvent) []string {
+	var f *failure.Failure
+	switch event.EventType {
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		f = event.GetWorkflowExecutionFailedEventAttributes().GetFailure()
+	case enums.EVENT_TYPE_WORKFLOW_TASK_FAILED:
+		f = event.GetWorkflowTaskFailedEventAttributes().GetFailure()
+	case enums.EVENT_TYPE_ACTIVITY_TASK_FAILED:
+		f = event.GetActivityTaskFailedEventAttributes().GetFailure()
+	case enums.EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT:
+		f = event.GetActivityTaskTimedOutEventAttributes().GetFailure()
+	case enums.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+		f = event.GetChildWorkflowExecutionFailedEventAttributes().GetFailure()
+	case enums.EVENT_TYPE_NEXUS_OPERATION_FAILED:
+		f = event.GetNexusOperationFailedEventAttributes().GetFailure()
+	case enums.EVENT_TYPE_NEXUS_OPERATION_TIMED_OUT:
+		f = event.GetNexusOperationTimedOutEventAttributes().GetFailure()
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
+		f = event.GetWorkflowExecutionUpdateCompletedEventAttributes().GetOutcome().GetFailure()
+	}
+	var chain []string
+	for ; f != nil; f = f.Cause {
+		chain = append(chain, f.Message)
+	}
+	return chain
+}
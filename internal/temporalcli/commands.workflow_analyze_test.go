@@ -0,0 +1,94 @@
+package temporalcli_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (s *SharedServerSuite) TestWorkflow_Analyze() {
+	var attempts atomic.Int32
+	s.Worker().OnDevActivity(func(ctx context.Context, a any) (any, error) {
+		if attempts.Add(1) < 3 {
+			return nil, fmt.Errorf("intentional error")
+		}
+		return a, nil
+	})
+	s.Worker().OnDevWorkflow(func(ctx workflow.Context, input any) (any, error) {
+		if err := workflow.Sleep(ctx, time.Millisecond); err != nil {
+			return nil, err
+		}
+		ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: 10 * time.Second,
+			RetryPolicy:         &temporal.RetryPolicy{InitialInterval: time.Millisecond},
+		})
+		var res any
+		if err := workflow.ExecuteActivity(ctx, DevActivity, input).Get(ctx, &res); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("intentional workflow error")
+	})
+
+	run, err := s.Client.ExecuteWorkflow(
+		s.Context,
+		client.StartWorkflowOptions{TaskQueue: s.Worker().Options.TaskQueue},
+		DevWorkflow,
+		"some-input",
+	)
+	s.NoError(err)
+	s.Error(run.Get(s.Context, nil))
+
+	// Export history to a file
+	res := s.Execute(
+		"workflow", "show",
+		"--address", s.Address(),
+		"-w", run.GetID(),
+		"-o", "json",
+	)
+	s.NoError(res.Err)
+	historyFile := filepath.Join(s.T().TempDir(), "history.json")
+	s.NoError(os.WriteFile(historyFile, res.Stdout.Bytes(), 0644))
+
+	// Text
+	res = s.Execute("workflow", "analyze", "--history-file", historyFile)
+	s.NoError(res.Err)
+	out := res.Stdout.String()
+	s.ContainsOnSameLine(out, "RunId", run.GetRunID())
+	s.ContainsOnSameLine(out, "Type", "DevWorkflow")
+	s.ContainsOnSameLine(out, "Status", "Failed")
+	s.ContainsOnSameLine(out, "DevActivity", "Completed", "3", "2")
+	s.ContainsOnSameLine(out, "Fired")
+	s.Contains(out, "intentional workflow error")
+
+	// JSON
+	res = s.Execute("workflow", "analyze", "--history-file", historyFile, "-o", "json")
+	s.NoError(res.Err)
+	var jsonOut map[string]any
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &jsonOut))
+	summary := jsonOut["summary"].(map[string]any)
+	s.Equal(run.GetRunID(), summary["runId"])
+	s.Equal("Failed", summary["status"])
+	activities := jsonOut["activities"].([]any)
+	s.Len(activities, 1)
+	s.Equal(2.0, activities[0].(map[string]any)["retries"])
+	s.Len(jsonOut["timers"], 1)
+	failures := jsonOut["failureChains"].([]any)
+	s.Len(failures, 1)
+	s.Equal("WorkflowExecutionFailed", failures[0].(map[string]any)["type"])
+
+	// Negative top
+	res = s.Execute("workflow", "analyze", "--history-file", historyFile, "--top", "-1")
+	s.ErrorContains(res.Err, "top cannot be negative")
+
+	// Bad file
+	res = s.Execute("workflow", "analyze", "--history-file", filepath.Join(s.T().TempDir(), "missing.json"))
+	s.Error(res.Err)
+}
@@ -0,0 +1,556 @@
+package temporalcli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/temporalio/cli/internal/printer"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/query/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+)
+
+func (c *TemporalWorkflowBulkCancelCommand) run(cctx *CommandContext, _ []string) error {
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	return c.runBulk(cctx, cl, c.Parent.Parent.Namespace, "cancel",
+		func(ctx context.Context, item *bulkWorkflowItem) (json.RawMessage, error) {
+			return nil, cl.CancelWorkflow(ctx, item.WorkflowId, item.RunId)
+		})
+}
+
+func (c *TemporalWorkflowBulkDeleteCommand) run(cctx *CommandContext, _ []string) error {
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	return c.runBulk(cctx, cl, c.Parent.Parent.Namespace, "delete",
+		func(ctx context.Context, item *bulkWorkflowItem) (json.RawMessage, error) {
+			_, err := cl.WorkflowService().DeleteWorkflowExecution(ctx, &workflowservice.DeleteWorkflowExecutionRequest{
+				Namespace:         c.Parent.Parent.Namespace,
+				WorkflowExecution: &common.WorkflowExecution{WorkflowId: item.WorkflowId, RunId: item.RunId},
+			})
+			return nil, err
+		})
+}
+
+func (c *TemporalWorkflowBulkQueryCommand) run(cctx *CommandContext, _ []string) error {
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	input, err := c.buildRawInputPayloads()
+	if err != nil {
+		return err
+	}
+	rejectCond, err := queryRejectConditionFromFlag(c.RejectCondition)
+	if err != nil {
+		return err
+	}
+
+	return c.runBulk(cctx, cl, c.Parent.Parent.Namespace, "query",
+		func(ctx context.Context, item *bulkWorkflowItem) (json.RawMessage, error) {
+			itemInput, err := item.inputPayloads(input)
+			if err != nil {
+				return nil, err
+			}
+			resp, err := cl.WorkflowService().QueryWorkflow(ctx, &workflowservice.QueryWorkflowRequest{
+				Namespace: c.Parent.Parent.Namespace,
+				Execution: &common.WorkflowExecution{WorkflowId: item.WorkflowId, RunId: item.RunId},
+				Query: &query.WorkflowQuery{
+					QueryType: c.Name,
+					QueryArgs: itemInput,
+				},
+				QueryRejectCondition: rejectCond,
+			})
+			if err != nil {
+				return nil, err
+			} else if resp.QueryRejected != nil {
+				return nil, fmt.Errorf("query was rejected, workflow has status: %v", resp.QueryRejected.GetStatus())
+			}
+			return cctx.MarshalFriendlyJSONPayloads(resp.QueryResult)
+		})
+}
+
+func (c *TemporalWorkflowBulkSignalCommand) run(cctx *CommandContext, _ []string) error {
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	input, err := c.buildRawInputPayloads()
+	if err != nil {
+		return err
+	}
+
+	return c.runBulk(cctx, cl, c.Parent.Parent.Namespace, "signal",
+		func(ctx context.Context, item *bulkWorkflowItem) (json.RawMessage, error) {
+			itemInput, err := item.inputPayloads(input)
+			if err != nil {
+				return nil, err
+			}
+			// Raw service call for multiple arguments like "workflow signal"
+			_, err = cl.WorkflowService().SignalWorkflowExecution(ctx, &workflowservice.SignalWorkflowExecutionRequest{
+				Namespace:         c.Parent.Parent.Namespace,
+				WorkflowExecution: &common.WorkflowExecution{WorkflowId: item.WorkflowId, RunId: item.RunId},
+				SignalName:        c.Name,
+				Input:             itemInput,
+				Identity:          c.Parent.Parent.Identity,
+			})
+			return nil, err
+		})
+}
+
+func (c *TemporalWorkflowBulkTerminateCommand) run(cctx *CommandContext, _ []string) error {
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	reason := c.Reason
+	if reason == "" {
+		reason = defaultReason()
+	}
+	return c.runBulk(cctx, cl, c.Parent.Parent.Namespace, "terminate",
+		func(ctx context.Context, item *bulkWorkflowItem) (json.RawMessage, error) {
+			return nil, cl.TerminateWorkflow(ctx, item.WorkflowId, item.RunId, reason)
+		})
+}
+
+func (c *TemporalWorkflowBulkUpdateCommand) run(cctx *CommandContext, _ []string) error {
+	cl, err := dialClient(cctx, &c.Parent.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	input, err := c.buildRawInputPayloads()
+	if err != nil {
+		return err
+	}
+
+	return c.runBulk(cctx, cl, c.Parent.Parent.Namespace, "update",
+		func(ctx context.Context, item *bulkWorkflowItem) (json.RawMessage, error) {
+			itemInput, err := item.inputPayloads(input)
+			if err != nil {
+				return nil, err
+			}
+			args := make([]any, len(itemInput.GetPayloads()))
+			for i, payload := range itemInput.GetPayloads() {
+				args[i] = RawValue{payload}
+			}
+			handle, err := cl.UpdateWorkflow(ctx, client.UpdateWorkflowOptions{
+				WorkflowID:   item.WorkflowId,
+				RunID:        item.RunId,
+				UpdateName:   c.Name,
+				Args:         args,
+				WaitForStage: client.WorkflowUpdateStageCompleted,
+			})
+			if err != nil {
+				return nil, err
+			}
+			var result any
+			if err := handle.Get(ctx, &result); err != nil {
+				return nil, err
+			}
+			return json.Marshal(result)
+		})
+}
+
+// bulkWorkflowItem is a Workflow Execution to operate on. Input is only set
+// from ID files and, if present, replaces the command's input.
+type bulkWorkflowItem struct {
+	WorkflowId string          `json:"workflowId"`
+	RunId      string          `json:"runId,omitempty"`
+	Input      json.RawMessage `json:"input,omitempty"`
+}
+
+func (i *bulkWorkflowItem) key() string {
+	return i.WorkflowId + "/" + i.RunId
+}
+
+func (i *bulkWorkflowItem) inputPayloads(defaultInput *common.Payloads) (*common.Payloads, error) {
+	if len(i.Input) == 0 {
+		return defaultInput, nil
+	}
+	return CreatePayloads([][]byte{i.Input}, map[string][][]byte{"encoding": {[]byte("json/plain")}}, false)
+}
+
+// bulkWorkflowOperation runs the operation against a single Workflow and
+// returns an optional JSON result for the report.
+type bulkWorkflowOperation func(ctx context.Context, item *bulkWorkflowItem) (json.RawMessage, error)
+
+type bulkWorkflowResult struct {
+	WorkflowId string          `json:"workflowId"`
+	RunId      string          `json:"runId,omitempty"`
+	Status     string          `json:"status"`
+	Error      string          `json:"error,omitempty"`
+	Result     json.RawMessage `json:"result,omitempty"`
+	Time       time.Time       `json:"time"`
+}
+
+type bulkWorkflowSummary struct {
+	Operation string `json:"operation"`
+	Succeeded int    `json:"succeeded"`
+	Failed    int    `json:"failed"`
+	Skipped   int    `json:"skipped"`
+}
+
+func (b *BulkExecutionOptions) runBulk(
+	cctx *CommandContext,
+	cl client.Client,
+	namespace string,
+	operation string,
+	op bulkWorkflowOperation,
+) error {
+	if (b.Query == "") == (b.IdFile == "") {
+		return fmt.Errorf("must set either query or ID file")
+	} else if b.Concurrency < 1 {
+		return fmt.Errorf("concurrency must be at least 1")
+	} else if b.Rps < 0 {
+		return fmt.Errorf("rps cannot be negative")
+	}
+
+	var fileItems []*bulkWorkflowItem
+	if b.IdFile != "" {
+		var err error
+		if fileItems, err = readBulkWorkflowIdFile(b.IdFile); err != nil {
+			return err
+		}
+	}
+	done := map[string]bool{}
+	if b.CheckpointFile != "" {
+		var err error
+		if done, err = readBulkWorkflowCheckpoint(b.CheckpointFile); err != nil {
+			return err
+		}
+	}
+
+	// Confirm, only counting the query when the count will be shown
+	var promptMessage string
+	switch {
+	case b.IdFile != "":
+		promptMessage = fmt.Sprintf("Run %v against %v workflow(s)? y/N", operation, len(fileItems))
+	case b.Yes:
+		promptMessage = fmt.Sprintf("Run %v against workflows matching query %q? y/N", operation, b.Query)
+	default:
+		count, err := cl.CountWorkflow(cctx, &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: namespace,
+			Query:     b.Query,
+		})
+		if err != nil {
+			return fmt.Errorf("failed counting workflows from query: %w", err)
+		}
+		promptMessage = fmt.Sprintf("Run %v against approximately %v workflow(s)? y/N", operation, count.Count)
+	}
+	if len(done) > 0 {
+		promptMessage = fmt.Sprintf("%v workflow(s) already in checkpoint file will be skipped. %v", len(done), promptMessage)
+	}
+	yes, err := cctx.promptYes(promptMessage, b.Yes)
+	if err != nil {
+		return err
+	} else if !yes {
+		return fmt.Errorf("user denied confirmation")
+	}
+
+	var checkpoint, report io.Writer
+	if b.CheckpointFile != "" {
+		f, err := openJSONLinesForAppend(b.CheckpointFile)
+		if err != nil {
+			return fmt.Errorf("failed opening checkpoint file: %w", err)
+		}
+		defer f.Close()
+		checkpoint = f
+	}
+	if b.ReportFile != "" {
+		f, err := openJSONLinesForAppend(b.ReportFile)
+		if err != nil {
+			return fmt.Errorf("failed opening report file: %w", err)
+		}
+		defer f.Close()
+		report = f
+	}
+
+	// Items are produced on one goroutine, which also applies the rate limit,
+	// and consumed by the workers
+	summary := bulkWorkflowSummary{Operation: operation}
+	items := make(chan *bulkWorkflowItem)
+	var produceErr error
+	go func() {
+		defer close(items)
+		var tick <-chan time.Time
+		if b.Rps > 0 {
+			ticker := time.NewTicker(time.Duration(float64(time.Second) / float64(b.Rps)))
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+		produceErr = b.produceBulkWorkflowItems(cctx, cl, namespace, fileItems, func(item *bulkWorkflowItem) bool {
+			if done[item.key()] {
+				summary.Skipped++
+				return true
+			}
+			if tick != nil {
+				select {
+				case <-tick:
+				case <-cctx.Done():
+					return false
+				}
+			}
+			select {
+			case items <- item:
+				return true
+			case <-cctx.Done():
+				return false
+			}
+		})
+	}()
+
+	var resultLock sync.Mutex
+	var writeErr error
+	var wg sync.WaitGroup
+	for range b.Concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range items {
+				result, err := op(cctx, item)
+				// Operations cut short by interruption are left for a resumed run
+				if err != nil && cctx.Err() != nil {
+					continue
+				}
+				res := bulkWorkflowResult{
+					WorkflowId: item.WorkflowId,
+					RunId:      item.RunId,
+					Status:     "succeeded",
+					Result:     result,
+					Time:       time.Now(),
+				}
+				if err != nil {
+					res.Status = "failed"
+					res.Error = err.Error()
+					res.Result = nil
+				}
+
+				resultLock.Lock()
+				if err != nil {
+					summary.Failed++
+					if !cctx.JSONOutput {
+						cctx.Printer.Printlnf("Failed %v workflow %v: %v", operation, item.WorkflowId, err)
+					}
+				} else {
+					summary.Succeeded++
+					if checkpoint != nil && writeErr == nil {
+						writeErr = writeJSONLine(checkpoint, bulkWorkflowItem{WorkflowId: item.WorkflowId, RunId: item.RunId})
+					}
+				}
+				if report != nil && writeErr == nil {
+					writeErr = writeJSONLine(report, res)
+				}
+				resultLock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if produceErr != nil && cctx.Err() == nil {
+		return produceErr
+	} else if writeErr != nil {
+		return fmt.Errorf("failed writing results: %w", writeErr)
+	}
+
+	if !cctx.JSONOutput {
+		cctx.Printer.Println(color.MagentaString("Results:"))
+	}
+	if err := cctx.Printer.PrintStructured(summary, printer.StructuredOptions{}); err != nil {
+		return err
+	}
+	if cctx.Err() != nil {
+		return fmt.Errorf("interrupted, rerun with the same checkpoint file to resume")
+	} else if summary.Failed > 0 {
+		return fmt.Errorf("%v of %v workflow operation(s) failed", summary.Failed, summary.Failed+summary.Succeeded)
+	}
+	return nil
+}
+
+// produceBulkWorkflowItems calls yield for every item from the ID file or the
+// query, stopping if yield returns false. Query results are all listed before
+// the first yield, since operations can change which workflows match and
+// paging through changing results skips some.
+func (b *BulkExecutionOptions) produceBulkWorkflowItems(
+	ctx context.Context,
+	cl client.Client,
+	namespace string,
+	fileItems []*bulkWorkflowItem,
+	yield func(*bulkWorkflowItem) bool,
+) error {
+	if b.IdFile != "" {
+		for _, item := range fileItems {
+			if !yield(item) {
+				return nil
+			}
+		}
+		return nil
+	}
+	var queryItems []*bulkWorkflowItem
+	var pageToken []byte
+	for {
+		resp, err := cl.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     namespace,
+			Query:         b.Query,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("failed listing workflows: %w", err)
+		}
+		for _, exec := range resp.Executions {
+			queryItems = append(queryItems, &bulkWorkflowItem{WorkflowId: exec.Execution.WorkflowId, RunId: exec.Execution.RunId})
+		}
+		if pageToken = resp.NextPageToken; len(pageToken) == 0 {
+			break
+		}
+	}
+	for _, item := range queryItems {
+		if !yield(item) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func readBulkWorkflowIdFile(path string) ([]*bulkWorkflowItem, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening ID file: %w", err)
+	}
+	defer f.Close()
+	var items []*bulkWorkflowItem
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var item bulkWorkflowItem
+		if strings.HasPrefix(line, "{") {
+			if err := json.Unmarshal([]byte(line), &item); err != nil {
+				return nil, fmt.Errorf("invalid JSON on line %v of ID file: %w", lineNum, err)
+			}
+		} else {
+			fields := strings.Fields(line)
+			if len(fields) > 2 {
+				return nil, fmt.Errorf("expected workflow ID and optional run ID on line %v of ID file", lineNum)
+			}
+			item.WorkflowId = fields[0]
+			if len(fields) == 2 {
+				item.RunId = fields[1]
+			}
+		}
+		if item.WorkflowId == "" {
+			return nil, fmt.Errorf("missing workflow ID on line %v of ID file", lineNum)
+		}
+		items = append(items, &item)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed reading ID file: %w", err)
+	}
+	return items, nil
+}
+
+// readBulkWorkflowCheckpoint returns the keys of the items in the checkpoint
+// file. A missing file is an empty checkpoint.
+func readBulkWorkflowCheckpoint(path string) (map[string]bool, error) {
+	done := map[string]bool{}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return done, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed reading checkpoint file: %w", err)
+	}
+	lines := strings.Split(string(b), "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var item bulkWorkflowItem
+		if err := json.Unmarshal([]byte(line), &item); err != nil {
+			// A partial last line is from an interrupted write and is ignored
+			if i == len(lines)-1 {
+				continue
+			}
+			return nil, fmt.Errorf("invalid JSON on line %v of checkpoint file: %w", i+1, err)
+		}
+		done[item.key()] = true
+	}
+	return done, nil
+}
+
+// openJSONLinesForAppend opens a JSON lines file for appending, first dropping
+// a partial last line left by an interrupted write so that appended lines are
+// not joined to it.
+func openJSONLinesForAppend(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	// Read backwards for the last newline
+	end := size
+	buf := make([]byte, 4096)
+	for end > 0 {
+		n := min(end, int64(len(buf)))
+		if _, err := f.ReadAt(buf[:n], end-n); err != nil {
+			f.Close()
+			return nil, err
+		}
+		if i := bytes.LastIndexByte(buf[:n], '\n'); i >= 0 {
+			end = end - n + int64(i) + 1
+			break
+		}
+		end -= n
+	}
+	if end < size {
+		if err := f.Truncate(end); err != nil {
+			f.Close()
+			return nil, err
+		} else if _, err := f.Seek(end, io.SeekStart); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+func writeJSONLine(w io.Writer, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
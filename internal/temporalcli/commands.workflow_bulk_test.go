@@ -0,0 +1,168 @@
+package temporalcli_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (s *SharedServerSuite) TestWorkflow_Bulk_Signal_IdFile() {
+	// Workflows that return the first signal they receive
+	s.Worker().OnDevWorkflow(func(ctx workflow.Context, a any) (any, error) {
+		var sig any
+		workflow.GetSignalChannel(ctx, "my-signal").Receive(ctx, &sig)
+		return sig, nil
+	})
+	var runs []client.WorkflowRun
+	for range 3 {
+		run, err := s.Client.ExecuteWorkflow(
+			s.Context,
+			client.StartWorkflowOptions{TaskQueue: s.Worker().Options.TaskQueue, ID: "bulk-" + uuid.NewString()},
+			DevWorkflow,
+			"ignored",
+		)
+		s.NoError(err)
+		runs = append(runs, run)
+	}
+
+	// First two by plain ID, last with its own input, plus one that doesn't exist
+	dir := s.T().TempDir()
+	idFile := filepath.Join(dir, "ids.txt")
+	checkpointFile := filepath.Join(dir, "checkpoint.jsonl")
+	reportFile := filepath.Join(dir, "report.jsonl")
+	s.NoError(os.WriteFile(idFile, []byte(strings.Join([]string{
+		"# comment",
+		runs[0].GetID(),
+		runs[1].GetID() + " " + runs[1].GetRunID(),
+		`{"workflowId":"` + runs[2].GetID() + `","input":{"custom":true}}`,
+		"does-not-exist-" + uuid.NewString(),
+	}, "\n")), 0644))
+
+	res := s.Execute(
+		"workflow", "bulk", "signal",
+		"--address", s.Address(),
+		"--id-file", idFile,
+		"--checkpoint-file", checkpointFile,
+		"--report-file", reportFile,
+		"--name", "my-signal",
+		"-i", `"default-input"`,
+		"--concurrency", "2",
+		"-y",
+	)
+	s.ErrorContains(res.Err, "1 of 4 workflow operation(s) failed")
+	s.ContainsOnSameLine(res.Stdout.String(), "Succeeded", "3")
+	s.ContainsOnSameLine(res.Stdout.String(), "Failed", "1")
+
+	var ret any
+	s.NoError(runs[0].Get(s.Context, &ret))
+	s.Equal("default-input", ret)
+	s.NoError(runs[1].Get(s.Context, &ret))
+	s.Equal("default-input", ret)
+	s.NoError(runs[2].Get(s.Context, &ret))
+	s.Equal(map[string]any{"custom": true}, ret)
+
+	// Report has every item, checkpoint only the successes
+	reportLines := strings.Split(strings.TrimSpace(s.readFile(reportFile)), "\n")
+	s.Len(reportLines, 4)
+	statuses := map[string]int{}
+	for _, line := range reportLines {
+		var result map[string]any
+		s.NoError(json.Unmarshal([]byte(line), &result))
+		statuses[result["status"].(string)]++
+	}
+	s.Equal(map[string]int{"succeeded": 3, "failed": 1}, statuses)
+	s.Len(strings.Split(strings.TrimSpace(s.readFile(checkpointFile)), "\n"), 3)
+
+	// Resuming skips the checkpointed workflows and only retries the failure.
+	// A partial line from an interrupted write is dropped.
+	f, err := os.OpenFile(checkpointFile, os.O_APPEND|os.O_WRONLY, 0644)
+	s.NoError(err)
+	_, err = f.WriteString(`{"workflowId":"partial`)
+	s.NoError(err)
+	s.NoError(f.Close())
+	res = s.Execute(
+		"workflow", "bulk", "signal",
+		"--address", s.Address(),
+		"--id-file", idFile,
+		"--checkpoint-file", checkpointFile,
+		"--name", "my-signal",
+		"-y",
+		"-o", "json",
+	)
+	s.Error(res.Err)
+	var summary map[string]any
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &summary))
+	s.Equal(map[string]any{"operation": "signal", "succeeded": 0.0, "failed": 1.0, "skipped": 3.0}, summary)
+	checkpointLines := strings.Split(strings.TrimSuffix(s.readFile(checkpointFile), "\n"), "\n")
+	s.Len(checkpointLines, 3)
+	for _, line := range checkpointLines {
+		s.True(json.Valid([]byte(line)), line)
+	}
+}
+
+func (s *SharedServerSuite) TestWorkflow_Bulk_Terminate_Query() {
+	s.Worker().OnDevWorkflow(func(ctx workflow.Context, a any) (any, error) {
+		return nil, workflow.Await(ctx, func() bool { return false })
+	})
+	searchAttr := "keyword-" + uuid.NewString()
+	var runs []client.WorkflowRun
+	for range 3 {
+		run, err := s.Client.ExecuteWorkflow(
+			s.Context,
+			client.StartWorkflowOptions{
+				TaskQueue:        s.Worker().Options.TaskQueue,
+				SearchAttributes: map[string]any{"CustomKeywordField": searchAttr},
+			},
+			DevWorkflow,
+			"ignored",
+		)
+		s.NoError(err)
+		runs = append(runs, run)
+	}
+	query := "CustomKeywordField = '" + searchAttr + "'"
+	s.Eventually(func() bool {
+		resp, err := s.Client.CountWorkflow(s.Context, &workflowservice.CountWorkflowExecutionsRequest{Query: query})
+		s.NoError(err)
+		return resp.Count == 3
+	}, 3*time.Second, 100*time.Millisecond)
+
+	res := s.Execute(
+		"workflow", "bulk", "terminate",
+		"--address", s.Address(),
+		"--query", query,
+		"--reason", "bulk-reason",
+		"--rps", "50",
+		"-y",
+	)
+	s.NoError(res.Err)
+	s.ContainsOnSameLine(res.Stdout.String(), "Succeeded", "3")
+	for _, run := range runs {
+		desc, err := s.Client.DescribeWorkflowExecution(s.Context, run.GetID(), run.GetRunID())
+		s.NoError(err)
+		s.Equal(enums.WORKFLOW_EXECUTION_STATUS_TERMINATED, desc.WorkflowExecutionInfo.Status)
+	}
+
+	// Both selectors is an error
+	res = s.Execute(
+		"workflow", "bulk", "terminate",
+		"--address", s.Address(),
+		"--query", query,
+		"--id-file", "ids.txt",
+		"-y",
+	)
+	s.ErrorContains(res.Err, "must set either query or ID file")
+}
+
+func (s *SharedServerSuite) readFile(path string) string {
+	b, err := os.ReadFile(path)
+	s.NoError(err)
+	return string(b)
+}
@@ -0,0 +1,544 @@
+package temporalcli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/temporalio/cli/internal/printer"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/failure/v1"
+	"go.temporal.io/api/history/v1"
+	"go.temporal.io/api/temporalproto"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type workflowDiff struct {
+	Left      workflowDiffSource  `json:"left"`
+	Right     workflowDiffSource  `json:"right"`
+	Same      int                 `json:"same"`
+	Changed   int                 `json:"changed"`
+	LeftOnly  int                 `json:"leftOnly"`
+	RightOnly int                 `json:"rightOnly"`
+	Events    []workflowDiffEvent `json:"events"`
+}
+
+type workflowDiffSource struct {
+	WorkflowId  string `json:"workflowId,omitempty" cli:",cardOmitEmpty"`
+	RunId       string `json:"runId,omitempty" cli:",cardOmitEmpty"`
+	HistoryFile string `json:"historyFile,omitempty" cli:",cardOmitEmpty"`
+	Type        string `json:"type"`
+	Events      int    `json:"events"`
+	Duration    string `json:"duration"`
+
+	hist *history.History
+}
+
+const (
+	workflowDiffSame      = "same"
+	workflowDiffChanged   = "changed"
+	workflowDiffLeftOnly  = "left-only"
+	workflowDiffRightOnly = "right-only"
+)
+
+type workflowDiffEvent struct {
+	Diff           string              `json:"diff"`
+	Key            string              `json:"key"`
+	LeftEventId    int64               `json:"leftEventId,omitempty"`
+	LeftEventType  string              `json:"leftEventType,omitempty"`
+	RightEventId   int64               `json:"rightEventId,omitempty"`
+	RightEventType string              `json:"rightEventType,omitempty"`
+	TimeDelta      string              `json:"timeDelta,omitempty"`
+	Differences    []workflowDiffField `json:"differences,omitempty"`
+}
+
+type workflowDiffField struct {
+	Field string `json:"field"`
+	Left  string `json:"left,omitempty"`
+	Right string `json:"right,omitempty"`
+}
+
+// Text table row, IDs are strings so they're blank for one-sided events
+type workflowDiffEventRow struct {
+	Diff           string
+	Key            string
+	LeftEventId    string
+	LeftEventType  string
+	RightEventId   string
+	RightEventType string
+	TimeDelta      string
+}
+
+func (c *TemporalWorkflowDiffCommand) run(cctx *CommandContext, _ []string) error {
+	left := workflowDiffSource{WorkflowId: c.WorkflowId, RunId: c.RunId, HistoryFile: c.HistoryFile}
+	right := workflowDiffSource{WorkflowId: c.OtherWorkflowId, RunId: c.OtherRunId, HistoryFile: c.OtherHistoryFile}
+	if (left.WorkflowId == "") == (left.HistoryFile == "") {
+		return fmt.Errorf("must specify exactly one of --workflow-id or --history-file")
+	} else if left.HistoryFile != "" && left.RunId != "" {
+		return fmt.Errorf("cannot set --run-id with --history-file")
+	}
+	if right.WorkflowId == "" && right.RunId == "" && right.HistoryFile == "" {
+		return fmt.Errorf("must specify one of --other-workflow-id, --other-run-id, or --other-history-file")
+	} else if right.WorkflowId != "" && right.HistoryFile != "" {
+		return fmt.Errorf("cannot set both --other-workflow-id and --other-history-file")
+	} else if right.HistoryFile != "" && right.RunId != "" {
+		return fmt.Errorf("cannot set --other-run-id with --other-history-file")
+	} else if right.HistoryFile == "" && right.WorkflowId == "" {
+		if left.WorkflowId == "" {
+			return fmt.Errorf("must set --other-workflow-id when using --history-file")
+		}
+		right.WorkflowId = left.WorkflowId
+	}
+
+	// Only dial if either side needs the server. History files may have been
+	// exported with encoded payloads, so decode them with the codec either way.
+	var cl client.Client
+	var codec converter.PayloadCodec
+	var err error
+	if left.HistoryFile == "" || right.HistoryFile == "" {
+		if cl, codec, err = dialClientWithCodec(cctx, &c.Parent.ClientOptions); err != nil {
+			return err
+		}
+		defer cl.Close()
+	} else if codec, err = loadPayloadCodec(cctx, &c.Parent.ClientOptions); err != nil {
+		return err
+	}
+	if err := left.load(cctx, cl, codec); err != nil {
+		return err
+	} else if err := right.load(cctx, cl, codec); err != nil {
+		return err
+	}
+
+	diff, err := diffWorkflowHistories(left, right)
+	if err != nil {
+		return err
+	}
+	if c.OnlyDifferences {
+		var events []workflowDiffEvent
+		for _, event := range diff.Events {
+			if event.Diff != workflowDiffSame {
+				events = append(events, event)
+			}
+		}
+		diff.Events = events
+	}
+
+	if cctx.JSONOutput {
+		if diff.Events == nil {
+			diff.Events = []workflowDiffEvent{}
+		}
+		return cctx.Printer.PrintStructured(diff, printer.StructuredOptions{})
+	}
+
+	cctx.Printer.Println(color.MagentaString("Left:"))
+	_ = cctx.Printer.PrintStructured(diff.Left, printer.StructuredOptions{})
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("Right:"))
+	_ = cctx.Printer.PrintStructured(diff.Right, printer.StructuredOptions{})
+	cctx.Printer.Println()
+	cctx.Printer.Println(color.MagentaString("Summary:"))
+	_ = cctx.Printer.PrintStructured(struct {
+		Same      int
+		Changed   int
+		LeftOnly  int
+		RightOnly int
+	}{diff.Same, diff.Changed, diff.LeftOnly, diff.RightOnly}, printer.StructuredOptions{})
+	cctx.Printer.Println()
+
+	cctx.Printer.Println(color.MagentaString("Events:"))
+	rows := make([]workflowDiffEventRow, len(diff.Events))
+	for i, event := range diff.Events {
+		rows[i] = workflowDiffEventRow{
+			Diff:           workflowDiffSymbol(event.Diff),
+			Key:            event.Key,
+			LeftEventType:  event.LeftEventType,
+			RightEventType: event.RightEventType,
+			TimeDelta:      event.TimeDelta,
+		}
+		if event.LeftEventId != 0 {
+			rows[i].LeftEventId = strconv.FormatInt(event.LeftEventId, 10)
+		}
+		if event.RightEventId != 0 {
+			rows[i].RightEventId = strconv.FormatInt(event.RightEventId, 10)
+		}
+	}
+	if len(rows) > 0 {
+		_ = cctx.Printer.PrintStructured(rows, printer.StructuredOptions{Table: &printer.TableOptions{}})
+	}
+
+	if diff.Changed > 0 {
+		cctx.Printer.Println()
+		cctx.Printer.Println(color.MagentaString("Differences:"))
+		for _, event := range diff.Events {
+			for _, field := range event.Differences {
+				cctx.Printer.Printlnf("  %v [%v/%v] %v:", event.Key, event.LeftEventId, event.RightEventId, field.Field)
+				cctx.Printer.Println(color.RedString("    - %v", field.Left))
+				cctx.Printer.Println(color.GreenString("    + %v", field.Right))
+			}
+		}
+	}
+	return nil
+}
+
+func workflowDiffSymbol(diff string) string {
+	switch diff {
+	case workflowDiffChanged:
+		return color.YellowString("~")
+	case workflowDiffLeftOnly:
+		return color.RedString("-")
+	case workflowDiffRightOnly:
+		return color.GreenString("+")
+	}
+	return ""
+}
+
+func (s *workflowDiffSource) load(cctx *CommandContext, cl client.Client, codec converter.PayloadCodec) error {
+	if s.HistoryFile != "" {
+		hist, err := readHistoryFile(s.HistoryFile)
+		if err != nil {
+			return err
+		}
+		if codec != nil {
+			if err := decodePayloadsInProto(cctx, hist, codec); err != nil {
+				return fmt.Errorf("failed decoding payloads in %v: %w", s.HistoryFile, err)
+			}
+		}
+		s.hist = hist
+	} else {
+		// Payloads are decoded by the client's codec interceptor
+		s.hist = &history.History{}
+		iter := cl.GetWorkflowHistory(cctx, s.WorkflowId, s.RunId, false, enums.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+		for iter.HasNext() {
+			event, err := iter.Next()
+			if err != nil {
+				return fmt.Errorf("failed getting history of %v: %w", s.WorkflowId, err)
+			}
+			s.hist.Events = append(s.hist.Events, event)
+		}
+		if len(s.hist.Events) == 0 {
+			return fmt.Errorf("workflow %v has no history events", s.WorkflowId)
+		}
+	}
+
+	started := s.hist.Events[0].GetWorkflowExecutionStartedEventAttributes()
+	if s.HistoryFile == "" && s.RunId == "" {
+		s.RunId = started.GetOriginalExecutionRunId()
+	}
+	s.Type = started.GetWorkflowType().GetName()
+	s.Events = len(s.hist.Events)
+	s.Duration = formatDurationShort(s.hist.Events[len(s.hist.Events)-1].EventTime.AsTime().Sub(
+		s.hist.Events[0].EventTime.AsTime()))
+	return nil
+}
+
+type workflowDiffKeyedEvent struct {
+	key    string
+	event  *history.HistoryEvent
+	offset time.Duration
+}
+
+// diffWorkflowHistories aligns the events of both histories by key, keeping
+// the order of the left history with right-only events placed before the next
+// event they precede on the right.
+func diffWorkflowHistories(left, right workflowDiffSource) (*workflowDiff, error) {
+	diff := &workflowDiff{Left: left, Right: right}
+	leftEvents := keyWorkflowDiffEvents(left.hist.Events)
+	rightEvents := keyWorkflowDiffEvents(right.hist.Events)
+	// Events are matched by key before laying them out, so events in a
+	// different order on each side, like activities completing in another
+	// order, still match. Keys can repeat, e.g. for a reused activity ID, so
+	// occurrences are matched in order.
+	rightIndexes := make(map[string][]int, len(rightEvents))
+	for i, event := range rightEvents {
+		rightIndexes[event.key] = append(rightIndexes[event.key], i)
+	}
+	leftMatches := make([]int, len(leftEvents))
+	rightMatched := make([]bool, len(rightEvents))
+	for i, event := range leftEvents {
+		leftMatches[i] = -1
+		if indexes := rightIndexes[event.key]; len(indexes) > 0 {
+			leftMatches[i] = indexes[0]
+			rightMatched[indexes[0]] = true
+			rightIndexes[event.key] = indexes[1:]
+		}
+	}
+	nextRight := 0
+	addRightOnlyUntil := func(end int) {
+		for ; nextRight < end; nextRight++ {
+			if !rightMatched[nextRight] {
+				event := rightEvents[nextRight]
+				diff.RightOnly++
+				diff.Events = append(diff.Events, workflowDiffEvent{
+					Diff:           workflowDiffRightOnly,
+					Key:            event.key,
+					RightEventId:   event.event.EventId,
+					RightEventType: event.event.EventType.String(),
+				})
+			}
+		}
+	}
+
+	for i, leftEvent := range leftEvents {
+		rightIndex := leftMatches[i]
+		if rightIndex < 0 {
+			diff.LeftOnly++
+			diff.Events = append(diff.Events, workflowDiffEvent{
+				Diff:          workflowDiffLeftOnly,
+				Key:           leftEvent.key,
+				LeftEventId:   leftEvent.event.EventId,
+				LeftEventType: leftEvent.event.EventType.String(),
+			})
+			continue
+		}
+		addRightOnlyUntil(rightIndex)
+		rightEvent := rightEvents[rightIndex]
+
+		event := workflowDiffEvent{
+			Diff:           workflowDiffSame,
+			Key:            leftEvent.key,
+			LeftEventId:    leftEvent.event.EventId,
+			LeftEventType:  leftEvent.event.EventType.String(),
+			RightEventId:   rightEvent.event.EventId,
+			RightEventType: rightEvent.event.EventType.String(),
+		}
+		if delta := rightEvent.offset - leftEvent.offset; delta > 0 {
+			event.TimeDelta = "+" + formatDurationShort(delta)
+		} else {
+			event.TimeDelta = formatDurationShort(delta)
+		}
+		if event.LeftEventType != event.RightEventType {
+			event.Differences = append(event.Differences, workflowDiffField{
+				Field: "eventType",
+				Left:  event.LeftEventType,
+				Right: event.RightEventType,
+			})
+		}
+		fields, err := diffWorkflowEventPayloads(leftEvent.event, rightEvent.event)
+		if err != nil {
+			return nil, err
+		}
+		event.Differences = append(event.Differences, fields...)
+		if len(event.Differences) > 0 {
+			event.Diff = workflowDiffChanged
+			diff.Changed++
+		} else {
+			diff.Same++
+		}
+		diff.Events = append(diff.Events, event)
+	}
+	addRightOnlyUntil(len(rightEvents))
+	return diff, nil
+}
+
+// keyWorkflowDiffEvents gives every event a key that identifies the same
+// logical step in another run of the workflow. Activities, timers, and updates
+// use their IDs, child workflows their type and occurrence (since default child
+// IDs contain the parent run ID), and other events their type and occurrence.
+// Closing events share a key regardless of how they closed so that, e.g., an
+// activity that completed in one run and failed in another is a changed event.
+func keyWorkflowDiffEvents(events []*history.HistoryEvent) []workflowDiffKeyedEvent {
+	occurrences := map[string]int{}
+	nextOccurrence := func(prefix string) string {
+		occurrences[prefix]++
+		return prefix + "#" + strconv.Itoa(occurrences[prefix])
+	}
+	activities := map[int64]string{}
+	children := map[int64]string{}
+	var workflowTask string
+
+	keyed := make([]workflowDiffKeyedEvent, len(events))
+	for i, event := range events {
+		var key string
+		switch event.EventType {
+		case enums.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED:
+			workflowTask = nextOccurrence("workflowTask")
+			key = workflowTask + "/scheduled"
+		case enums.EVENT_TYPE_WORKFLOW_TASK_STARTED:
+			key = workflowTask + "/started"
+		case enums.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+			enums.EVENT_TYPE_WORKFLOW_TASK_FAILED,
+			enums.EVENT_TYPE_WORKFLOW_TASK_TIMED_OUT:
+			key = workflowTask + "/closed"
+
+		case enums.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED:
+			activities[event.EventId] = "activity/" + event.GetActivityTaskScheduledEventAttributes().GetActivityId()
+			key = activities[event.EventId] + "/scheduled"
+		case enums.EVENT_TYPE_ACTIVITY_TASK_STARTED:
+			key = activities[event.GetActivityTaskStartedEventAttributes().GetScheduledEventId()] + "/started"
+		case enums.EVENT_TYPE_ACTIVITY_TASK_CANCEL_REQUESTED:
+			key = activities[event.GetActivityTaskCancelRequestedEventAttributes().GetScheduledEventId()] + "/cancelRequested"
+		case enums.EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+			key = activities[event.GetActivityTaskCompletedEventAttributes().GetScheduledEventId()] + "/closed"
+		case enums.EVENT_TYPE_ACTIVITY_TASK_FAILED:
+			key = activities[event.GetActivityTaskFailedEventAttributes().GetScheduledEventId()] + "/closed"
+		case enums.EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT:
+			key = activities[event.GetActivityTaskTimedOutEventAttributes().GetScheduledEventId()] + "/closed"
+		case enums.EVENT_TYPE_ACTIVITY_TASK_CANCELED:
+			key = activities[event.GetActivityTaskCanceledEventAttributes().GetScheduledEventId()] + "/closed"
+
+		case enums.EVENT_TYPE_TIMER_STARTED:
+			key = "timer/" + event.GetTimerStartedEventAttributes().GetTimerId() + "/started"
+		case enums.EVENT_TYPE_TIMER_FIRED:
+			key = "timer/" + event.GetTimerFiredEventAttributes().GetTimerId() + "/closed"
+		case enums.EVENT_TYPE_TIMER_CANCELED:
+			key = "timer/" + event.GetTimerCanceledEventAttributes().GetTimerId() + "/closed"
+
+		case enums.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+			attrs := event.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+			children[event.EventId] = nextOccurrence("child/" + attrs.GetWorkflowType().GetName())
+			key = children[event.EventId] + "/initiated"
+		case enums.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED:
+			key = children[event.GetStartChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId()] + "/started"
+		case enums.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED:
+			key = children[event.GetChildWorkflowExecutionStartedEventAttributes().GetInitiatedEventId()] + "/started"
+		case enums.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+			key = children[event.GetChildWorkflowExecutionCompletedEventAttributes().GetInitiatedEventId()] + "/closed"
+		case enums.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+			key = children[event.GetChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId()] + "/closed"
+		case enums.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED:
+			key = children[event.GetChildWorkflowExecutionCanceledEventAttributes().GetInitiatedEventId()] + "/closed"
+		case enums.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT:
+			key = children[event.GetChildWorkflowExecutionTimedOutEventAttributes().GetInitiatedEventId()] + "/closed"
+		case enums.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED:
+			key = children[event.GetChildWorkflowExecutionTerminatedEventAttributes().GetInitiatedEventId()] + "/closed"
+
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
+			key = nextOccurrence("signal/" + event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName())
+		case enums.EVENT_TYPE_MARKER_RECORDED:
+			key = nextOccurrence("marker/" + event.GetMarkerRecordedEventAttributes().GetMarkerName())
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+			key = "update/" + event.GetWorkflowExecutionUpdateAcceptedEventAttributes().GetAcceptedRequest().GetMeta().GetUpdateId() + "/accepted"
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
+			key = "update/" + event.GetWorkflowExecutionUpdateCompletedEventAttributes().GetMeta().GetUpdateId() + "/completed"
+
+		default:
+			if isWorkflowTerminatingEvent(event.EventType) {
+				key = "workflow/closed"
+			} else {
+				key = nextOccurrence(event.EventType.String())
+			}
+		}
+		keyed[i] = workflowDiffKeyedEvent{
+			key:    key,
+			event:  event,
+			offset: event.EventTime.AsTime().Sub(events[0].EventTime.AsTime()),
+		}
+	}
+	return keyed
+}
+
+var (
+	payloadsFullName = (&common.Payloads{}).ProtoReflect().Descriptor().FullName()
+	failureFullName  = (&failure.Failure{}).ProtoReflect().Descriptor().FullName()
+)
+
+// diffWorkflowEventPayloads compares the top-level payload and failure fields
+// (inputs, results, details, failures) of the event attributes. Values are
+// rendered as JSON with payload shorthand so JSON payloads compare by value and
+// failures without stack traces.
+func diffWorkflowEventPayloads(left, right *history.HistoryEvent) ([]workflowDiffField, error) {
+	leftFields, err := workflowEventPayloadFields(left)
+	if err != nil {
+		return nil, err
+	}
+	rightFields, err := workflowEventPayloadFields(right)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []workflowDiffField
+	addDiff := func(field string) {
+		if leftFields[field] != rightFields[field] {
+			diffs = append(diffs, workflowDiffField{Field: field, Left: leftFields[field], Right: rightFields[field]})
+		}
+	}
+	for _, fd := range workflowEventAttributeFields(left) {
+		addDiff(fd)
+	}
+	for _, fd := range workflowEventAttributeFields(right) {
+		if _, ok := leftFields[fd]; !ok {
+			addDiff(fd)
+		}
+	}
+	return diffs, nil
+}
+
+// Names of the payload and failure fields set on the event attributes, in
+// field order
+func workflowEventAttributeFields(event *history.HistoryEvent) []string {
+	attrs := workflowEventAttributes(event)
+	if attrs == nil {
+		return nil
+	}
+	var names []string
+	fields := attrs.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if isWorkflowDiffPayloadField(fd) && attrs.Has(fd) {
+			names = append(names, fd.JSONName())
+		}
+	}
+	return names
+}
+
+func workflowEventPayloadFields(event *history.HistoryEvent) (map[string]string, error) {
+	attrs := workflowEventAttributes(event)
+	if attrs == nil {
+		return nil, nil
+	}
+	opts := temporalproto.CustomJSONMarshalOptions{
+		Metadata: map[string]any{common.EnablePayloadShorthandMetadataKey: true},
+	}
+	values := map[string]string{}
+	var err error
+	attrs.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !isWorkflowDiffPayloadField(fd) {
+			return true
+		}
+		msg := v.Message().Interface()
+		// Stack traces differ between builds even when behavior doesn't
+		if f, ok := msg.(*failure.Failure); ok {
+			f = proto.Clone(f).(*failure.Failure)
+			for cause := f; cause != nil; cause = cause.Cause {
+				cause.StackTrace = ""
+			}
+			msg = f
+		}
+		var b []byte
+		if b, err = opts.Marshal(msg); err != nil {
+			err = fmt.Errorf("failed marshaling %v of event %v: %w", fd.JSONName(), event.EventId, err)
+			return false
+		}
+		// Normalize whitespace
+		var buf bytes.Buffer
+		if err = json.Compact(&buf, b); err != nil {
+			return false
+		}
+		values[fd.JSONName()] = buf.String()
+		return true
+	})
+	return values, err
+}
+
+func isWorkflowDiffPayloadField(fd protoreflect.FieldDescriptor) bool {
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		return false
+	}
+	name := fd.Message().FullName()
+	return name == payloadsFullName || name == payloadFullName || name == failureFullName
+}
+
+func workflowEventAttributes(event *history.HistoryEvent) protoreflect.Message {
+	m := event.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("attributes"))
+	if fd == nil {
+		return nil
+	}
+	return m.Get(fd).Message()
+}
@@ -0,0 +1,220 @@
+package temporalcli_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (s *SharedServerSuite) TestWorkflow_Diff() {
+	// Two runs of the default DevWorkflow with different inputs. Both execute
+	// the same activity, but it echoes different values.
+	run1, err := s.Client.ExecuteWorkflow(
+		s.Context,
+		client.StartWorkflowOptions{TaskQueue: s.Worker().Options.TaskQueue},
+		DevWorkflow,
+		"input-one",
+	)
+	s.NoError(err)
+	s.NoError(run1.Get(s.Context, nil))
+	run2, err := s.Client.ExecuteWorkflow(
+		s.Context,
+		client.StartWorkflowOptions{TaskQueue: s.Worker().Options.TaskQueue},
+		DevWorkflow,
+		"input-two",
+	)
+	s.NoError(err)
+	s.NoError(run2.Get(s.Context, nil))
+
+	// Text
+	res := s.Execute(
+		"workflow", "diff",
+		"--address", s.Address(),
+		"-w", run1.GetID(),
+		"--other-workflow-id", run2.GetID(),
+	)
+	s.NoError(res.Err)
+	out := res.Stdout.String()
+	s.ContainsOnSameLine(out, "Changed", "4")
+	s.ContainsOnSameLine(out, "LeftOnly", "0")
+	s.ContainsOnSameLine(out, "activity/dev-activity-id/scheduled", "ActivityTaskScheduled", "ActivityTaskScheduled")
+	s.Contains(out, `- ["input-one"]`)
+	s.Contains(out, `+ ["input-two"]`)
+
+	// JSON, only differences
+	res = s.Execute(
+		"workflow", "diff",
+		"--address", s.Address(),
+		"-w", run1.GetID(),
+		"--other-workflow-id", run2.GetID(),
+		"--only-differences",
+		"-o", "json",
+	)
+	s.NoError(res.Err)
+	var jsonOut map[string]any
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &jsonOut))
+	s.Equal(run1.GetRunID(), jsonOut["left"].(map[string]any)["runId"])
+	s.Equal(run2.GetRunID(), jsonOut["right"].(map[string]any)["runId"])
+	events := jsonOut["events"].([]any)
+	s.Len(events, 4)
+	var keys []string
+	for _, event := range events {
+		keys = append(keys, event.(map[string]any)["key"].(string))
+	}
+	s.Equal([]string{
+		"WorkflowExecutionStarted#1",
+		"activity/dev-activity-id/scheduled",
+		"activity/dev-activity-id/closed",
+		"workflow/closed",
+	}, keys)
+
+	// History files, same run on both sides
+	res = s.Execute(
+		"workflow", "show",
+		"--address", s.Address(),
+		"-w", run1.GetID(),
+		"-o", "json",
+	)
+	s.NoError(res.Err)
+	historyFile := filepath.Join(s.T().TempDir(), "history.json")
+	s.NoError(os.WriteFile(historyFile, res.Stdout.Bytes(), 0644))
+	res = s.Execute(
+		"workflow", "diff",
+		"--history-file", historyFile,
+		"--other-history-file", historyFile,
+		"-o", "json",
+	)
+	s.NoError(res.Err)
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &jsonOut))
+	s.Equal(0.0, jsonOut["changed"])
+	s.Equal(0.0, jsonOut["leftOnly"])
+	s.Equal(0.0, jsonOut["rightOnly"])
+
+	// Missing other side
+	res = s.Execute("workflow", "diff", "--history-file", historyFile)
+	s.ErrorContains(res.Err, "must specify one of")
+}
+
+func (s *SharedServerSuite) TestWorkflow_Diff_ReusedActivityID() {
+	s.Worker().OnDevWorkflow(func(ctx workflow.Context, input any) (any, error) {
+		ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			ActivityID:          "reused",
+			StartToCloseTimeout: 10 * time.Second,
+		})
+		for range 2 {
+			if err := workflow.ExecuteActivity(ctx, DevActivity, input).Get(ctx, nil); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	run, err := s.Client.ExecuteWorkflow(
+		s.Context,
+		client.StartWorkflowOptions{TaskQueue: s.Worker().Options.TaskQueue},
+		DevWorkflow,
+		"input",
+	)
+	s.NoError(err)
+	s.NoError(run.Get(s.Context, nil))
+
+	res := s.Execute(
+		"workflow", "show",
+		"--address", s.Address(),
+		"-w", run.GetID(),
+		"-o", "json",
+	)
+	s.NoError(res.Err)
+	historyFile := filepath.Join(s.T().TempDir(), "history.json")
+	s.NoError(os.WriteFile(historyFile, res.Stdout.Bytes(), 0644))
+
+	// Each occurrence of the activity matches the same occurrence on the right
+	res = s.Execute(
+		"workflow", "diff",
+		"--history-file", historyFile,
+		"--other-history-file", historyFile,
+		"-o", "json",
+	)
+	s.NoError(res.Err)
+	var jsonOut map[string]any
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &jsonOut))
+	s.Equal(0.0, jsonOut["changed"])
+	s.Equal(0.0, jsonOut["leftOnly"])
+	s.Equal(0.0, jsonOut["rightOnly"])
+}
+
+func (s *SharedServerSuite) TestWorkflow_Diff_ActivitiesCompletedInOtherOrder() {
+	// Two activities run at once and the slow one completes last, which is
+	// swapped between runs
+	var slow atomic.Value
+	s.Worker().OnDevActivity(func(ctx context.Context, a any) (any, error) {
+		if a == slow.Load() {
+			time.Sleep(500 * time.Millisecond)
+		}
+		return a, nil
+	})
+	s.Worker().OnDevWorkflow(func(ctx workflow.Context, input any) (any, error) {
+		var futures []workflow.Future
+		for _, id := range []string{"first", "second"} {
+			ctx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+				ActivityID:          id,
+				StartToCloseTimeout: 10 * time.Second,
+			})
+			futures = append(futures, workflow.ExecuteActivity(ctx, DevActivity, id))
+		}
+		for _, future := range futures {
+			if err := future.Get(ctx, nil); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	var runIDs []string
+	for _, slowID := range []string{"second", "first"} {
+		slow.Store(slowID)
+		run, err := s.Client.ExecuteWorkflow(
+			s.Context,
+			client.StartWorkflowOptions{TaskQueue: s.Worker().Options.TaskQueue},
+			DevWorkflow,
+			"input",
+		)
+		s.NoError(err)
+		s.NoError(run.Get(s.Context, nil))
+		runIDs = append(runIDs, run.GetID())
+	}
+
+	// Every event matches the same event of the other run
+	res := s.Execute(
+		"workflow", "diff",
+		"--address", s.Address(),
+		"-w", runIDs[0],
+		"--other-workflow-id", runIDs[1],
+		"-o", "json",
+	)
+	s.NoError(res.Err)
+	var jsonOut struct {
+		Changed   int `json:"changed"`
+		LeftOnly  int `json:"leftOnly"`
+		RightOnly int `json:"rightOnly"`
+		Events    []struct {
+			Key          string `json:"key"`
+			LeftEventId  int64  `json:"leftEventId"`
+			RightEventId int64  `json:"rightEventId"`
+		} `json:"events"`
+	}
+	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &jsonOut))
+	s.Equal(0, jsonOut.LeftOnly)
+	s.Equal(0, jsonOut.RightOnly)
+	eventIDs := map[string][2]int64{}
+	for _, event := range jsonOut.Events {
+		eventIDs[event.Key] = [2]int64{event.LeftEventId, event.RightEventId}
+	}
+	first, second := eventIDs["activity/first/closed"], eventIDs["activity/second/closed"]
+	s.Less(first[0], second[0])
+	s.Greater(first[1], second[1])
+}
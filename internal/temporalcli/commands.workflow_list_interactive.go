@@ -0,0 +1,575 @@
+package temporalcli
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/temporalio/cli/internal/printer"
+	"github.com/temporalio/cli/internal/tracer"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"golang.org/x/term"
+)
+
+const workflowBrowserHelp = "↑/↓ select  n/p page  enter describe  h history  " +
+	"s signal  c cancel  t terminate  r reset  R refresh  esc back  q quit"
+
+func (c *TemporalWorkflowListCommand) runInteractive(cctx *CommandContext) error {
+	if cctx.JSONOutput {
+		return fmt.Errorf("interactive mode does not support JSON output")
+	} else if c.Limit > 0 {
+		return fmt.Errorf("cannot set limit in interactive mode")
+	}
+	cl, codec, err := dialClientWithCodec(cctx, &c.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
+	// Raw mode only applies to a real terminal, otherwise keys are read as-is
+	out := cctx.Printer.Output
+	if f, ok := cctx.Options.Stdin.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		oldState, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return fmt.Errorf("failed setting terminal to raw mode: %w", err)
+		}
+		defer func() { _ = term.Restore(int(f.Fd()), oldState) }()
+		// Raw mode no longer moves to the start of the line on newline
+		out = crlfWriter{out}
+	}
+
+	b := &workflowBrowser{
+		cctx:      cctx,
+		cl:        cl,
+		codec:     codec,
+		namespace: c.Parent.Namespace,
+		identity:  c.Parent.Identity,
+		query:     c.Query,
+		fetchPage: c.pageFetcher(cctx, cl),
+		in:        bufio.NewReader(cctx.Options.Stdin),
+		writer:    tracer.NewTermWriter(out),
+	}
+	return b.run()
+}
+
+type workflowBrowserView int
+
+const (
+	workflowBrowserList workflowBrowserView = iota
+	workflowBrowserDetail
+	workflowBrowserHistory
+)
+
+// workflowBrowser is the state of an interactive workflow list. Each key
+// updates the state and redraws the whole frame with the TermWriter.
+type workflowBrowser struct {
+	cctx      *CommandContext
+	cl        client.Client
+	codec     converter.PayloadCodec
+	namespace string
+	identity  string
+	query     string
+	fetchPage func(next []byte) (workflowPage, error)
+	in        *bufio.Reader
+	writer    *tracer.TermWriter
+
+	// Tokens of the pages up to and including the current one
+	pageTokens    [][]byte
+	nextPageToken []byte
+	execs         []*workflow.WorkflowExecutionInfo
+	selected      int
+
+	view workflowBrowserView
+	// Lines of the detail or history view and the first one shown
+	lines  []string
+	scroll int
+	status string
+}
+
+func (b *workflowBrowser) run() error {
+	if err := b.loadPage(nil); err != nil {
+		return err
+	}
+	for {
+		b.render("")
+		key, err := b.readKey()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if quit := b.handleKey(key); quit {
+			return nil
+		}
+	}
+}
+
+func (b *workflowBrowser) handleKey(key string) (quit bool) {
+	b.status = ""
+	switch key {
+	case "q", "ctrl-c":
+		if key == "q" && b.view != workflowBrowserList {
+			b.view = workflowBrowserList
+			return false
+		}
+		return true
+	case "esc":
+		b.view = workflowBrowserList
+	case "up", "k":
+		b.move(-1)
+	case "down", "j":
+		b.move(1)
+	case "pgup":
+		b.move(-b.pageHeight())
+	case "pgdown":
+		b.move(b.pageHeight())
+	case "n":
+		if b.view == workflowBrowserList && len(b.nextPageToken) > 0 {
+			b.setErrorStatus(b.loadPage(b.nextPageToken))
+		}
+	case "p":
+		if b.view == workflowBrowserList && len(b.pageTokens) > 1 {
+			prev := b.pageTokens[len(b.pageTokens)-2]
+			b.pageTokens = b.pageTokens[:len(b.pageTokens)-2]
+			b.setErrorStatus(b.loadPage(prev))
+		}
+	case "R":
+		b.refresh()
+	case "enter":
+		b.setErrorStatus(b.showDetail())
+	case "h":
+		b.setErrorStatus(b.showHistory())
+	case "s":
+		b.setErrorStatus(b.signal())
+	case "c":
+		b.setErrorStatus(b.cancel())
+	case "t":
+		b.setErrorStatus(b.terminate())
+	case "r":
+		b.setErrorStatus(b.reset())
+	}
+	return false
+}
+
+func (b *workflowBrowser) setErrorStatus(err error) {
+	if err != nil {
+		b.status = color.RedString("Error: %v", err)
+	}
+}
+
+func (b *workflowBrowser) loadPage(token []byte) error {
+	page, err := b.fetchPage(token)
+	if err != nil {
+		return fmt.Errorf("failed listing workflows: %w", err)
+	}
+	b.pageTokens = append(b.pageTokens, token)
+	b.nextPageToken = page.GetNextPageToken()
+	b.execs = page.GetExecutions()
+	b.selected = 0
+	return nil
+}
+
+func (b *workflowBrowser) refresh() {
+	// Reload the current page and whatever view is showing
+	if len(b.pageTokens) > 0 {
+		current := b.pageTokens[len(b.pageTokens)-1]
+		b.pageTokens = b.pageTokens[:len(b.pageTokens)-1]
+		selected := b.selected
+		if err := b.loadPage(current); err != nil {
+			b.setErrorStatus(err)
+			return
+		}
+		b.selected = min(selected, max(len(b.execs)-1, 0))
+	}
+	switch b.view {
+	case workflowBrowserDetail:
+		b.setErrorStatus(b.showDetail())
+	case workflowBrowserHistory:
+		b.setErrorStatus(b.showHistory())
+	}
+}
+
+func (b *workflowBrowser) move(delta int) {
+	if b.view == workflowBrowserList {
+		b.selected = min(max(b.selected+delta, 0), max(len(b.execs)-1, 0))
+	} else {
+		b.scroll = min(max(b.scroll+delta, 0), max(len(b.lines)-1, 0))
+	}
+}
+
+func (b *workflowBrowser) selectedExec() (*common.WorkflowExecution, error) {
+	if len(b.execs) == 0 {
+		return nil, fmt.Errorf("no workflow selected")
+	}
+	return b.execs[b.selected].Execution, nil
+}
+
+func (b *workflowBrowser) showDetail() error {
+	exec, err := b.selectedExec()
+	if err != nil {
+		return err
+	}
+	resp, err := b.cl.DescribeWorkflowExecution(b.cctx, exec.WorkflowId, exec.RunId)
+	if err != nil {
+		return fmt.Errorf("failed describing workflow: %w", err)
+	}
+	closeEvent, err := getWorkflowCloseEvent(b.cctx, b.cl, resp)
+	if err != nil {
+		return err
+	}
+	// Render the same text as "workflow describe" into the pane
+	var buf bytes.Buffer
+	if err := printWorkflowDescriptionText(b.bufferedContext(&buf), b.namespace, resp, closeEvent); err != nil {
+		return err
+	}
+	b.showLines(workflowBrowserDetail, buf.String())
+	return nil
+}
+
+func (b *workflowBrowser) showHistory() error {
+	exec, err := b.selectedExec()
+	if err != nil {
+		return err
+	}
+	iter := &structuredHistoryIter{
+		ctx:        b.cctx,
+		client:     b.cl,
+		namespace:  b.namespace,
+		workflowID: exec.WorkflowId,
+		runID:      exec.RunId,
+		codec:      b.codec,
+	}
+	var buf bytes.Buffer
+	if err := iter.print(b.bufferedContext(&buf)); err != nil {
+		return fmt.Errorf("failed getting history: %w", err)
+	}
+	b.showLines(workflowBrowserHistory, buf.String())
+	return nil
+}
+
+// bufferedContext returns a copy of the context that prints text to buf with
+// the same formatting as the real printer.
+func (b *workflowBrowser) bufferedContext(buf *bytes.Buffer) *CommandContext {
+	cctx := *b.cctx
+	cctx.Printer = &printer.Printer{
+		Output:               buf,
+		JSONPayloadShorthand: b.cctx.Printer.JSONPayloadShorthand,
+		FormatTime:           b.cctx.Printer.FormatTime,
+		TableHeaderColorer:   b.cctx.Printer.TableHeaderColorer,
+	}
+	return &cctx
+}
+
+func (b *workflowBrowser) showLines(view workflowBrowserView, text string) {
+	b.view = view
+	b.lines = strings.Split(strings.TrimRight(text, "\n"), "\n")
+	b.scroll = 0
+}
+
+func (b *workflowBrowser) signal() error {
+	exec, err := b.selectedExec()
+	if err != nil {
+		return err
+	}
+	name, ok, err := b.readLine("Signal name: ")
+	if !ok || err != nil || name == "" {
+		return err
+	}
+	input, ok, err := b.readLine("Signal input JSON (empty for none): ")
+	if !ok || err != nil {
+		return err
+	}
+	var payloads *common.Payloads
+	if input != "" {
+		if payloads, err = CreatePayloads(
+			[][]byte{[]byte(input)}, map[string][][]byte{"encoding": {[]byte("json/plain")}}, false); err != nil {
+			return err
+		}
+	}
+	if ok, err := b.confirm(fmt.Sprintf("Send signal %q to workflow %v? y/N ", name, exec.WorkflowId)); !ok || err != nil {
+		return err
+	}
+	_, err = b.cl.WorkflowService().SignalWorkflowExecution(b.cctx, &workflowservice.SignalWorkflowExecutionRequest{
+		Namespace:         b.namespace,
+		WorkflowExecution: exec,
+		SignalName:        name,
+		Input:             payloads,
+		Identity:          b.identity,
+	})
+	if err != nil {
+		return fmt.Errorf("failed signaling workflow: %w", err)
+	}
+	b.status = fmt.Sprintf("Signaled workflow %v", exec.WorkflowId)
+	return nil
+}
+
+func (b *workflowBrowser) cancel() error {
+	exec, err := b.selectedExec()
+	if err != nil {
+		return err
+	}
+	if ok, err := b.confirm(fmt.Sprintf("Cancel workflow %v? y/N ", exec.WorkflowId)); !ok || err != nil {
+		return err
+	}
+	if err := b.cl.CancelWorkflow(b.cctx, exec.WorkflowId, exec.RunId); err != nil {
+		return fmt.Errorf("failed to cancel workflow: %w", err)
+	}
+	b.status = fmt.Sprintf("Canceled workflow %v", exec.WorkflowId)
+	return nil
+}
+
+func (b *workflowBrowser) terminate() error {
+	exec, err := b.selectedExec()
+	if err != nil {
+		return err
+	}
+	if ok, err := b.confirm(fmt.Sprintf("Terminate workflow %v? y/N ", exec.WorkflowId)); !ok || err != nil {
+		return err
+	}
+	if err := b.cl.TerminateWorkflow(b.cctx, exec.WorkflowId, exec.RunId, defaultReason()); err != nil {
+		return fmt.Errorf("failed to terminate workflow: %w", err)
+	}
+	b.status = fmt.Sprintf("Terminated workflow %v", exec.WorkflowId)
+	return nil
+}
+
+func (b *workflowBrowser) reset() error {
+	exec, err := b.selectedExec()
+	if err != nil {
+		return err
+	}
+	resetType, ok, err := b.readLine("Reset to FirstWorkflowTask or LastWorkflowTask (empty for LastWorkflowTask): ")
+	if !ok || err != nil {
+		return err
+	}
+	getEventID := getLastWorkflowTaskEventID
+	switch resetType {
+	case "", "LastWorkflowTask":
+		resetType = "LastWorkflowTask"
+	case "FirstWorkflowTask":
+		getEventID = getFirstWorkflowTaskEventID
+	default:
+		return fmt.Errorf("invalid reset type: %v", resetType)
+	}
+	if ok, err := b.confirm(fmt.Sprintf("Reset workflow %v to %v? y/N ", exec.WorkflowId, resetType)); !ok || err != nil {
+		return err
+	}
+	runID, eventID, err := getEventID(b.cctx, b.namespace, exec.WorkflowId, exec.RunId, b.cl.WorkflowService())
+	if err != nil {
+		return fmt.Errorf("failed getting reset event: %w", err)
+	}
+	resp, err := b.cl.ResetWorkflowExecution(b.cctx, &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace:                 b.namespace,
+		WorkflowExecution:         &common.WorkflowExecution{WorkflowId: exec.WorkflowId, RunId: runID},
+		Reason:                    defaultReason(),
+		WorkflowTaskFinishEventId: eventID,
+		ResetReapplyType:          enums.RESET_REAPPLY_TYPE_ALL_ELIGIBLE,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset workflow: %w", err)
+	}
+	b.status = fmt.Sprintf("Reset workflow %v, new run ID %v", exec.WorkflowId, resp.RunId)
+	return nil
+}
+
+func (b *workflowBrowser) confirm(message string) (bool, error) {
+	line, ok, err := b.readLine(message)
+	if !ok || err != nil {
+		return false, err
+	}
+	line = strings.ToLower(line)
+	return line == "y" || line == "yes", nil
+}
+
+// readLine reads a line of input shown at the bottom of the frame. False is
+// returned if the input is aborted with escape or the input ends.
+func (b *workflowBrowser) readLine(prompt string) (string, bool, error) {
+	var line []rune
+	for {
+		b.render(prompt + string(line))
+		key, err := b.readKey()
+		if err == io.EOF {
+			return "", false, nil
+		} else if err != nil {
+			return "", false, err
+		}
+		switch key {
+		case "enter":
+			return strings.TrimSpace(string(line)), true, nil
+		case "esc", "ctrl-c":
+			return "", false, nil
+		case "backspace":
+			if len(line) > 0 {
+				line = line[:len(line)-1]
+			}
+		default:
+			// Only single printable characters are typed, other keys are ignored
+			if r := []rune(key); len(r) == 1 {
+				line = append(line, r[0])
+			}
+		}
+	}
+}
+
+// readKey returns the next key as its character or as a name for special keys.
+func (b *workflowBrowser) readKey() (string, error) {
+	r, _, err := b.in.ReadRune()
+	if err != nil {
+		return "", err
+	}
+	switch r {
+	case '\r', '\n':
+		return "enter", nil
+	case 3:
+		return "ctrl-c", nil
+	case 8, 127:
+		return "backspace", nil
+	case 27:
+		// Escape sequences arrive together, a lone escape is the escape key.
+		// Only look at what is already buffered, a terminal would block waiting
+		// for the next key.
+		if b.in.Buffered() == 0 {
+			return "esc", nil
+		} else if next, err := b.in.Peek(1); err != nil || next[0] != '[' {
+			return "esc", nil
+		}
+		_, _ = b.in.ReadByte()
+		seq, err := b.in.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		switch seq {
+		case 'A':
+			return "up", nil
+		case 'B':
+			return "down", nil
+		case '5', '6':
+			// Page up and down end with a tilde
+			_, _ = b.in.ReadByte()
+			if seq == '5' {
+				return "pgup", nil
+			}
+			return "pgdown", nil
+		}
+		return "", nil
+	}
+	return string(r), nil
+}
+
+// pageHeight is how many list rows or view lines fit in the terminal, or 0 if
+// the size is unknown.
+func (b *workflowBrowser) pageHeight() int {
+	_, height := b.writer.GetSize()
+	// Title, header and the two footer lines
+	if height > 0 {
+		return max(height-4, 1)
+	}
+	return 0
+}
+
+func (b *workflowBrowser) render(prompt string) {
+	var frame strings.Builder
+	// The selection may be gone after a refresh
+	if b.view == workflowBrowserList || len(b.execs) == 0 {
+		b.view = workflowBrowserList
+		b.renderList(&frame)
+	} else {
+		b.renderLines(&frame)
+	}
+	if prompt != "" {
+		frame.WriteString(prompt + "\n")
+	} else {
+		frame.WriteString(b.status + "\n")
+	}
+	frame.WriteString(color.New(color.Faint).Sprint(workflowBrowserHelp))
+	_, _ = b.writer.WriteLine(frame.String())
+	_ = b.writer.Flush(true)
+}
+
+func (b *workflowBrowser) renderList(frame *strings.Builder) {
+	title := fmt.Sprintf("Workflows in %v, page %v", b.namespace, len(b.pageTokens))
+	if b.query != "" {
+		title += fmt.Sprintf(", query %q", b.query)
+	}
+	if len(b.nextPageToken) > 0 {
+		title += ", more with n"
+	}
+	frame.WriteString(color.MagentaString(title) + "\n")
+
+	// Only show rows around the selection that fit
+	first, last := 0, len(b.execs)
+	if height := b.pageHeight(); height > 0 && height < len(b.execs) {
+		first = min(max(b.selected-height/2, 0), len(b.execs)-height)
+		last = first + height
+	}
+	rows := [][]string{{"Status", "WorkflowId", "Type", "StartTime"}}
+	for _, exec := range b.execs[first:last] {
+		rows = append(rows, []string{
+			exec.Status.String(),
+			exec.Execution.GetWorkflowId(),
+			exec.Type.GetName(),
+			b.cctx.Printer.FormatTime(timestampToTime(exec.StartTime)),
+		})
+	}
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, col := range row {
+			widths[i] = max(widths[i], len(col))
+		}
+	}
+	for i, row := range rows {
+		var line strings.Builder
+		for j, col := range row {
+			fmt.Fprintf(&line, "%-*v  ", widths[j], col)
+		}
+		text := strings.TrimRight(line.String(), " ")
+		switch {
+		case i == 0:
+			frame.WriteString("  " + color.MagentaString(text) + "\n")
+		case first+i-1 == b.selected:
+			frame.WriteString("> " + color.New(color.ReverseVideo).Sprint(text) + "\n")
+		default:
+			frame.WriteString("  " + text + "\n")
+		}
+	}
+	if len(b.execs) == 0 {
+		frame.WriteString("  No workflows found\n")
+	}
+}
+
+func (b *workflowBrowser) renderLines(frame *strings.Builder) {
+	exec := b.execs[b.selected].Execution
+	title := "Workflow " + exec.GetWorkflowId()
+	if b.view == workflowBrowserHistory {
+		title = "History of " + title
+	}
+	frame.WriteString(color.MagentaString("%v (run %v)", title, exec.GetRunId()) + "\n")
+	last := len(b.lines)
+	// The title takes the place of the list header
+	if height := b.pageHeight(); height > 0 {
+		last = min(b.scroll+height+1, len(b.lines))
+	}
+	for _, line := range b.lines[b.scroll:last] {
+		frame.WriteString(line + "\n")
+	}
+}
+
+// crlfWriter writes newlines as carriage return and newline for terminals in
+// raw mode.
+type crlfWriter struct{ io.Writer }
+
+func (w crlfWriter) Write(p []byte) (int, error) {
+	if _, err := w.Writer.Write(bytes.ReplaceAll(p, []byte("\n"), []byte("\r\n"))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,33 @@
+package temporalcli
+
+import (
+	"bufio"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowBrowserReadKey_LoneEscape(t *testing.T) {
+	// A terminal keeps stdin open after the key, so nothing more arrives
+	r, w := io.Pipe()
+	defer w.Close()
+	go func() { _, _ = w.Write([]byte("\x1b")) }()
+	b := &workflowBrowser{in: bufio.NewReader(r), view: workflowBrowserDetail}
+
+	keys := make(chan string, 1)
+	go func() {
+		key, _ := b.readKey()
+		keys <- key
+	}()
+	select {
+	case key := <-keys:
+		require.Equal(t, "esc", key)
+		// Goes back to the list
+		require.False(t, b.handleKey(key))
+		require.Equal(t, workflowBrowserList, b.view)
+	case <-time.After(5 * time.Second):
+		t.Fatal("escape key blocked waiting for more input")
+	}
+}
@@ -0,0 +1,75 @@
+package temporalcli_test
+
+import (
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
+)
+
+func (s *SharedServerSuite) TestWorkflow_List_Interactive() {
+	s.Worker().OnDevWorkflow(func(ctx workflow.Context, a any) (any, error) {
+		var sig any
+		workflow.GetSignalChannel(ctx, "my-signal").Receive(ctx, &sig)
+		return sig, nil
+	})
+	signaled, err := s.Client.ExecuteWorkflow(
+		s.Context,
+		client.StartWorkflowOptions{TaskQueue: s.Worker().Options.TaskQueue},
+		DevWorkflow,
+		"ignored",
+	)
+	s.NoError(err)
+	terminated, err := s.Client.ExecuteWorkflow(
+		s.Context,
+		client.StartWorkflowOptions{TaskQueue: s.Worker().Options.TaskQueue},
+		DevWorkflow,
+		"ignored",
+	)
+	s.NoError(err)
+	s.Eventually(func() bool {
+		resp, err := s.Client.CountWorkflow(s.Context, &workflowservice.CountWorkflowExecutionsRequest{
+			Query: "WorkflowId = '" + signaled.GetID() + "' OR WorkflowId = '" + terminated.GetID() + "'",
+		})
+		s.NoError(err)
+		return resp.Count == 2
+	}, 3*time.Second, 100*time.Millisecond)
+
+	// Describe, show history, go back, then signal
+	s.CommandHarness.Stdin.WriteString("\rh\x1bs" + "my-signal\r" + `{"foo":"bar"}` + "\ry\rq")
+	res := s.Execute(
+		"workflow", "list",
+		"--address", s.Address(),
+		"-q", "WorkflowId = '"+signaled.GetID()+"'",
+		"--interactive",
+	)
+	s.NoError(res.Err)
+	out := res.Stdout.String()
+	s.ContainsOnSameLine(out, "Running", signaled.GetID(), "DevWorkflow")
+	s.Contains(out, "Execution Info:")
+	s.Contains(out, "WorkflowExecutionStarted")
+	s.Contains(out, "Signaled workflow "+signaled.GetID())
+	var ret any
+	s.NoError(signaled.Get(s.Context, &ret))
+	s.Equal(map[string]any{"foo": "bar"}, ret)
+
+	// Declining does nothing, then terminate
+	s.CommandHarness.Stdin.WriteString("tn\rt\x7fy\r")
+	res = s.Execute(
+		"workflow", "list",
+		"--address", s.Address(),
+		"-q", "WorkflowId = '"+terminated.GetID()+"'",
+		"--interactive",
+	)
+	s.NoError(res.Err)
+	s.Contains(res.Stdout.String(), "Terminated workflow "+terminated.GetID())
+	desc, err := s.Client.DescribeWorkflowExecution(s.Context, terminated.GetID(), "")
+	s.NoError(err)
+	s.Equal(enums.WORKFLOW_EXECUTION_STATUS_TERMINATED, desc.WorkflowExecutionInfo.Status)
+
+	// No JSON
+	res = s.Execute("workflow", "list", "--address", s.Address(), "--interactive", "-o", "json")
+	s.EqualError(res.Err, "interactive mode does not support JSON output")
+}
@@ -47,6 +47,12 @@ func (c *TemporalWorkflowResetCommand) validateWorkflowResetArguments() error {
 	if c.WorkflowId == "" {
 		return errors.New("must specify workflow id")
 	}
+	if c.DryRun {
+		return errors.New("must not specify dry run for non-batch reset")
+	}
+	if c.Wait {
+		return errors.New("must not specify wait for non-batch reset")
+	}
 	return nil
 }
 
@@ -134,6 +140,10 @@ func (c *TemporalWorkflowResetCommand) runBatchResetWithPostOps(cctx *CommandCon
 			PostResetOperations: postOps,
 		},
 	}
+	// A dry run only previews the batch, so there is nothing to confirm
+	if c.DryRun {
+		return startBatchJob(cctx, cl, &request, c.BatchJobOptions)
+	}
 	// The count is only used in the confirmation prompt; skip the request when --yes
 	// bypasses it, so batch jobs can still proceed if the visibility API is timing out.
 	var promptMessage string
@@ -154,7 +164,7 @@ func (c *TemporalWorkflowResetCommand) runBatchResetWithPostOps(cctx *CommandCon
 		return fmt.Errorf("user denied confirmation")
 	}
 
-	return startBatchJob(cctx, cl, &request)
+	return startBatchJob(cctx, cl, &request, c.BatchJobOptions)
 }
 
 func (c *TemporalWorkflowResetCommand) batchResetOptions() (*common.ResetOptions, error) {
@@ -69,6 +69,9 @@ func (c *TemporalWorkflowResetWithWorkflowUpdateOptionsCommand) run(cctx *Comman
 	}
 
 	if c.Parent.WorkflowId != "" {
+		if c.Parent.Wait {
+			return fmt.Errorf("must not specify wait for non-batch reset")
+		}
 		return c.Parent.doWorkflowResetWithPostOps(cctx, cl, []*workflowpb.PostResetOperation{postOp})
 	}
 	return c.Parent.runBatchResetWithPostOps(cctx, cl, []*workflowpb.PostResetOperation{postOp})
@@ -3,13 +3,17 @@ package temporalcli
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/fatih/color"
 	"github.com/temporalio/cli/internal/printer"
 	"github.com/temporalio/cli/internal/tracer"
+	"go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/history/v1"
 	"go.temporal.io/sdk/client"
 )
 
@@ -49,22 +53,32 @@ func (c *TemporalWorkflowTraceCommand) run(cctx *CommandContext, _ []string) err
 	if cctx.JSONOutput {
 		return fmt.Errorf("JSON output not supported for trace command")
 	}
-	cl, err := dialClient(cctx, &c.Parent.ClientOptions)
-	if err != nil {
-		return err
-	}
-	defer cl.Close()
 
 	opts := tracer.WorkflowTracerOptions{
 		Depth:       c.Depth,
 		Concurrency: c.Concurrency,
 		NoFold:      c.NoFold,
 	}
+	var err error
 	opts.FoldStatuses, err = c.getFoldStatuses()
 	if err != nil {
 		return err
 	}
 
+	if c.HistoryFile != "" {
+		return c.printHistoryFileTrace(cctx, opts)
+	} else if c.ChildHistoryDir != "" {
+		return fmt.Errorf("--child-history-dir requires --history-file")
+	} else if c.WorkflowId == "" {
+		return fmt.Errorf("must specify workflow id")
+	}
+
+	cl, err := dialClient(cctx, &c.Parent.ClientOptions)
+	if err != nil {
+		return err
+	}
+	defer cl.Close()
+
 	if err = c.printWorkflowSummary(cctx, cl, c.WorkflowId, c.RunId); err != nil {
 		return err
 	}
@@ -74,10 +88,10 @@ func (c *TemporalWorkflowTraceCommand) run(cctx *CommandContext, _ []string) err
 }
 
 type workflowTraceSummary struct {
-	WorkflowId string `json:"workflowId"`
+	WorkflowId string `json:"workflowId" cli:",cardOmitEmpty"`
 	RunId      string `json:"runId"`
 	Type       string `json:"type"`
-	Namespace  string `json:"namespace"`
+	Namespace  string `json:"namespace" cli:",cardOmitEmpty"`
 	TaskQueue  string `json:"taskQueue"`
 }
 
@@ -129,3 +143,71 @@ func (c *TemporalWorkflowTraceCommand) printWorkflowTrace(cctx *CommandContext,
 	cctx.Printer.Println(color.MagentaString("Progress:"))
 	return workflowTracer.PrintUpdates(tmpl, time.Second)
 }
+
+// printHistoryFileTrace renders the trace of a workflow (and optionally its
+// children) from Event History files without contacting the server.
+func (c *TemporalWorkflowTraceCommand) printHistoryFileTrace(cctx *CommandContext, opts tracer.WorkflowTracerOptions) error {
+	hist, err := readHistoryFile(c.HistoryFile)
+	if err != nil {
+		return err
+	}
+	var childHistory tracer.ChildHistoryFunc
+	if c.ChildHistoryDir != "" {
+		if childHistory, err = readChildHistoryDir(c.ChildHistoryDir); err != nil {
+			return err
+		}
+	}
+	state := tracer.NewWorkflowExecutionStateFromHistory(
+		c.WorkflowId, hist, childHistory, opts.NoFold, opts.FoldStatuses, opts.Depth)
+	if c.RunId != "" && c.RunId != state.Execution.GetRunId() {
+		return fmt.Errorf("history file is for run ID %v, not %v", state.Execution.GetRunId(), c.RunId)
+	}
+
+	cctx.Printer.Println(color.MagentaString("Execution summary:"))
+	_ = cctx.Printer.PrintStructured(workflowTraceSummary{
+		WorkflowId: state.Execution.GetWorkflowId(),
+		RunId:      state.Execution.GetRunId(),
+		Type:       state.Type.GetName(),
+		TaskQueue:  hist.Events[0].GetWorkflowExecutionStartedEventAttributes().GetTaskQueue().GetName(),
+	}, printer.StructuredOptions{})
+	cctx.Printer.Println()
+
+	tmpl, err := tracer.NewExecutionTemplate(opts.FoldStatuses, opts.NoFold)
+	if err != nil {
+		return err
+	}
+	cctx.Printer.Println(color.MagentaString("Progress:"))
+	writer := tracer.NewTermWriter(cctx.Printer.Output)
+	if err := tmpl.Execute(writer, state, 0); err != nil {
+		return err
+	}
+	return writer.Flush(false)
+}
+
+// readChildHistoryDir loads every JSON file in the directory as an Event
+// History. Histories are matched to child workflows by the run ID in their
+// started event, or by a file name equal to the run ID or workflow ID.
+func readChildHistoryDir(dir string) (tracer.ChildHistoryFunc, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	histories := make(map[string]*history.History, len(files))
+	for _, file := range files {
+		hist, err := readHistoryFile(file)
+		if err != nil {
+			return nil, err
+		}
+		histories[strings.TrimSuffix(filepath.Base(file), ".json")] = hist
+		if runId := hist.Events[0].GetWorkflowExecutionStartedEventAttributes().GetOriginalExecutionRunId(); runId != "" {
+			histories[runId] = hist
+		}
+	}
+	return func(exec *common.WorkflowExecution) (*history.History, bool) {
+		if hist, ok := histories[exec.GetRunId()]; ok {
+			return hist, true
+		}
+		hist, ok := histories[exec.GetWorkflowId()]
+		return hist, ok
+	}, nil
+}
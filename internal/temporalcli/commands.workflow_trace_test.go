@@ -3,8 +3,11 @@ package temporalcli_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
+	"go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/workflow"
@@ -148,3 +151,88 @@ func (s *SharedServerSuite) TestWorkflow_Trace_Fold() {
 	s.Contains(out, " │   ┼ ! DevActivity")
 	s.Contains(out, " │   │   Failure: intentional error")
 }
+
+func (s *SharedServerSuite) TestWorkflow_Trace_HistoryFile() {
+	s.Worker().OnDevWorkflow(func(ctx workflow.Context, input any) (any, error) {
+		if input == "child" {
+			var res any
+			err := workflow.ExecuteActivity(ctx, DevActivity, input).Get(ctx, &res)
+			return res, err
+		}
+		var res any
+		err := workflow.ExecuteChildWorkflow(ctx, "DevWorkflow", "child").Get(ctx, &res)
+		return res, err
+	})
+
+	run, err := s.Client.ExecuteWorkflow(
+		s.Context,
+		client.StartWorkflowOptions{TaskQueue: s.Worker().Options.TaskQueue},
+		DevWorkflow,
+		"parent",
+	)
+	s.NoError(err)
+	s.NoError(run.Get(s.Context, nil))
+
+	// Export parent and child histories
+	dir := s.T().TempDir()
+	childDir := filepath.Join(dir, "children")
+	s.NoError(os.Mkdir(childDir, 0755))
+	exportHistory := func(wfId, runId, file string) {
+		res := s.Execute(
+			"workflow", "show",
+			"--address", s.Address(),
+			"-w", wfId,
+			"-r", runId,
+			"-o", "json",
+		)
+		s.NoError(res.Err)
+		s.NoError(os.WriteFile(file, res.Stdout.Bytes(), 0644))
+	}
+	exportHistory(run.GetID(), run.GetRunID(), filepath.Join(dir, "history.json"))
+	var childExec *common.WorkflowExecution
+	iter := s.Client.GetWorkflowHistory(s.Context, run.GetID(), run.GetRunID(), false, enums.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		s.NoError(err)
+		if attrs := event.GetChildWorkflowExecutionStartedEventAttributes(); attrs != nil {
+			childExec = attrs.WorkflowExecution
+		}
+	}
+	s.NotNil(childExec)
+	exportHistory(childExec.WorkflowId, childExec.RunId, filepath.Join(childDir, "some-child.json"))
+
+	// Without child histories, the child is shown from the parent's events only
+	res := s.Execute(
+		"workflow", "trace",
+		"--history-file", filepath.Join(dir, "history.json"),
+		"--no-fold",
+	)
+	s.NoError(res.Err)
+	out := res.Stdout.String()
+	s.ContainsOnSameLine(out, "RunId", run.GetRunID())
+	s.Contains(out, "╪ ✓ DevWorkflow")
+	s.Contains(out, fmt.Sprintf("wfId: %s, runId: %s", childExec.WorkflowId, childExec.RunId))
+	s.NotContains(out, "DevActivity")
+
+	// With child histories
+	res = s.Execute(
+		"workflow", "trace",
+		"-w", run.GetID(),
+		"--history-file", filepath.Join(dir, "history.json"),
+		"--child-history-dir", childDir,
+		"--no-fold",
+	)
+	s.NoError(res.Err)
+	out = res.Stdout.String()
+	s.ContainsOnSameLine(out, "WorkflowId", run.GetID())
+	s.Contains(out, fmt.Sprintf("wfId: %s, runId: %s", run.GetID(), run.GetRunID()))
+	s.Contains(out, " │   │   ┼ ✓ DevActivity")
+
+	// Mismatched run ID
+	res = s.Execute(
+		"workflow", "trace",
+		"-r", "not-the-run-id",
+		"--history-file", filepath.Join(dir, "history.json"),
+	)
+	s.ErrorContains(res.Err, "not-the-run-id")
+}
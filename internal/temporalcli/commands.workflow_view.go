@@ -1,6 +1,7 @@
 package temporalcli
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -49,23 +50,9 @@ func (c *TemporalWorkflowDescribeCommand) run(cctx *CommandContext, args []strin
 		return nil
 	}
 
-	// Try to also get the close event if the description says completed. We don't
-	// just ask always because we don't want the race where it may have finished
-	// between when we called describe and now
-	var closeEvent *history.HistoryEvent
-	running := resp.WorkflowExecutionInfo.Status == enums.WORKFLOW_EXECUTION_STATUS_RUNNING
-	if !running {
-		iter := cl.GetWorkflowHistory(cctx,
-			resp.WorkflowExecutionInfo.Execution.WorkflowId,
-			resp.WorkflowExecutionInfo.Execution.RunId,
-			false,
-			enums.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT,
-		)
-		if !iter.HasNext() {
-			return fmt.Errorf("missing close event: %w", err)
-		} else if closeEvent, err = iter.Next(); err != nil {
-			return fmt.Errorf("failed getting close event: %w", err)
-		}
+	closeEvent, err := getWorkflowCloseEvent(cctx, cl, resp)
+	if err != nil {
+		return err
 	}
 
 	// Print JSON
@@ -99,7 +86,45 @@ func (c *TemporalWorkflowDescribeCommand) run(cctx *CommandContext, args []strin
 		}
 		return cctx.Printer.PrintStructured(toPrint, printer.StructuredOptions{})
 	}
+	return printWorkflowDescriptionText(cctx, c.Parent.Namespace, resp, closeEvent)
+}
 
+// getWorkflowCloseEvent returns the close event of a described workflow, or
+// nil if it is running.
+func getWorkflowCloseEvent(
+	ctx context.Context,
+	cl client.Client,
+	resp *workflowservice.DescribeWorkflowExecutionResponse,
+) (*history.HistoryEvent, error) {
+	// We only ask for the close event if the description says completed. We don't
+	// just ask always because we don't want the race where it may have finished
+	// between when we called describe and now
+	if resp.WorkflowExecutionInfo.Status == enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return nil, nil
+	}
+	iter := cl.GetWorkflowHistory(ctx,
+		resp.WorkflowExecutionInfo.Execution.WorkflowId,
+		resp.WorkflowExecutionInfo.Execution.RunId,
+		false,
+		enums.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT,
+	)
+	if !iter.HasNext() {
+		return nil, fmt.Errorf("missing close event")
+	}
+	closeEvent, err := iter.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed getting close event: %w", err)
+	}
+	return closeEvent, nil
+}
+
+func printWorkflowDescriptionText(
+	cctx *CommandContext,
+	namespace string,
+	resp *workflowservice.DescribeWorkflowExecutionResponse,
+	closeEvent *history.HistoryEvent,
+) error {
+	running := resp.WorkflowExecutionInfo.Status == enums.WORKFLOW_EXECUTION_STATUS_RUNNING
 	cctx.Printer.Println(color.MagentaString("Execution Info:"))
 	info := resp.WorkflowExecutionInfo
 	_ = cctx.Printer.PrintStructured(struct {
@@ -125,7 +150,7 @@ func (c *TemporalWorkflowDescribeCommand) run(cctx *CommandContext, args []strin
 		WorkflowId:           info.Execution.WorkflowId,
 		RunId:                info.Execution.RunId,
 		Type:                 info.Type.GetName(),
-		Namespace:            c.Parent.Namespace,
+		Namespace:            namespace,
 		TaskQueue:            info.TaskQueue,
 		AssignedBuildId:      info.GetAssignedBuildId(),
 		StartTime:            timestampToTime(info.StartTime),
@@ -369,6 +394,9 @@ func (c *TemporalWorkflowDescribeCommand) run(cctx *CommandContext, args []strin
 }
 
 func (c *TemporalWorkflowListCommand) run(cctx *CommandContext, _ []string) error {
+	if c.Interactive {
+		return c.runInteractive(cctx)
+	}
 	cl, err := dialClient(cctx, &c.Parent.ClientOptions)
 	if err != nil {
 		return err
@@ -124,6 +124,36 @@ func (s *SharedServerSuite) TestWorkflow_Describe_Completed() {
 	s.NoError(json.Unmarshal(res.Stdout.Bytes(), &jsonOut))
 	s.NotNil(jsonOut["closeEvent"])
 	s.Equal(map[string]any{"foo": "bar"}, jsonOut["result"])
+
+	// Go template, payloads in shorthand
+	res = s.Execute(
+		"workflow", "describe",
+		"--output-template", "{{.workflowExecutionInfo.status}} {{.result.foo}}",
+		"--address", s.Address(),
+		"-w", run.GetID(),
+	)
+	s.NoError(res.Err)
+	s.Equal("WORKFLOW_EXECUTION_STATUS_COMPLETED bar\n", res.Stdout.String())
+
+	// JSONPath
+	res = s.Execute(
+		"workflow", "describe",
+		"--output-jsonpath", "{.workflowExecutionInfo.execution.workflowId}",
+		"--address", s.Address(),
+		"-w", run.GetID(),
+	)
+	s.NoError(res.Err)
+	s.Equal(run.GetID()+"\n", res.Stdout.String())
+
+	// Both is an error
+	res = s.Execute(
+		"workflow", "describe",
+		"--output-template", "{{.result}}",
+		"--output-jsonpath", "{.result}",
+		"--address", s.Address(),
+		"-w", run.GetID(),
+	)
+	s.ErrorContains(res.Err, "cannot set both")
 }
 
 func (s *SharedServerSuite) TestWorkflow_Describe_Versioned() {
@@ -557,6 +587,35 @@ func (s *SharedServerSuite) TestWorkflow_List() {
 	out = res.Stdout.String()
 	s.ContainsOnSameLine(out, "name", "DevWorkflow")
 	s.ContainsOnSameLine(out, "status", "WORKFLOW_EXECUTION_STATUS_COMPLETED")
+
+	// CSV, header only once across pages
+	res = s.Execute(
+		"workflow", "list",
+		"--address", s.Address(),
+		"--query", fmt.Sprintf(`TaskQueue="%s"`, s.Worker().Options.TaskQueue),
+		"--page-size", "1",
+		"-o", "csv",
+	)
+	s.NoError(res.Err)
+	lines := strings.Split(strings.TrimSpace(res.Stdout.String()), "\n")
+	s.Len(lines, 4)
+	s.Equal("Status,WorkflowId,Type,StartTime", lines[0])
+	for _, line := range lines[1:] {
+		s.True(strings.HasPrefix(line, "Completed,"))
+		s.Contains(line, ",DevWorkflow,")
+	}
+
+	// YAML
+	res = s.Execute(
+		"workflow", "list",
+		"--address", s.Address(),
+		"--query", fmt.Sprintf(`TaskQueue="%s"`, s.Worker().Options.TaskQueue),
+		"-o", "yaml",
+	)
+	s.NoError(res.Err)
+	out = res.Stdout.String()
+	s.Equal(3, strings.Count(out, "- execution:"))
+	s.Contains(out, "status: WORKFLOW_EXECUTION_STATUS_COMPLETED")
 }
 
 func (s *SharedServerSuite) TestWorkflow_Count() {
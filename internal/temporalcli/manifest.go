@@ -0,0 +1,108 @@
+package temporalcli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+// manifestChange is a field that differs between a live resource and its
+// definition in a file given to an apply command.
+type manifestChange struct {
+	Field string `json:"field"`
+	Old   any    `json:"old,omitempty"`
+	New   any    `json:"new,omitempty"`
+}
+
+type manifestField struct {
+	name     string
+	old, new any
+}
+
+func diffManifestFields(fields ...manifestField) []*manifestChange {
+	var changes []*manifestChange
+	for _, f := range fields {
+		if !reflect.DeepEqual(f.old, f.new) {
+			changes = append(changes, &manifestChange{
+				Field: f.name,
+				Old:   manifestChangeValue(f.old),
+				New:   manifestChangeValue(f.new),
+			})
+		}
+	}
+	return changes
+}
+
+// manifestChangeValue turns zero values into nil so they show as unset.
+func manifestChangeValue(v any) any {
+	if rv := reflect.ValueOf(v); !rv.IsValid() || rv.IsZero() {
+		return nil
+	}
+	return v
+}
+
+func printManifestChanges(cctx *CommandContext, changes []*manifestChange) {
+	for _, change := range changes {
+		cctx.Printer.Printlnf("    %v: %v => %v",
+			change.Field, formatManifestValue(change.Old), formatManifestValue(change.New))
+	}
+}
+
+func formatManifestValue(v any) string {
+	if v == nil {
+		return "<unset>"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// readManifest decodes a YAML file, failing on unknown fields so typos are not
+// silently ignored.
+func readManifest(file string, v any) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed reading %v: %w", file, err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid file %v: %w", file, err)
+	}
+	return nil
+}
+
+// writeManifest writes YAML, or JSON when JSON output other than YAML is
+// enabled, to the file if set or stdout otherwise. Returns whether it was
+// written to a file.
+func writeManifest(cctx *CommandContext, v any, outputFile string) (bool, error) {
+	var b []byte
+	var err error
+	if cctx.JSONOutput && !cctx.Printer.YAML {
+		if b, err = json.MarshalIndent(v, "", "  "); err == nil {
+			b = append(b, '\n')
+		}
+	} else {
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err = enc.Encode(v); err == nil {
+			err = enc.Close()
+		}
+		b = buf.Bytes()
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed marshaling: %w", err)
+	}
+	if outputFile != "" {
+		return true, os.WriteFile(outputFile, b, 0644)
+	}
+	_, err = cctx.Printer.Output.Write(b)
+	return false, err
+}
@@ -0,0 +1,232 @@
+package temporalcli
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/contrib/envconfig"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	aesGCMEncoding         = "binary/encrypted"
+	aesGCMKeyIDMetadataKey = "encryption-key-id"
+	zstdEncoding           = "binary/zstd"
+)
+
+// buildCodec creates a single codec that applies the selected codecs in order
+// when encoding and in reverse order when decoding.
+func (p *PayloadCodecOptions) buildCodec(envLookup envconfig.EnvLookup) (converter.PayloadCodec, error) {
+	if len(p.Codec.Values) == 0 {
+		return nil, fmt.Errorf("must set at least one codec")
+	}
+	var codecs chainPayloadCodec
+	for _, name := range p.Codec.Values {
+		switch name {
+		case "aes-gcm":
+			key, err := p.loadKey(envLookup)
+			if err != nil {
+				return nil, err
+			}
+			codec, err := newAESGCMPayloadCodec(p.KeyId, key)
+			if err != nil {
+				return nil, err
+			}
+			codecs = append(codecs, codec)
+		case "zlib":
+			codecs = append(codecs, converter.NewZlibCodec(converter.ZlibCodecOptions{AlwaysEncode: true}))
+		case "zstd":
+			codec, err := newZstdPayloadCodec()
+			if err != nil {
+				return nil, err
+			}
+			codecs = append(codecs, codec)
+		default:
+			return nil, fmt.Errorf("unknown codec %q", name)
+		}
+	}
+	return codecs, nil
+}
+
+func (p *PayloadCodecOptions) loadKey(envLookup envconfig.EnvLookup) ([]byte, error) {
+	var encoded string
+	switch {
+	case p.KeyFile != "" && p.KeyEnv != "":
+		return nil, fmt.Errorf("cannot set both key file and key env")
+	case p.KeyFile != "":
+		b, err := os.ReadFile(p.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading key file: %w", err)
+		}
+		encoded = string(b)
+	case p.KeyEnv != "":
+		encoded, _ = envLookup.LookupEnv(p.KeyEnv)
+		if encoded == "" {
+			return nil, fmt.Errorf("environment variable %v is not set", p.KeyEnv)
+		}
+	default:
+		return nil, fmt.Errorf("must set key file or key env for aes-gcm codec")
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("key is not valid base64: %w", err)
+	}
+	return key, nil
+}
+
+type chainPayloadCodec []converter.PayloadCodec
+
+func (c chainPayloadCodec) Encode(payloads []*common.Payload) ([]*common.Payload, error) {
+	var err error
+	for _, codec := range c {
+		if payloads, err = codec.Encode(payloads); err != nil {
+			return nil, err
+		}
+	}
+	return payloads, nil
+}
+
+func (c chainPayloadCodec) Decode(payloads []*common.Payload) ([]*common.Payload, error) {
+	var err error
+	for i := len(c) - 1; i >= 0; i-- {
+		if payloads, err = c[i].Decode(payloads); err != nil {
+			return nil, err
+		}
+	}
+	return payloads, nil
+}
+
+// aesGCMPayloadCodec encrypts entire serialized payloads, storing the nonce as
+// a prefix of the encrypted data.
+type aesGCMPayloadCodec struct {
+	keyID string
+	aead  cipher.AEAD
+}
+
+func newAESGCMPayloadCodec(keyID string, key []byte) (*aesGCMPayloadCodec, error) {
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return nil, fmt.Errorf("key must be 16, 24, or 32 bytes, got %v", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &aesGCMPayloadCodec{keyID: keyID, aead: aead}, nil
+}
+
+func (a *aesGCMPayloadCodec) Encode(payloads []*common.Payload) ([]*common.Payload, error) {
+	result := make([]*common.Payload, len(payloads))
+	for i, p := range payloads {
+		b, err := proto.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+		nonce := make([]byte, a.aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, err
+		}
+		result[i] = &common.Payload{
+			Metadata: map[string][]byte{
+				converter.MetadataEncoding: []byte(aesGCMEncoding),
+				aesGCMKeyIDMetadataKey:     []byte(a.keyID),
+			},
+			Data: a.aead.Seal(nonce, nonce, b, nil),
+		}
+	}
+	return result, nil
+}
+
+func (a *aesGCMPayloadCodec) Decode(payloads []*common.Payload) ([]*common.Payload, error) {
+	result := make([]*common.Payload, len(payloads))
+	for i, p := range payloads {
+		// Only if it's our encoding
+		if string(p.Metadata[converter.MetadataEncoding]) != aesGCMEncoding {
+			result[i] = p
+			continue
+		}
+		if keyID := string(p.Metadata[aesGCMKeyIDMetadataKey]); keyID != a.keyID {
+			return nil, fmt.Errorf("payload encrypted with key ID %q, expected %q", keyID, a.keyID)
+		}
+		nonceSize := a.aead.NonceSize()
+		if len(p.Data) < nonceSize {
+			return nil, fmt.Errorf("encrypted payload too short")
+		}
+		b, err := a.aead.Open(nil, p.Data[:nonceSize], p.Data[nonceSize:], nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed decrypting payload: %w", err)
+		}
+		result[i] = &common.Payload{}
+		if err := proto.Unmarshal(b, result[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// zstdPayloadCodec compresses entire serialized payloads. Encoders and
+// decoders are safe for concurrent use with EncodeAll/DecodeAll.
+type zstdPayloadCodec struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func newZstdPayloadCodec() (*zstdPayloadCodec, error) {
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &zstdPayloadCodec{encoder: encoder, decoder: decoder}, nil
+}
+
+func (z *zstdPayloadCodec) Encode(payloads []*common.Payload) ([]*common.Payload, error) {
+	result := make([]*common.Payload, len(payloads))
+	for i, p := range payloads {
+		b, err := proto.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = &common.Payload{
+			Metadata: map[string][]byte{converter.MetadataEncoding: []byte(zstdEncoding)},
+			Data:     z.encoder.EncodeAll(b, nil),
+		}
+	}
+	return result, nil
+}
+
+func (z *zstdPayloadCodec) Decode(payloads []*common.Payload) ([]*common.Payload, error) {
+	result := make([]*common.Payload, len(payloads))
+	for i, p := range payloads {
+		// Only if it's our encoding
+		if string(p.Metadata[converter.MetadataEncoding]) != zstdEncoding {
+			result[i] = p
+			continue
+		}
+		b, err := z.decoder.DecodeAll(p.Data, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed decompressing payload: %w", err)
+		}
+		result[i] = &common.Payload{}
+		if err := proto.Unmarshal(b, result[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
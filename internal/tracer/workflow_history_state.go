@@ -0,0 +1,46 @@
+package tracer
+
+import (
+	"slices"
+
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/history/v1"
+)
+
+// ChildHistoryFunc returns the Event History of a child workflow execution, or false if it's not available.
+type ChildHistoryFunc func(exec *common.WorkflowExecution) (*history.History, bool)
+
+// NewWorkflowExecutionStateFromHistory builds a WorkflowExecutionState from an already retrieved Event History, e.g. one
+// exported to a file. Child workflows are filled in from childHistory (which may be nil) using the same depth and folding
+// rules as WorkflowStateJob, so the result can be rendered with an ExecutionTemplate exactly like a live trace.
+func NewWorkflowExecutionStateFromHistory(wfId string, hist *history.History, childHistory ChildHistoryFunc, fetchAll bool, foldStatus []enums.WorkflowExecutionStatus, depth int) *WorkflowExecutionState {
+	state := NewWorkflowExecutionState(wfId, "")
+	updateStateFromHistory(state, hist, childHistory, fetchAll, foldStatus, depth)
+	return state
+}
+
+func updateStateFromHistory(state *WorkflowExecutionState, hist *history.History, childHistory ChildHistoryFunc, fetchAll bool, foldStatus []enums.WorkflowExecutionStatus, depth int) {
+	for _, event := range hist.GetEvents() {
+		state.Update(event)
+	}
+	// The whole history is known, so the state is always up-to-date
+	state.HistoryLength = state.LastEventId
+
+	if childHistory == nil || depth == 0 {
+		return
+	}
+	for _, child := range state.ChildStates {
+		childState, ok := child.(*WorkflowExecutionState)
+		// Children without a run ID were never started
+		if !ok || childState.Execution.GetRunId() == "" {
+			continue
+		}
+		if !fetchAll && slices.Contains(foldStatus, childState.Status) {
+			continue
+		}
+		if childHist, ok := childHistory(childState.Execution); ok {
+			updateStateFromHistory(childState, childHist, childHistory, fetchAll, foldStatus, depth-1)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package tracer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/history/v1"
+)
+
+func TestNewWorkflowExecutionStateFromHistory(t *testing.T) {
+	parent := &history.History{Events: []*history.HistoryEvent{
+		events["started"],
+		events["child workflow initiated"],
+		events["child workflow started"],
+		events["child workflow completed"],
+	}}
+	child := &history.History{Events: []*history.HistoryEvent{
+		events["started"],
+		events["activity scheduled"],
+		events["completed"],
+	}}
+	childHistory := func(exec *common.WorkflowExecution) (*history.History, bool) {
+		return child, exec.GetRunId() == "childRunId"
+	}
+	completed := []enums.WorkflowExecutionStatus{enums.WORKFLOW_EXECUTION_STATUS_COMPLETED}
+
+	tests := map[string]struct {
+		childHistory    ChildHistoryFunc
+		fetchAll        bool
+		depth           int
+		childHasHistory bool
+	}{
+		"no child histories": {childHistory: nil, fetchAll: true, depth: -1},
+		"child history":      {childHistory: childHistory, fetchAll: true, depth: -1, childHasHistory: true},
+		"child folded":       {childHistory: childHistory, fetchAll: false, depth: -1},
+		"child beyond depth": {childHistory: childHistory, fetchAll: true, depth: 0},
+		"child within depth": {childHistory: childHistory, fetchAll: true, depth: 1, childHasHistory: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := NewWorkflowExecutionStateFromHistory("foo", parent, tt.childHistory, tt.fetchAll, completed, tt.depth)
+			assert.Equal(t, "foo", state.Execution.GetWorkflowId())
+			assert.Equal(t, int64(60), state.HistoryLength)
+			assert.Len(t, state.ChildStates, 1)
+
+			childState := state.ChildStates[0].(*WorkflowExecutionState)
+			assert.Equal(t, "childRunId", childState.Execution.GetRunId())
+			assert.Equal(t, enums.WORKFLOW_EXECUTION_STATUS_COMPLETED, childState.Status)
+			if tt.childHasHistory {
+				assert.Len(t, childState.ChildStates, 1)
+				assert.Equal(t, int64(100), childState.HistoryLength)
+			} else {
+				assert.Empty(t, childState.ChildStates)
+			}
+		})
+	}
+}